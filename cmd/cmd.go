@@ -3,11 +3,13 @@ package cmd
 import (
 	dv "github.com/named-data/ndnd/dv/cmd"
 	fw "github.com/named-data/ndnd/fw/cmd"
+	ls "github.com/named-data/ndnd/ls/cmd"
 	"github.com/named-data/ndnd/repo"
 	"github.com/named-data/ndnd/std/utils"
 	"github.com/named-data/ndnd/tools"
 	"github.com/named-data/ndnd/tools/dvc"
 	"github.com/named-data/ndnd/tools/nfdc"
+	"github.com/named-data/ndnd/tools/repoc"
 	"github.com/named-data/ndnd/tools/sec"
 	"github.com/spf13/cobra"
 )
@@ -39,6 +41,7 @@ func init() {
 	CmdNDNd.AddGroup(&cobra.Group{ID: "daemons", Title: "NDN Daemons"})
 	CmdNDNd.AddCommand(cmdFw())
 	CmdNDNd.AddCommand(cmdDv())
+	CmdNDNd.AddCommand(cmdLs())
 	CmdNDNd.AddCommand(cmdDaemon)
 	CmdNDNd.AddCommand(cmdRepo())
 
@@ -51,6 +54,7 @@ func init() {
 	CmdNDNd.AddCommand(tools.CmdPingServer())
 	CmdNDNd.AddCommand(tools.CmdCatChunks())
 	CmdNDNd.AddCommand(tools.CmdPutChunks())
+	CmdNDNd.AddCommand(tools.CmdStore())
 }
 
 // Constructs the NDN Forwarding Daemon command with subcommands grouped for running the forwarder (via `run CONFIG-FILE`) and managing it (via `nfdc` control commands).
@@ -105,12 +109,36 @@ Reference:
 	return cmdDv
 }
 
-// Constructs the 'repo' command for managing the NDN Data Repository daemon, including the 'run' subcommand to start the daemon using a configuration file.
+// Constructs the command of the NDN Link State Routing Daemon, with the subcommand
+// that runs the router from a configuration file.
+func cmdLs() *cobra.Command {
+	cmdLs := &cobra.Command{
+		Use:   "ls",
+		Short: "NDN Link State Routing Daemon",
+		Long: `NDN Link State Routing Daemon
+
+Reference:
+  https://github.com/named-data/ndnd/blob/main/ls/README.md`,
+		GroupID: "daemons",
+	}
+
+	cmdLs.AddGroup(&cobra.Group{ID: "run", Title: "Router Daemon"})
+	ls.CmdLs.Use = "run CONFIG-FILE"
+	ls.CmdLs.Short = "Start the NDN Link State Routing Daemon"
+	cmdLs.AddCommand(ls.CmdLs)
+
+	return cmdLs
+}
+
+// Constructs the 'repo' command for managing the NDN Data Repository daemon, including the 'run' subcommand to start the daemon using a configuration file and control commands to manage stored objects.
 func cmdRepo() *cobra.Command {
 	cmdRepo := &cobra.Command{
-		Use:     "repo",
-		Short:   "NDN Data Repository",
-		Long:    `Named Data Networking Data Repository`,
+		Use:   "repo",
+		Short: "NDN Data Repository",
+		Long: `Named Data Networking Data Repository
+
+Reference:
+  https://github.com/named-data/ndnd/blob/main/docs/repo-control.md`,
 		GroupID: "daemons",
 	}
 
@@ -119,5 +147,11 @@ func cmdRepo() *cobra.Command {
 	repo.CmdRepo.Short = "Start the NDN Data Repository Daemon"
 	cmdRepo.AddCommand(repo.CmdRepo)
 
+	cmdRepo.AddGroup(&cobra.Group{ID: "repoc", Title: "NDN Data Repository Control"})
+	for _, sub := range repoc.Cmds() {
+		sub.GroupID = "repoc"
+		cmdRepo.AddCommand(sub)
+	}
+
 	return cmdRepo
 }
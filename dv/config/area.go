@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+type AggregateConfig struct {
+	// Area in which the aggregated prefixes are announced.
+	Area string `json:"area"`
+	// Aggregate prefix announced into the other areas of the border router.
+	Prefix string `json:"prefix"`
+}
+
+// Area is a parsed routing area of this router.
+// Routers exchange advertisements and prefixes only within an area.
+type Area struct {
+	// Name of the area (empty for the default area)
+	name string
+	// Advertisement Sync Prefix
+	advSyncPfxN enc.Name
+	// Advertisement Sync Prefix (Active)
+	advSyncActivePfxN enc.Name
+	// Advertisement Sync Prefix (Passive)
+	advSyncPassivePfxN enc.Name
+	// Prefix Table Sync Prefix
+	pfxSyncGroupPfxN enc.Name
+	// Aggregate prefixes of this area announced into other areas
+	aggregatesN []enc.Name
+}
+
+// Parses the areas of the router and the aggregate prefixes of border routers.
+func (c *Config) parseAreas() error {
+	names := c.Areas
+	if len(names) == 0 {
+		names = []string{""} // default area
+	}
+
+	c.areas = make([]*Area, 0, len(names))
+	for _, name := range names {
+		if len(c.Areas) > 0 && name == "" {
+			return fmt.Errorf("area name must not be empty")
+		}
+		if c.GetArea(name) != nil {
+			return fmt.Errorf("duplicate area %s", name)
+		}
+		c.areas = append(c.areas, newArea(c.networkNameN, c.routerNameN, name))
+	}
+
+	for _, agg := range c.Aggregates {
+		if len(c.areas) < 2 {
+			return fmt.Errorf("aggregates can only be configured on border routers")
+		}
+		area := c.GetArea(agg.Area)
+		if area == nil {
+			return fmt.Errorf("aggregate prefix %s is in unknown area %s", agg.Prefix, agg.Area)
+		}
+		prefix, err := enc.NameFromStr(agg.Prefix)
+		if err != nil {
+			return err
+		}
+		area.aggregatesN = append(area.aggregatesN, prefix)
+	}
+
+	for i := range c.Neighbors {
+		if c.Neighbors[i].Area == "" {
+			c.Neighbors[i].Area = c.areas[0].name
+		}
+		if c.GetArea(c.Neighbors[i].Area) == nil {
+			return fmt.Errorf("neighbor %s is in unknown area %s", c.Neighbors[i].Uri, c.Neighbors[i].Area)
+		}
+	}
+
+	if c.Discovery.Area == "" {
+		c.Discovery.Area = c.areas[0].name
+	}
+	if c.GetArea(c.Discovery.Area) == nil {
+		return fmt.Errorf("discovered links are in unknown area %s", c.Discovery.Area)
+	}
+
+	return nil
+}
+
+// newArea constructs the names of an area.
+func newArea(network enc.Name, router enc.Name, name string) *Area {
+	a := &Area{name: name}
+
+	// /localhop/<network>/32=DV[/32=AREA/<area>]
+	a.advSyncPfxN = enc.LOCALHOP.
+		Append(network...).
+		Append(enc.NewKeywordComponent("DV"))
+	// /<network>/32=DV[/32=AREA/<area>]
+	a.pfxSyncGroupPfxN = network.
+		Append(enc.NewKeywordComponent("DV"))
+
+	if name != "" {
+		areaPfx := enc.Name{
+			enc.NewKeywordComponent("AREA"),
+			enc.NewGenericComponent(name),
+		}
+		a.advSyncPfxN = a.advSyncPfxN.Append(areaPfx...)
+		a.pfxSyncGroupPfxN = a.pfxSyncGroupPfxN.Append(areaPfx...)
+	}
+
+	a.advSyncPfxN = a.advSyncPfxN.
+		Append(enc.NewKeywordComponent("ADS"))
+	a.advSyncActivePfxN = a.advSyncPfxN.
+		Append(enc.NewKeywordComponent("ACT"))
+	a.advSyncPassivePfxN = a.advSyncPfxN.
+		Append(enc.NewKeywordComponent("PSV"))
+	a.pfxSyncGroupPfxN = a.pfxSyncGroupPfxN.
+		Append(enc.NewKeywordComponent("PFS"))
+
+	return a
+}
+
+// Returns the areas of this router. There is always at least one area.
+func (c *Config) RouterAreas() []*Area {
+	return c.areas
+}
+
+// Returns the area with the given name, or nil if the router is not in the area.
+func (c *Config) GetArea(name string) *Area {
+	idx := slices.IndexFunc(c.areas, func(a *Area) bool { return a.name == name })
+	if idx < 0 {
+		return nil
+	}
+	return c.areas[idx]
+}
+
+// Returns true if the router is in more than one area.
+func (c *Config) IsBorderRouter() bool {
+	return len(c.areas) > 1
+}
+
+// Returns the name of the area (empty for the default area).
+func (a *Area) Name() string {
+	return a.name
+}
+
+// Log identifier for the area.
+func (a *Area) String() string {
+	if a.name == "" {
+		return "dv-area-default"
+	}
+	return "dv-area-" + a.name
+}
+
+// Returns the advertisement synchronization prefix of the area.
+func (a *Area) AdvertisementSyncPrefix() enc.Name {
+	return a.advSyncPfxN
+}
+
+// Returns the active advertisement synchronization prefix of the area.
+func (a *Area) AdvertisementSyncActivePrefix() enc.Name {
+	return a.advSyncActivePfxN
+}
+
+// Returns the passive advertisement synchronization prefix of the area.
+func (a *Area) AdvertisementSyncPassivePrefix() enc.Name {
+	return a.advSyncPassivePfxN
+}
+
+// Returns the group prefix used for prefix table synchronization in the area.
+func (a *Area) PrefixTableGroupPrefix() enc.Name {
+	return a.pfxSyncGroupPfxN
+}
+
+// Returns the aggregate prefixes of this area announced into the other areas.
+func (a *Area) Aggregates() []enc.Name {
+	return a.aggregatesN
+}
@@ -9,8 +9,11 @@ import (
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
 
-// CostInfinity is the maximum cost to a router.
-const CostInfinity = uint64(16)
+// DefaultCostInfinity is the default maximum cost to a router.
+const DefaultCostInfinity = uint64(16)
+
+// DefaultLinkCost is the cost of a link without a configured cost.
+const DefaultLinkCost = uint64(1)
 
 // CostPfxInfinity is the maximum cost to a name prefix.
 const CostPfxInfinity = uint64(0xFFFFFFFF)
@@ -41,21 +44,37 @@ type Config struct {
 	TrustAnchors []string `json:"trust_anchors"`
 	// List of permanent neighbors.
 	Neighbors []Neighbor `json:"neighbors"`
+	// Maximum cost to a router, which must be the same for all routers.
+	// Destinations at this cost or higher are unreachable.
+	CostInfinity uint64 `json:"cost_infinity"`
+	// Measurement of link costs.
+	LinkCost LinkCostConfig `json:"link_cost"`
+	// Maximum number of loop-free next hops installed for a destination.
+	MaxPaths int `json:"max_paths"`
+	// Filters and cost rewrites for announced prefixes.
+	Policy PrefixPolicy `json:"policy"`
+	// Routing areas of this router. A router in more than one area is a border router.
+	// If empty, the router is in the default area of the network.
+	Areas []string `json:"areas"`
+	// Aggregate prefixes announced by a border router between its areas.
+	Aggregates []AggregateConfig `json:"aggregates"`
+	// Automatic discovery of neighbors on multicast faces.
+	Discovery DiscoveryConfig `json:"discovery"`
+	// Fast detection of neighbor failures.
+	FailureDetection FailureDetectionConfig `json:"failure_detection"`
+	// Graceful restart of the router.
+	GracefulRestart GracefulRestartConfig `json:"graceful_restart"`
 
 	// Parsed Global Prefix
 	networkNameN enc.Name
 	// Parsed Router Prefix
 	routerNameN enc.Name
-	// Advertisement Sync Prefix
-	advSyncPfxN enc.Name
-	// Advertisement Sync Prefix (Active)
-	advSyncActivePfxN enc.Name
-	// Advertisement Sync Prefix (Passive)
-	advSyncPassivePfxN enc.Name
+	// Parsed routing areas
+	areas []*Area
 	// Advertisement Data Prefix
 	advDataPfxN enc.Name
-	// Prefix Table Sync Prefix
-	pfxSyncGroupPfxN enc.Name
+	// Neighbor Discovery Prefix
+	discoveryPfxN enc.Name
 	// NLSR readvertise prefix
 	mgmtPrefix enc.Name
 	// Trust anchor names
@@ -67,6 +86,11 @@ type Neighbor struct {
 	Uri string `json:"uri"`
 	// MTU of the link face.
 	Mtu uint64 `json:"mtu"`
+	// Static cost of the link (default 1).
+	// With dynamic link costs, this is the base cost of the link.
+	Cost uint64 `json:"cost"`
+	// Area of the link (default: first area of the router).
+	Area string `json:"area"`
 
 	// FaceId of the neighbor.
 	FaceId uint64 `json:"-"`
@@ -74,6 +98,45 @@ type Neighbor struct {
 	Created bool `json:"-"`
 }
 
+type DiscoveryConfig struct {
+	// Discover neighbors on the multicast faces of the forwarder.
+	Enabled bool `json:"enabled"`
+	// Area of discovered links (default: first area of the router).
+	Area string `json:"area"`
+	// Unicast UDP port of the forwarder, announced to neighbors.
+	UdpPort uint64 `json:"udp_port"`
+}
+
+type GracefulRestartConfig struct {
+	// Persist the routing state on shutdown and restore it on start.
+	Enabled bool `json:"enabled"`
+	// File to persist the routing state in.
+	StateFile string `json:"state_file"`
+	// Time for which neighbors keep stale routes through the router (ms).
+	// This is also the longest grace period accepted from a neighbor.
+	GracePeriod_ms uint64 `json:"grace_period"`
+}
+
+type FailureDetectionConfig struct {
+	// Fail neighbors when the forwarder reports that their face is down or destroyed.
+	FaceEvents bool `json:"face_events"`
+	// Interval of failure detection probes to each neighbor (zero to disable).
+	ProbeInterval_ms uint64 `json:"probe_interval"`
+	// Number of consecutive lost probes after which a neighbor is failed.
+	ProbeMultiplier uint64 `json:"probe_multiplier"`
+}
+
+type LinkCostConfig struct {
+	// Derive link costs from the measured RTT and loss.
+	Dynamic bool `json:"dynamic"`
+	// RTT that adds one to the link cost.
+	RttUnit_ms uint64 `json:"rtt_unit"`
+	// Cost added for a link that loses all probes.
+	LossPenalty uint64 `json:"loss_penalty"`
+	// Minimum change of the link cost in percent before routes are updated.
+	Hysteresis uint64 `json:"hysteresis"`
+}
+
 // "Returns a default configuration with invalid network/router identifiers, 5-second advertisement sync interval, 30-second router dead interval, and an undefined key chain URI, requiring customization before use."
 func DefaultConfig() *Config {
 	return &Config{
@@ -82,6 +145,27 @@ func DefaultConfig() *Config {
 		AdvertisementSyncInterval_ms: 5000,
 		RouterDeadInterval_ms:        30000,
 		KeyChainUri:                  "undefined",
+		CostInfinity:                 DefaultCostInfinity,
+		MaxPaths:                     2,
+		LinkCost: LinkCostConfig{
+			Dynamic:     false,
+			RttUnit_ms:  10,
+			LossPenalty: 10,
+			Hysteresis:  20,
+		},
+		Discovery: DiscoveryConfig{
+			Enabled: false,
+			UdpPort: 6363,
+		},
+		FailureDetection: FailureDetectionConfig{
+			FaceEvents:       true,
+			ProbeInterval_ms: 0,
+			ProbeMultiplier:  3,
+		},
+		GracefulRestart: GracefulRestartConfig{
+			Enabled:        false,
+			GracePeriod_ms: 60000,
+		},
 	}
 }
 
@@ -128,6 +212,49 @@ func (c *Config) Parse() (err error) {
 		return fmt.Errorf("RouterDeadInterval must be at least 2*AdvertisementSyncInterval")
 	}
 
+	// Validate link costs
+	if c.CostInfinity < 2 {
+		return fmt.Errorf("CostInfinity must be at least 2")
+	}
+	for i := range c.Neighbors {
+		if c.Neighbors[i].Cost == 0 {
+			c.Neighbors[i].Cost = DefaultLinkCost
+		}
+		if c.Neighbors[i].Cost >= c.CostInfinity {
+			return fmt.Errorf("cost of neighbor %s must be less than CostInfinity", c.Neighbors[i].Uri)
+		}
+	}
+	if c.MaxPaths < 1 {
+		return fmt.Errorf("MaxPaths must be at least 1")
+	}
+	if c.LinkCost.Dynamic && c.LinkCost.RttUnit_ms == 0 {
+		return fmt.Errorf("LinkCost.RttUnit must be set for dynamic link costs")
+	}
+	if c.Discovery.Enabled && (c.Discovery.UdpPort == 0 || c.Discovery.UdpPort > 65535) {
+		return fmt.Errorf("Discovery.UdpPort must be a valid port")
+	}
+	if c.GracefulRestart.Enabled {
+		if c.GracefulRestart.StateFile == "" {
+			return fmt.Errorf("GracefulRestart.StateFile must be set")
+		}
+		if c.GracefulRestart.GracePeriod_ms == 0 {
+			return fmt.Errorf("GracefulRestart.GracePeriod must be positive")
+		}
+	}
+	if c.FailureDetection.ProbeInterval_ms > 0 {
+		if c.FailureDetection.ProbeInterval() < 10*time.Millisecond {
+			return fmt.Errorf("FailureDetection.ProbeInterval must be at least 10ms")
+		}
+		if c.FailureDetection.ProbeMultiplier < 1 {
+			return fmt.Errorf("FailureDetection.ProbeMultiplier must be at least 1")
+		}
+	}
+
+	// Validate prefix policy
+	if err := c.Policy.Parse(); err != nil {
+		return err
+	}
+
 	// Validate trust anchors
 	c.trustAnchorsN = make([]enc.Name, 0, len(c.TrustAnchors))
 	for _, anchor := range c.TrustAnchors {
@@ -138,24 +265,22 @@ func (c *Config) Parse() (err error) {
 		c.trustAnchorsN = append(c.trustAnchorsN, name)
 	}
 
-	// Advertisement sync and data prefixes
-	c.advSyncPfxN = enc.LOCALHOP.
-		Append(c.networkNameN...).
-		Append(enc.NewKeywordComponent("DV")).
-		Append(enc.NewKeywordComponent("ADS"))
-	c.advSyncActivePfxN = c.advSyncPfxN.
-		Append(enc.NewKeywordComponent("ACT"))
-	c.advSyncPassivePfxN = c.advSyncPfxN.
-		Append(enc.NewKeywordComponent("PSV"))
+	// Advertisement sync and prefix table sync prefixes of each area
+	if err := c.parseAreas(); err != nil {
+		return err
+	}
+
+	// Advertisement data prefix
 	c.advDataPfxN = enc.LOCALHOP.
 		Append(c.routerNameN...).
 		Append(enc.NewKeywordComponent("DV")).
 		Append(enc.NewKeywordComponent("ADV"))
 
-	// Prefix table sync prefix
-	c.pfxSyncGroupPfxN = c.networkNameN.
+	// Neighbor discovery prefix
+	c.discoveryPfxN = enc.LOCALHOP.
+		Append(c.networkNameN...).
 		Append(enc.NewKeywordComponent("DV")).
-		Append(enc.NewKeywordComponent("PFS"))
+		Append(enc.NewKeywordComponent("HELLO"))
 
 	// Local prefixes to NFD
 	c.mgmtPrefix = enc.LOCALHOST.
@@ -174,29 +299,14 @@ func (c *Config) RouterName() enc.Name {
 	return c.routerNameN
 }
 
-// Returns the configured advertisement synchronization prefix name.
-func (c *Config) AdvertisementSyncPrefix() enc.Name {
-	return c.advSyncPfxN
-}
-
-// Returns the active name prefix used for advertisement synchronization in the configuration.
-func (c *Config) AdvertisementSyncActivePrefix() enc.Name {
-	return c.advSyncActivePfxN
-}
-
-// Returns the name of the passive prefix used for advertisement synchronization in the routing protocol.
-func (c *Config) AdvertisementSyncPassivePrefix() enc.Name {
-	return c.advSyncPassivePfxN
-}
-
 // Returns the configured advertisement data prefix name used for data packet naming in the NDN network.
 func (c *Config) AdvertisementDataPrefix() enc.Name {
 	return c.advDataPfxN
 }
 
-// Returns the configured group prefix name used for prefix table synchronization.
-func (c *Config) PrefixTableGroupPrefix() enc.Name {
-	return c.pfxSyncGroupPfxN
+// Returns the prefix of neighbor discovery hello Interests.
+func (c *Config) DiscoveryPrefix() enc.Name {
+	return c.discoveryPfxN
 }
 
 // Returns the management prefix configured for this instance.
@@ -214,6 +324,21 @@ func (c *Config) RouterDeadInterval() time.Duration {
 	return time.Duration(c.RouterDeadInterval_ms) * time.Millisecond
 }
 
+// Returns the RTT that adds one to a dynamic link cost as a `time.Duration`.
+func (c *LinkCostConfig) RttUnit() time.Duration {
+	return time.Duration(c.RttUnit_ms) * time.Millisecond
+}
+
+// Returns the interval of failure detection probes as a `time.Duration`.
+func (c *FailureDetectionConfig) ProbeInterval() time.Duration {
+	return time.Duration(c.ProbeInterval_ms) * time.Millisecond
+}
+
+// Returns the grace period of a restart as a `time.Duration`.
+func (c *GracefulRestartConfig) GracePeriod() time.Duration {
+	return time.Duration(c.GracePeriod_ms) * time.Millisecond
+}
+
 // Returns the names of the trust anchors configured in this configuration.
 func (c *Config) TrustAnchorNames() []enc.Name {
 	return c.trustAnchorsN
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+const (
+	// PolicyAccept accepts a matching prefix.
+	PolicyAccept = "accept"
+	// PolicyDeny drops a matching prefix.
+	PolicyDeny = "deny"
+)
+
+type PrefixPolicy struct {
+	// Rules applied to local prefixes before they are announced to the network.
+	Export []*PolicyRule `json:"export"`
+	// Rules applied to prefixes announced by other routers.
+	Import []*PolicyRule `json:"import"`
+}
+
+type PolicyRule struct {
+	// Name prefix matched by the rule (default: all names).
+	Prefix string `json:"prefix"`
+	// Match only the exact name and not names under it.
+	Exact bool `json:"exact"`
+	// Prefix of the announcing router matched by the rule (import only).
+	Router string `json:"router"`
+	// Action for matching prefixes, either "accept" or "deny".
+	Action string `json:"action"`
+	// Replace the cost of accepted prefixes.
+	SetCost *uint64 `json:"set_cost"`
+	// Add to the cost of accepted prefixes.
+	AddCost uint64 `json:"add_cost"`
+
+	// Parsed name prefix
+	prefixN enc.Name
+	// Parsed router prefix
+	routerN enc.Name
+}
+
+// Parses and validates the import and export rules of the policy.
+func (p *PrefixPolicy) Parse() error {
+	for _, rule := range p.Export {
+		if rule.Router != "" {
+			return fmt.Errorf("export policy rules cannot match a router")
+		}
+	}
+	for _, rules := range [][]*PolicyRule{p.Export, p.Import} {
+		for _, rule := range rules {
+			if err := rule.Parse(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Parses the names of the rule and validates its action.
+func (r *PolicyRule) Parse() (err error) {
+	switch r.Action {
+	case "":
+		r.Action = PolicyAccept
+	case PolicyAccept, PolicyDeny:
+	default:
+		return fmt.Errorf("invalid policy action: %s", r.Action)
+	}
+
+	if r.SetCost != nil && *r.SetCost >= CostPfxInfinity {
+		return fmt.Errorf("policy cost must be less than %d", CostPfxInfinity)
+	}
+	if r.AddCost >= CostPfxInfinity {
+		return fmt.Errorf("policy added cost must be less than %d", CostPfxInfinity)
+	}
+
+	if r.prefixN, err = enc.NameFromStr(r.Prefix); err != nil {
+		return fmt.Errorf("invalid policy prefix %s: %w", r.Prefix, err)
+	}
+	if r.routerN, err = enc.NameFromStr(r.Router); err != nil {
+		return fmt.Errorf("invalid policy router %s: %w", r.Router, err)
+	}
+	return nil
+}
+
+// Applies the export policy to a local prefix.
+// Returns the cost to announce and whether the prefix is accepted.
+func (p *PrefixPolicy) ExportPrefix(name enc.Name, cost uint64) (uint64, bool) {
+	return evaluatePolicy(p.Export, nil, name, cost)
+}
+
+// Applies the import policy to a prefix announced by a router.
+// Returns the cost to install and whether the prefix is accepted.
+func (p *PrefixPolicy) ImportPrefix(router enc.Name, name enc.Name, cost uint64) (uint64, bool) {
+	return evaluatePolicy(p.Import, router, name, cost)
+}
+
+// evaluatePolicy applies the first matching rule. Prefixes matching no rule are accepted.
+func evaluatePolicy(rules []*PolicyRule, router enc.Name, name enc.Name, cost uint64) (uint64, bool) {
+	for _, rule := range rules {
+		if !rule.matches(router, name) {
+			continue
+		}
+		if rule.Action == PolicyDeny {
+			return cost, false
+		}
+		if rule.SetCost != nil {
+			cost = *rule.SetCost
+		}
+		// Both terms are below infinity, so the sum cannot overflow
+		cost = min(min(cost, CostPfxInfinity-1)+rule.AddCost, CostPfxInfinity-1)
+		return cost, true
+	}
+	return cost, true
+}
+
+// matches checks if the rule matches a prefix announced by a router.
+func (r *PolicyRule) matches(router enc.Name, name enc.Name) bool {
+	if r.Exact && len(name) != len(r.prefixN) {
+		return false
+	}
+	if !r.prefixN.IsPrefix(name) {
+		return false
+	}
+	return router == nil || r.routerN.IsPrefix(router)
+}
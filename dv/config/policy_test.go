@@ -0,0 +1,95 @@
+package config_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyParse(t *testing.T) {
+	tu.SetT(t)
+
+	infinity := config.CostPfxInfinity
+	for _, policy := range []config.PrefixPolicy{
+		{Export: []*config.PolicyRule{{Router: "/ndn/r1"}}},
+		{Import: []*config.PolicyRule{{Action: "drop"}}},
+		{Import: []*config.PolicyRule{{SetCost: &infinity}}},
+		{Import: []*config.PolicyRule{{AddCost: infinity}}},
+	} {
+		require.Error(t, policy.Parse())
+	}
+
+	// Rules accept by default
+	policy := config.PrefixPolicy{Import: []*config.PolicyRule{{Prefix: "/ndn"}}}
+	require.NoError(t, policy.Parse())
+	require.Equal(t, config.PolicyAccept, policy.Import[0].Action)
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	tu.SetT(t)
+
+	cost := func(c uint64) *uint64 { return &c }
+	policy := config.PrefixPolicy{
+		Export: []*config.PolicyRule{
+			{Prefix: "/ndn/private", Action: config.PolicyDeny},
+			{Prefix: "/ndn", AddCost: 1},
+		},
+		Import: []*config.PolicyRule{
+			{Prefix: "/ndn/app/secret", Action: config.PolicyDeny},
+			{Prefix: "/ndn/app", Exact: true, SetCost: cost(5)},
+			{Prefix: "/ndn/app", Router: "/ndn/r2", AddCost: 10},
+			{Prefix: "/ndn", Router: "/ndn/r3", Action: config.PolicyDeny},
+			{Prefix: "/both", SetCost: cost(3), AddCost: 2},
+			{Prefix: "/big", AddCost: config.CostPfxInfinity - 1},
+		},
+	}
+	require.NoError(t, policy.Parse())
+
+	tests := []struct {
+		name   string
+		router string // empty for export
+		cost   uint64
+		accept bool
+	}{
+		// Export rules never match a router
+		{"/ndn/private/x", "", 1, false},
+		{"/ndn/app", "", 2, true},
+		{"/other", "", 1, true},
+		// The first matching rule applies
+		{"/ndn/app/secret/x", "/ndn/r2", 1, false},
+		// Exact rules only match the exact name
+		{"/ndn/app", "/ndn/r2", 5, true},
+		{"/ndn/app/x", "/ndn/r2", 11, true},
+		// Router rules only match names announced by the router
+		{"/ndn/app/x", "/ndn/r3", 1, false},
+		{"/ndn/app/x", "/ndn/r4", 1, true},
+		{"/ndn/app/x", "/ndn/r2/sub", 11, true},
+		// Costs are set before they are added, and stay below infinity
+		{"/both", "/ndn/r2", 5, true},
+		{"/big", "/ndn/r2", config.CostPfxInfinity - 1, true},
+	}
+	for _, test := range tests {
+		name := tu.NoErr(enc.NameFromStr(test.name))
+		var cost uint64
+		var accept bool
+		if test.router == "" {
+			cost, accept = policy.ExportPrefix(name, 1)
+		} else {
+			cost, accept = policy.ImportPrefix(tu.NoErr(enc.NameFromStr(test.router)), name, 1)
+		}
+		require.Equal(t, test.accept, accept, "%s from %s", test.name, test.router)
+		require.Equal(t, test.cost, cost, "%s from %s", test.name, test.router)
+	}
+
+	// Added costs do not overflow for any announced cost
+	r2 := tu.NoErr(enc.NameFromStr("/ndn/r2"))
+	for _, announced := range []uint64{config.CostPfxInfinity, math.MaxUint64} {
+		cost, accept := policy.ImportPrefix(r2, tu.NoErr(enc.NameFromStr("/big")), announced)
+		require.True(t, accept)
+		require.Equal(t, config.CostPfxInfinity-1, cost)
+	}
+}
@@ -0,0 +1,36 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/security/trust_schema"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	tu.SetT(t)
+
+	schema := tu.NoErr(trust_schema.NewLvsSchema(config.DefaultConfig().SchemaBytes()))
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+	cert := "/ndn/r1/32=DV/KEY/k/ndn/v=1"
+
+	tests := []struct {
+		pkt   string
+		cert  string
+		valid bool
+	}{
+		{"/localhop/ndn/r1/32=DV/32=ADV/32=SYNC", cert, true},
+		{"/localhop/ndn/r1/32=DV/32=ADV/32=HELLO/t=1", cert, true},
+		{"/localhop/ndn/r2/32=DV/32=ADV/32=HELLO/t=1", cert, false},
+		{"/localhop/ndn/r1/32=DV/32=ADV/32=HELLO/t=1/v=1", cert, false},
+		{"/ndn/32=DV/32=PFS/ndn/r1/t=1/seq=1", cert, true},
+		{"/ndn/32=DV/32=AREA/a/32=PFS/ndn/r1/t=1/seq=1", cert, true},
+		{"/ndn/r1/32=DV/KEY/k/ndn/v=1", "/ndn/KEY/k/self/v=1", true},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.valid, schema.Check(name(test.pkt), name(test.cert)), "%s by %s", test.pkt, test.cert)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
 	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/types/optional"
 )
 
 // Generates a versioned advertisement Data packet with a timestamped name, encoded content from the routing table, and 10-second freshness, then updates the object directory and notifies neighbors via sync interest.
@@ -21,9 +22,13 @@ func (a *advertModule) generate() {
 	name := a.dv.config.AdvertisementDataPrefix().
 		Append(enc.NewTimestampComponent(a.bootTime)).
 		WithVersion(a.seq)
+	advert := a.dv.advertisement()
+	if a.grace > 0 {
+		advert.GracePeriod = optional.Some(a.grace)
+	}
 	name, err := a.dv.client.Produce(ndn.ProduceArgs{
 		Name:            name,
-		Content:         a.dv.rib.Advert().Encode(),
+		Content:         advert.Encode(),
 		FreshnessPeriod: 10 * time.Second,
 	})
 	if err != nil {
@@ -92,5 +97,6 @@ func (a *advertModule) dataHandler(nName enc.Name, seqNo uint64, data enc.Wire)
 
 	// Update the local advertisement list
 	ns.Advert = advert
+	ns.SetGracePeriod(advert.GracePeriod)
 	go a.dv.updateRib(ns)
 }
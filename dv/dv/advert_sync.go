@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
@@ -22,6 +23,8 @@ type advertModule struct {
 	bootTime uint64
 	// advertisement sequence number for self
 	seq uint64
+	// grace period announced before a restart (ms)
+	grace uint64
 	// object directory for advertisement data
 	objDir *storage.MemoryFifoDir
 }
@@ -31,18 +34,20 @@ func (a *advertModule) String() string {
 	return "dv-advert"
 }
 
-// Sends synchronization Interests for both active (outgoing) and passive (incoming) connection prefixes to ensure network state consistency, logging and propagating any errors encountered during the process.
+// Sends synchronization Interests for both active (outgoing) and passive (incoming) connection prefixes of every area to ensure network state consistency, logging and propagating any errors encountered during the process.
 func (a *advertModule) sendSyncInterest() (err error) {
-	// Sync Interests for our outgoing connections
-	err = a.sendSyncInterestImpl(a.dv.config.AdvertisementSyncActivePrefix())
-	if err != nil {
-		log.Error(a, "Failed to send active sync interest", "err", err)
-	}
+	for _, area := range a.dv.config.RouterAreas() {
+		// Sync Interests for our outgoing connections
+		err = a.sendSyncInterestImpl(area.AdvertisementSyncActivePrefix())
+		if err != nil {
+			log.Error(a, "Failed to send active sync interest", "area", area.Name(), "err", err)
+		}
 
-	// Sync Interests for incoming connections
-	err = a.sendSyncInterestImpl(a.dv.config.AdvertisementSyncPassivePrefix())
-	if err != nil {
-		log.Error(a, "Failed to send passive sync interest", "err", err)
+		// Sync Interests for incoming connections
+		err = a.sendSyncInterestImpl(area.AdvertisementSyncPassivePrefix())
+		if err != nil {
+			log.Error(a, "Failed to send passive sync interest", "area", area.Name(), "err", err)
+		}
 	}
 
 	return err
@@ -101,8 +106,8 @@ func (a *advertModule) sendSyncInterestImpl(syncName enc.Name) (err error) {
 	return nil
 }
 
-// Handles an incoming Sync Interest by validating its signed data and processing the contained state vector to update synchronization state.
-func (a *advertModule) OnSyncInterest(args ndn.InterestHandlerArgs, active bool) {
+// Handles an incoming Sync Interest of an area by validating its signed data and processing the contained state vector to update synchronization state.
+func (a *advertModule) OnSyncInterest(args ndn.InterestHandlerArgs, area *config.Area, active bool) {
 	// If there is no incoming face ID, we can't use this
 	if !args.IncomingFaceId.IsSet() {
 		log.Warn(a, "Received Sync Interest with no incoming face ID, ignoring")
@@ -143,13 +148,13 @@ func (a *advertModule) OnSyncInterest(args ndn.InterestHandlerArgs, active bool)
 			}
 
 			// Process the state vector
-			go a.onStateVector(params.StateVector, args.IncomingFaceId.Unwrap(), active)
+			go a.onStateVector(params.StateVector, args.IncomingFaceId.Unwrap(), area, active)
 		},
 	})
 }
 
-// Processes an incoming StateVector from a neighbor, updating local neighbor state tracking and triggering FIB updates if network face changes are detected, while debouncing data fetch requests for new or updated sequence numbers.
-func (a *advertModule) onStateVector(sv *spec_svs.StateVector, faceId uint64, active bool) {
+// Processes an incoming StateVector from a neighbor in an area, updating local neighbor state tracking and triggering FIB updates if network face changes are detected, while debouncing data fetch requests for new or updated sequence numbers.
+func (a *advertModule) onStateVector(sv *spec_svs.StateVector, faceId uint64, area *config.Area, active bool) {
 	// Process each entry in the state vector
 	a.dv.mutex.Lock()
 	defer a.dv.mutex.Unlock()
@@ -157,11 +162,23 @@ func (a *advertModule) onStateVector(sv *spec_svs.StateVector, faceId uint64, ac
 	// FIB needs update if face changes for any neighbor
 	fibDirty := false
 	markRecvPing := func(ns *table.NeighborState) {
+		cost := ns.Cost()
 		err, faceDirty := ns.RecvPing(faceId, active)
 		if err != nil {
 			log.Warn(a, "Failed to update neighbor", "err", err)
 		}
 		fibDirty = fibDirty || faceDirty
+
+		// Routes through the neighbor change with the link cost,
+		// and when its advertisement is used in a new area
+		areaDirty := ns.JoinArea(area)
+		costDirty := ns.Cost() != cost
+		if costDirty {
+			log.Info(a, "Neighbor link cost change", "neighbor", ns.Name, "cost", ns.Cost(), "old", cost)
+		}
+		if costDirty || areaDirty {
+			go a.dv.updateRib(ns)
+		}
 	}
 
 	// There should only be one entry in the StateVector, but check all anyway
@@ -0,0 +1,137 @@
+package dv
+
+import (
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// routingArea is the routing state of the router in one area.
+// Routers exchange advertisements and prefixes only within an area.
+type routingArea struct {
+	// area configuration
+	config *config.Area
+	// routing information base of the area
+	rib *table.Rib
+	// prefix table of the area
+	pfx *table.PrefixTable
+	// prefix table svs instance
+	pfxSvs *ndn_sync.SvsALO
+	// prefix table svs subscriptions
+	pfxSubs map[uint64]enc.Name
+}
+
+// Log identifier for the area.
+func (a *routingArea) String() string {
+	return a.config.String()
+}
+
+// Name of the area in management datasets, none for the default area.
+func (a *routingArea) tlvName() optional.Optional[string] {
+	if name := a.config.Name(); name != "" {
+		return optional.Some(name)
+	}
+	return optional.None[string]()
+}
+
+// Creates the routing state of all areas of the router.
+func (dv *Router) createAreas() {
+	dv.areas = make([]*routingArea, 0, len(dv.config.RouterAreas()))
+	for _, areaConfig := range dv.config.RouterAreas() {
+		area := &routingArea{
+			config:  areaConfig,
+			rib:     table.NewRib(dv.config, areaConfig.Name()),
+			pfxSubs: make(map[uint64]enc.Name),
+		}
+		dv.createPrefixTable(area)
+		dv.areas = append(dv.areas, area)
+	}
+	dv.aggregates = make(map[string]uint64)
+}
+
+// Returns the routing state of an area, or nil if the router is not in the area.
+func (dv *Router) getArea(name string) *routingArea {
+	for _, area := range dv.areas {
+		if area.config.Name() == name {
+			return area
+		}
+	}
+	return nil
+}
+
+// Initializes the prefix table of an area with synchronization via SVS
+// and automatic publication of changes to the prefix table sync group.
+func (dv *Router) createPrefixTable(area *routingArea) {
+	// SVS delivery agent
+	var err error
+	area.pfxSvs, err = ndn_sync.NewSvsALO(ndn_sync.SvsAloOpts{
+		Name: dv.config.RouterName(),
+		Svs: ndn_sync.SvSyncOpts{
+			Client:      dv.client,
+			GroupPrefix: area.config.PrefixTableGroupPrefix(),
+			BootTime:    dv.advert.bootTime,
+		},
+		InitialState: dv.restart.svsState(area.config.Name()),
+		Snapshot: &ndn_sync.SnapshotNodeLatest{
+			Client: dv.client,
+			SnapMe: func(name enc.Name) (enc.Wire, error) {
+				return area.pfx.Snap(), nil
+			},
+			Threshold: PrefixSnapThreshold,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Local prefix table
+	area.pfx = table.NewPrefixTable(dv.config, func(w enc.Wire) {
+		if _, _, err := area.pfxSvs.Publish(w); err != nil {
+			log.Error(area, "Failed to publish prefix table update", "err", err)
+		}
+	})
+}
+
+// Get the advertisement of the router, with the entries of the RIB of all areas.
+func (dv *Router) advertisement() *tlv.Advertisement {
+	advert := &tlv.Advertisement{}
+	for _, area := range dv.areas {
+		advert.Entries = append(advert.Entries, area.rib.Advert().Entries...)
+	}
+	return advert
+}
+
+// Announces the aggregate prefixes of each area of a border router into its other areas.
+// An aggregate is announced while any prefix under it is reachable in its area.
+// This function must be called with the router lock held.
+func (dv *Router) updateAggregates() {
+	for _, src := range dv.areas {
+		for _, aggregate := range src.config.Aggregates() {
+			cost := src.pfx.AggregateCost(aggregate, src.rib)
+
+			key := src.config.Name() + aggregate.TlvStr()
+			if known, ok := dv.aggregates[key]; (ok && known == cost) || (!ok && cost >= config.CostPfxInfinity) {
+				continue
+			}
+			dv.aggregates[key] = cost
+
+			// Aggregates are local prefixes without a face
+			for _, dst := range dv.areas {
+				if dst == src {
+					continue
+				}
+				if cost < config.CostPfxInfinity {
+					log.Info(dst, "Announce aggregate", "name", aggregate, "from", src.config.Name(), "cost", cost)
+					dst.pfx.Announce(aggregate, 0, cost)
+				} else {
+					log.Info(dst, "Withdraw aggregate", "name", aggregate, "from", src.config.Name())
+					dst.pfx.Withdraw(aggregate, 0)
+				}
+			}
+		}
+	}
+}
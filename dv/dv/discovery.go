@@ -0,0 +1,483 @@
+package dv
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/nfdc"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// helloFreshness is the maximum difference between the timestamp of a hello
+// and the local time. It bounds the time for which a captured hello can be replayed.
+const helloFreshness = 10 * time.Second
+
+// discoveryModule discovers neighbors on the multicast faces of the forwarder.
+// Routers send a signed hello with their unicast endpoint on each multicast face,
+// and create unicast faces to the routers they hear from.
+type discoveryModule struct {
+	// parent router
+	dv *Router
+	// multicast faces with a hello route
+	faces map[uint64]*mgmt.FaceStatus
+	// discovered links by router name hash
+	links map[uint64]*discoveredLink
+	// timestamp of the last hello by router name hash
+	hellos map[uint64]uint64
+}
+
+// discoveredLink is a unicast link to a discovered neighbor.
+type discoveredLink struct {
+	// name of the neighbor router
+	router enc.Name
+	// URI of the unicast face to the neighbor
+	uri string
+	// face to the neighbor (zero while the face is created)
+	faceId uint64
+	// whether this instance created the face
+	created bool
+	// time of the last hello from the neighbor
+	lastSeen time.Time
+}
+
+// Log identifier for the discovery module.
+func (d *discoveryModule) String() string {
+	return "dv-discovery"
+}
+
+// Returns true if the neighbor was discovered on a multicast face.
+func (d *discoveryModule) isDiscovered(router enc.Name) bool {
+	return d.links[router.Hash()] != nil
+}
+
+// Prefix of the hellos sent by this router on a multicast face.
+// The route of this prefix points only to the multicast face.
+func (d *discoveryModule) helloPrefix(faceId uint64) enc.Name {
+	return d.dv.config.DiscoveryPrefix().
+		Append(d.dv.config.RouterName()...).
+		Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, faceId))
+}
+
+// Sends a hello on each multicast face of the forwarder.
+// This is a blocking call, run it in a separate goroutine.
+func (d *discoveryModule) sendHellos() {
+	faces, err := d.dv.nfdc.ListFaces()
+	if err != nil {
+		log.Warn(d, "Failed to list faces", "err", err)
+		return
+	}
+
+	multicast := make(map[uint64]*mgmt.FaceStatus)
+	for _, face := range faces {
+		if face.LinkType == mgmt.FaceLinkMultiAccess {
+			multicast[face.FaceId] = face
+		}
+	}
+
+	d.dv.mutex.Lock()
+	for faceId, face := range multicast {
+		if d.faces[faceId] == nil {
+			log.Info(d, "Discovery on multicast face", "faceid", faceId, "uri", face.Uri)
+			d.dv.nfdc.Exec(nfdc.NfdMgmtCmd{
+				Module: "rib",
+				Cmd:    "register",
+				Args: &mgmt.ControlArgs{
+					Name:   d.helloPrefix(faceId),
+					FaceId: optional.Some(faceId),
+					Origin: optional.Some(config.NlsrOrigin),
+					Cost:   optional.Some(uint64(0)),
+				},
+				Retries: 3,
+			})
+		}
+	}
+	d.faces = multicast // routes of removed faces are removed by the forwarder
+	d.dv.mutex.Unlock()
+
+	for faceId, face := range multicast {
+		endpoint, err := d.localEndpoint(face)
+		if err != nil {
+			log.Debug(d, "No unicast endpoint on multicast face", "faceid", faceId, "err", err)
+			continue
+		}
+		if err := d.sendHello(faceId, endpoint); err != nil {
+			log.Warn(d, "Failed to send hello", "faceid", faceId, "err", err)
+		}
+	}
+}
+
+// Sends a hello with the unicast endpoint of this router on a multicast face.
+func (d *discoveryModule) sendHello(faceId uint64, endpoint string) error {
+	hello := &tlv.Hello{
+		Endpoint: endpoint,
+		Area:     d.dv.getArea(d.dv.config.Discovery.Area).tlvName(),
+	}
+
+	// The hello is signed like the advertisement sync data.
+	// The timestamp prevents replays of old hellos.
+	dataName := d.dv.config.AdvertisementDataPrefix().
+		Append(enc.NewKeywordComponent("HELLO")).
+		Append(enc.NewTimestampComponent(uint64(time.Now().UnixMilli())))
+	signer := d.dv.client.SuggestSigner(dataName)
+	if signer == nil {
+		return fmt.Errorf("no signer found for %s", dataName)
+	}
+
+	dataCfg := &ndn.DataConfig{
+		ContentType: optional.Some(ndn.ContentTypeBlob),
+	}
+	data, err := d.dv.engine.Spec().MakeData(dataName, dataCfg, hello.Encode(), signer)
+	if err != nil {
+		return err
+	}
+
+	intCfg := &ndn.InterestConfig{
+		Lifetime: optional.Some(1 * time.Second),
+		Nonce:    utils.ConvertNonce(d.dv.engine.Timer().Nonce()),
+		HopLimit: utils.IdPtr(byte(2)), // use localhop w/ this
+	}
+	interest, err := d.dv.engine.Spec().MakeInterest(d.helloPrefix(faceId), intCfg, data.Wire, nil)
+	if err != nil {
+		return err
+	}
+
+	// Hello has no reply
+	return d.dv.engine.Express(interest, nil)
+}
+
+// Handles a hello from a router on a multicast face.
+func (d *discoveryModule) onHello(args ndn.InterestHandlerArgs) {
+	// If there is no incoming face ID, we can't use this
+	if !args.IncomingFaceId.IsSet() {
+		log.Warn(d, "Received hello with no incoming face ID, ignoring")
+		return
+	}
+	faceId := args.IncomingFaceId.Unwrap()
+
+	if args.Interest.AppParam() == nil {
+		log.Warn(d, "Received hello with no AppParam, ignoring")
+		return
+	}
+
+	data, sigCov, err := spec.Spec{}.ReadData(enc.NewWireView(args.Interest.AppParam()))
+	if err != nil {
+		log.Warn(d, "Failed to parse hello data", "err", err)
+		return
+	}
+
+	// /localhop/<router>/32=DV/32=ADV/32=HELLO/t=<time>
+	dname := data.Name()
+	if len(dname) < 6 || !dname[0].Equal(enc.LOCALHOP) ||
+		!dname.At(-2).Equal(enc.NewKeywordComponent("HELLO")) ||
+		!dname.At(-1).IsTimestamp() {
+		log.Warn(d, "Invalid hello data name", "name", dname)
+		return
+	}
+	router := dname[1 : len(dname)-4]
+	if router.Equal(d.dv.config.RouterName()) {
+		return // our own hello
+	}
+
+	timestamp := dname.At(-1).NumberVal()
+	if !helloFresh(timestamp, time.Now()) {
+		log.Warn(d, "Received stale hello, ignoring", "name", dname)
+		return
+	}
+
+	d.dv.client.ValidateExt(ndn.ValidateExtArgs{
+		Data:        data,
+		SigCovered:  sigCov,
+		CertNextHop: args.IncomingFaceId,
+		Callback: func(valid bool, err error) {
+			if !valid || err != nil {
+				log.Warn(d, "Failed to validate hello", "name", dname, "valid", valid, "err", err)
+				return
+			}
+
+			hello, err := tlv.ParseHello(enc.NewWireView(data.Content()), false)
+			if err != nil {
+				log.Warn(d, "Failed to parse hello", "err", err)
+				return
+			}
+
+			// Links are only discovered within the same area
+			if hello.Area.GetOr("") != d.dv.config.Discovery.Area {
+				return
+			}
+
+			go d.onValidHello(router, hello.Endpoint, faceId, timestamp)
+		},
+	})
+}
+
+// Returns true if the timestamp of a hello is close enough to the local time.
+func helloFresh(timestamp uint64, now time.Time) bool {
+	return now.Sub(time.UnixMilli(int64(timestamp))).Abs() <= helloFreshness
+}
+
+// Creates or refreshes the link to a router heard on a multicast face.
+func (d *discoveryModule) onValidHello(router enc.Name, endpoint string, faceId uint64, timestamp uint64) {
+	d.dv.mutex.Lock()
+	defer d.dv.mutex.Unlock()
+
+	face := d.faces[faceId]
+	if face == nil {
+		return // not a known multicast face
+	}
+
+	// Each hello is accepted only once, and only if it is newer than the last one
+	hash := router.Hash()
+	if timestamp <= d.hellos[hash] {
+		log.Debug(d, "Received replayed hello, ignoring", "router", router, "timestamp", timestamp)
+		return
+	}
+	d.hellos[hash] = timestamp
+
+	uri, localUri, err := d.remoteEndpoint(endpoint, face)
+	if err != nil {
+		log.Warn(d, "Invalid endpoint in hello", "router", router, "endpoint", endpoint, "err", err)
+		return
+	}
+
+	if link := d.links[hash]; link != nil {
+		if link.uri == uri {
+			link.lastSeen = time.Now()
+			return
+		}
+		log.Info(d, "Discovered neighbor endpoint change", "router", router, "uri", uri, "old", link.uri)
+		d.removeLink(hash)
+	}
+
+	// Neighbors with a configured link are not discovered
+	if ns := d.dv.neighbors.Get(router); ns != nil {
+		for _, neighbor := range d.dv.config.Neighbors {
+			if neighbor.FaceId != 0 && neighbor.FaceId == ns.FaceId() {
+				return
+			}
+		}
+	}
+
+	link := &discoveredLink{
+		router:   router.Clone(),
+		uri:      uri,
+		lastSeen: time.Now(),
+	}
+	d.links[hash] = link
+
+	// Face creation is blocking
+	go d.createLink(link, localUri)
+}
+
+// Creates the unicast face of a discovered link.
+func (d *discoveryModule) createLink(link *discoveredLink, localUri optional.Optional[string]) {
+	faceId, created, err := d.dv.nfdc.CreateFace(&mgmt.ControlArgs{
+		Uri:             optional.Some(link.uri),
+		LocalUri:        localUri,
+		FacePersistency: optional.Some(uint64(mgmt.PersistencyPersistent)),
+	})
+
+	d.dv.mutex.Lock()
+	defer d.dv.mutex.Unlock()
+
+	hash := link.router.Hash()
+	if err != nil {
+		log.Error(d, "Failed to create face to discovered neighbor", "router", link.router, "uri", link.uri, "err", err)
+		if d.links[hash] == link {
+			delete(d.links, hash) // retry on next hello
+		}
+		return
+	}
+
+	link.faceId = faceId
+	link.created = created || d.dv.restart.ownsFace(faceId)
+	if d.links[hash] != link {
+		d.destroyLink(link) // removed while the face was created
+		return
+	}
+
+	log.Info(d, "Discovered neighbor", "router", link.router, "uri", link.uri, "faceid", faceId)
+	d.dv.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "rib",
+		Cmd:    "register",
+		Args: &mgmt.ControlArgs{
+			Name:   d.dv.config.GetArea(d.dv.config.Discovery.Area).AdvertisementSyncActivePrefix(),
+			Cost:   optional.Some(uint64(1)),
+			Origin: optional.Some(config.NlsrOrigin),
+			FaceId: optional.Some(faceId),
+		},
+		Retries: 3,
+	})
+}
+
+// Removes the links of routers not heard for the router dead interval.
+// This function must be called with the router lock held.
+func (d *discoveryModule) checkDeadLinks() {
+	for hash, link := range d.links {
+		if link.faceId != 0 && time.Since(link.lastSeen) > d.dv.config.RouterDeadInterval() {
+			log.Info(d, "Discovered neighbor is dead", "router", link.router)
+			d.removeLink(hash)
+		}
+	}
+
+	// Stale hellos are rejected without the last timestamp
+	now := time.Now()
+	for hash, timestamp := range d.hellos {
+		if !helloFresh(timestamp, now) {
+			delete(d.hellos, hash)
+		}
+	}
+}
+
+// Removes the link to a discovered router, if any.
+// This function must be called with the router lock held.
+func (d *discoveryModule) removeLink(hash uint64) {
+	link := d.links[hash]
+	if link == nil {
+		return
+	}
+	delete(d.links, hash)
+
+	// The face is destroyed when its creation completes
+	if link.faceId != 0 {
+		d.destroyLink(link)
+	}
+}
+
+// Unregisters the routes of a discovered link and destroys its face.
+func (d *discoveryModule) destroyLink(link *discoveredLink) {
+	d.dv.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "rib",
+		Cmd:    "unregister",
+		Args: &mgmt.ControlArgs{
+			Name:   d.dv.config.GetArea(d.dv.config.Discovery.Area).AdvertisementSyncActivePrefix(),
+			Origin: optional.Some(config.NlsrOrigin),
+			FaceId: optional.Some(link.faceId),
+		},
+		Retries: 1,
+	})
+
+	// only destroy faces that we created
+	if link.created {
+		d.dv.nfdc.Exec(nfdc.NfdMgmtCmd{
+			Module: "faces",
+			Cmd:    "destroy",
+			Args: &mgmt.ControlArgs{
+				FaceId: optional.Some(link.faceId),
+			},
+			Retries: 1,
+		})
+	}
+}
+
+// stop synchronously removes all discovered links and hello routes.
+func (d *discoveryModule) stop() {
+	// Links are kept for the restarted router
+	if d.dv.restart.graceful {
+		return
+	}
+
+	d.dv.mutex.Lock()
+	links, faces := d.links, d.faces
+	d.links = make(map[uint64]*discoveredLink)
+	d.faces = make(map[uint64]*mgmt.FaceStatus)
+	d.dv.mutex.Unlock()
+
+	for _, link := range links {
+		if link.faceId == 0 {
+			continue
+		}
+		d.dv.engine.ExecMgmtCmd("rib", "unregister", &mgmt.ControlArgs{
+			Name:   d.dv.config.GetArea(d.dv.config.Discovery.Area).AdvertisementSyncActivePrefix(),
+			Origin: optional.Some(config.NlsrOrigin),
+			FaceId: optional.Some(link.faceId),
+		})
+		if link.created {
+			d.dv.engine.ExecMgmtCmd("faces", "destroy", &mgmt.ControlArgs{
+				FaceId: optional.Some(link.faceId),
+			})
+		}
+	}
+
+	for faceId := range faces {
+		d.dv.engine.ExecMgmtCmd("rib", "unregister", &mgmt.ControlArgs{
+			Name:   d.helloPrefix(faceId),
+			Origin: optional.Some(config.NlsrOrigin),
+			FaceId: optional.Some(faceId),
+		})
+	}
+}
+
+// Returns the unicast endpoint of this router on the link of a multicast face.
+//
+//	udp4://192.0.2.1:56363 => udp4://192.0.2.1:<udp_port>
+//	dev://eth0             => ether://[<mac address of eth0>]
+func (d *discoveryModule) localEndpoint(face *mgmt.FaceStatus) (string, error) {
+	scheme, addr, _ := strings.Cut(face.LocalUri, "://")
+	switch scheme {
+	case "udp4", "udp6":
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", err
+		}
+		host, _, _ = strings.Cut(host, "%") // zone is local to this host
+		port := fmt.Sprint(d.dv.config.Discovery.UdpPort)
+		return scheme + "://" + net.JoinHostPort(host, port), nil
+	case "dev":
+		iface, err := net.InterfaceByName(addr)
+		if err != nil {
+			return "", err
+		}
+		if len(iface.HardwareAddr) == 0 {
+			return "", fmt.Errorf("interface %s has no hardware address", addr)
+		}
+		return fmt.Sprintf("ether://[%s]", iface.HardwareAddr), nil
+	default:
+		return "", fmt.Errorf("unsupported multicast face %s", face.LocalUri)
+	}
+}
+
+// Returns the remote and local URI of the unicast face to an endpoint of
+// a neighbor heard on a multicast face.
+func (d *discoveryModule) remoteEndpoint(endpoint string, face *mgmt.FaceStatus) (
+	uri string, localUri optional.Optional[string], err error,
+) {
+	scheme, addr, _ := strings.Cut(endpoint, "://")
+	localScheme, localAddr, _ := strings.Cut(face.LocalUri, "://")
+	switch {
+	case (scheme == "udp4" || scheme == "udp6") && scheme == localScheme:
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", localUri, err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return "", localUri, fmt.Errorf("invalid address %s", host)
+		}
+
+		// Link-local addresses use the zone of the multicast face
+		if ip.IsLinkLocalUnicast() {
+			localHost, _, err := net.SplitHostPort(localAddr)
+			if err != nil {
+				return "", localUri, err
+			}
+			if _, zone, ok := strings.Cut(localHost, "%"); ok {
+				host += "%" + zone
+			}
+		}
+		return scheme + "://" + net.JoinHostPort(host, port), localUri, nil
+	case scheme == "ether" && localScheme == "dev":
+		return endpoint, optional.Some(face.LocalUri), nil
+	default:
+		return "", localUri, fmt.Errorf("endpoint does not match face %s", face.LocalUri)
+	}
+}
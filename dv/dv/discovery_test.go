@@ -0,0 +1,168 @@
+package dv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/nfdc"
+	"github.com/named-data/ndnd/dv/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates a router with discovery enabled and without a forwarder.
+// Management commands are queued and never executed.
+func newDiscoveryRouter(t *testing.T) *Router {
+	c := testConfig("/ndn/r1")
+	c.Discovery.Enabled = true
+	c.Discovery.UdpPort = 6363
+	require.NoError(t, c.Parse())
+
+	dv := &Router{
+		config: c,
+		nfdc:   nfdc.NewNfdMgmtThread(nil),
+	}
+	dv.neighbors = table.NewNeighborTable(c, dv.nfdc)
+	dv.discovery = discoveryModule{
+		dv:     dv,
+		faces:  make(map[uint64]*mgmt.FaceStatus),
+		links:  make(map[uint64]*discoveredLink),
+		hellos: make(map[uint64]uint64),
+	}
+	dv.failover = failoverModule{dv: dv}
+	return dv
+}
+
+func TestDiscoveryLocalEndpoint(t *testing.T) {
+	tu.SetT(t)
+
+	d := &newDiscoveryRouter(t).discovery
+	tests := []struct {
+		localUri string
+		endpoint string // empty for error
+	}{
+		{"udp4://192.0.2.1:56363", "udp4://192.0.2.1:6363"},
+		{"udp6://[2001:db8::1]:56363", "udp6://[2001:db8::1]:6363"},
+		// The zone is local to this host
+		{"udp6://[fe80::1%eth0]:56363", "udp6://[fe80::1]:6363"},
+		{"udp4://192.0.2.1", ""},
+		// Interfaces need a hardware address
+		{"dev://lo", ""},
+		{"dev://ndn-no-such-interface", ""},
+		{"tcp4://192.0.2.1:6363", ""},
+	}
+	for _, test := range tests {
+		endpoint, err := d.localEndpoint(&mgmt.FaceStatus{LocalUri: test.localUri})
+		if test.endpoint == "" {
+			require.Error(t, err, test.localUri)
+		} else {
+			require.NoError(t, err, test.localUri)
+			require.Equal(t, test.endpoint, endpoint, test.localUri)
+		}
+	}
+}
+
+func TestDiscoveryRemoteEndpoint(t *testing.T) {
+	tu.SetT(t)
+
+	d := &newDiscoveryRouter(t).discovery
+	tests := []struct {
+		endpoint string
+		localUri string
+		uri      string // empty for error
+		local    string
+	}{
+		{"udp4://192.0.2.2:6363", "udp4://192.0.2.1:56363", "udp4://192.0.2.2:6363", ""},
+		{"udp6://[2001:db8::2]:6363", "udp6://[fe80::1%eth0]:56363", "udp6://[2001:db8::2]:6363", ""},
+		// Link-local addresses use the zone of the multicast face
+		{"udp6://[fe80::2]:6363", "udp6://[fe80::1%eth0]:56363", "udp6://[fe80::2%eth0]:6363", ""},
+		// Ethernet faces are created on the interface of the multicast face
+		{"ether://[02:00:00:00:00:02]", "dev://eth0", "ether://[02:00:00:00:00:02]", "dev://eth0"},
+		// The endpoint must match the multicast face
+		{"udp4://192.0.2.2:6363", "udp6://[fe80::1%eth0]:56363", "", ""},
+		{"ether://[02:00:00:00:00:02]", "udp4://192.0.2.1:56363", "", ""},
+		{"udp4://192.0.2.2:6363", "dev://eth0", "", ""},
+		{"udp4://router:6363", "udp4://192.0.2.1:56363", "", ""},
+		{"udp4://192.0.2.2", "udp4://192.0.2.1:56363", "", ""},
+	}
+	for _, test := range tests {
+		uri, localUri, err := d.remoteEndpoint(test.endpoint, &mgmt.FaceStatus{LocalUri: test.localUri})
+		if test.uri == "" {
+			require.Error(t, err, "%s on %s", test.endpoint, test.localUri)
+			continue
+		}
+		require.NoError(t, err, "%s on %s", test.endpoint, test.localUri)
+		require.Equal(t, test.uri, uri)
+		require.Equal(t, test.local, localUri.GetOr(""))
+	}
+}
+
+func TestDiscoveryLinks(t *testing.T) {
+	tu.SetT(t)
+
+	dv := newDiscoveryRouter(t)
+	d := &dv.discovery
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+	d.faces[10] = &mgmt.FaceStatus{FaceId: 10, LocalUri: "udp4://192.0.2.1:56363"}
+
+	addLink := func(router string, faceId uint64, lastSeen time.Time) *discoveredLink {
+		link := &discoveredLink{
+			router:   name(router),
+			uri:      "udp4://192.0.2.2:6363",
+			faceId:   faceId,
+			created:  true,
+			lastSeen: lastSeen,
+		}
+		d.links[link.router.Hash()] = link
+		return link
+	}
+
+	// Hellos refresh the link only if they are newer than the last one
+	now := uint64(time.Now().UnixMilli())
+	old := time.Now().Add(-time.Minute)
+	r2 := addLink("/ndn/r2", 20, old)
+	d.onValidHello(r2.router, "udp4://192.0.2.2:6363", 10, now)
+	require.True(t, r2.lastSeen.After(old))
+	r2.lastSeen = old
+	d.onValidHello(r2.router, "udp4://192.0.2.2:6363", 10, now)
+	require.Equal(t, old, r2.lastSeen)
+
+	// Hellos on unknown faces are ignored
+	d.onValidHello(r2.router, "udp4://192.0.2.2:6363", 11, now+1)
+	require.Equal(t, old, r2.lastSeen)
+
+	// Hellos are fresh within the clock offset
+	require.True(t, helloFresh(now, time.Now().Add(helloFreshness/2)))
+	require.True(t, helloFresh(now, time.Now().Add(-helloFreshness/2)))
+	require.False(t, helloFresh(now, time.Now().Add(2*helloFreshness)))
+	require.False(t, helloFresh(now, time.Now().Add(-2*helloFreshness)))
+
+	// Links without hellos are removed once their face exists
+	r3 := addLink("/ndn/r3", 0, old)
+	r4 := addLink("/ndn/r4", 40, time.Now())
+	d.hellos[r4.router.Hash()] = now - uint64(2*helloFreshness.Milliseconds())
+	d.checkDeadLinks()
+	require.False(t, d.isDiscovered(r2.router))
+	require.True(t, d.isDiscovered(r3.router))
+	require.True(t, d.isDiscovered(r4.router))
+
+	// The timestamps of stale hellos are forgotten
+	require.Contains(t, d.hellos, r2.router.Hash())
+	require.NotContains(t, d.hellos, r4.router.Hash())
+
+	// Links are removed with their neighbor
+	ns := dv.neighbors.Add(r4.router)
+	dv.removeNeighbor(ns)
+	require.False(t, d.isDiscovered(r4.router))
+
+	// Neighbors with a configured link are not discovered again after an endpoint change
+	r5 := addLink("/ndn/r5", 50, time.Now())
+	ns = dv.neighbors.Add(r5.router)
+	ns.RecvPing(60, true)
+	dv.config.Neighbors = []config.Neighbor{{Uri: "udp4://192.0.2.5:6363", FaceId: 60}}
+	d.onValidHello(r5.router, "udp4://192.0.2.5:6363", 10, now)
+	require.False(t, d.isDiscovered(r5.router))
+}
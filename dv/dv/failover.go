@@ -0,0 +1,155 @@
+package dv
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/dv/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// Delay before subscribing to face events again after a failure.
+const faceEventsRetryInterval = 10 * time.Second
+
+// failoverModule detects neighbor failures faster than the router dead interval.
+// Failed neighbors are removed immediately, so the FIB switches to the loop-free
+// alternates already in the RIB before the new routes converge.
+type failoverModule struct {
+	// parent router
+	dv *Router
+	// whether the face event subscription is active
+	running atomic.Bool
+}
+
+// Log identifier for the failover module.
+func (f *failoverModule) String() string {
+	return "dv-failover"
+}
+
+// Prefix of the face event notification stream of the forwarder.
+func faceEventsPrefix() enc.Name {
+	return enc.Name{
+		enc.LOCALHOST,
+		enc.NewGenericComponent("nfd"),
+		enc.NewGenericComponent("faces"),
+		enc.NewGenericComponent("events"),
+	}
+}
+
+// Subscribes to the face events of the forwarder, if enabled.
+func (f *failoverModule) start() {
+	if !f.dv.config.FailureDetection.FaceEvents {
+		return
+	}
+	f.running.Store(true)
+	f.watchFaceEvents(0)
+}
+
+// Stops the face event subscription.
+func (f *failoverModule) stop() {
+	f.running.Store(false)
+}
+
+// Expresses an Interest for the face event with a sequence number.
+// Zero fetches the next event published by the forwarder.
+func (f *failoverModule) watchFaceEvents(seq uint64) {
+	if !f.running.Load() {
+		return
+	}
+
+	name := faceEventsPrefix()
+	if seq > 0 {
+		name = name.Append(enc.NewSequenceNumComponent(seq))
+	}
+
+	intCfg := &ndn.InterestConfig{
+		CanBePrefix: seq == 0,
+		MustBeFresh: true,
+		Lifetime:    optional.Some(60 * time.Second),
+		Nonce:       utils.ConvertNonce(f.dv.engine.Timer().Nonce()),
+	}
+	interest, err := f.dv.engine.Spec().MakeInterest(name, intCfg, nil, nil)
+	if err != nil {
+		log.Warn(f, "Failed to make face events Interest", "err", err)
+		return
+	}
+
+	err = f.dv.engine.Express(interest, func(args ndn.ExpressCallbackArgs) {
+		switch args.Result {
+		case ndn.InterestResultData:
+			next := uint64(0)
+			if last := args.Data.Name().At(-1); last.IsSequenceNum() {
+				next = last.NumberVal() + 1
+			}
+			if ev, err := mgmt.ParseFaceEventNotification(enc.NewWireView(args.Data.Content()), true); err == nil && ev.Val != nil {
+				f.onFaceEvent(ev.Val)
+			} else {
+				log.Warn(f, "Failed to parse face event", "err", err)
+			}
+			go f.watchFaceEvents(next)
+
+		case ndn.InterestResultTimeout:
+			// Events may have been missed, restart from the next event
+			go f.watchFaceEvents(0)
+
+		default:
+			// The forwarder may not publish face events
+			log.Debug(f, "Face events not available", "result", args.Result)
+			time.AfterFunc(faceEventsRetryInterval, func() { f.watchFaceEvents(0) })
+		}
+	})
+	if err != nil {
+		log.Warn(f, "Failed to express face events Interest", "err", err)
+	}
+}
+
+// Fails the neighbors on a face that is down or destroyed.
+func (f *failoverModule) onFaceEvent(ev *mgmt.FaceEventNotificationValue) {
+	if ev.FaceEventKind != mgmt.FaceEventDestroyed && ev.FaceEventKind != mgmt.FaceEventDown {
+		return
+	}
+
+	f.dv.mutex.Lock()
+	defer f.dv.mutex.Unlock()
+
+	for _, ns := range f.dv.neighbors.GetAll() {
+		if ns.FaceId() == ev.FaceId {
+			log.Info(f, "Neighbor face is down", "router", ns.Name, "faceid", ev.FaceId)
+			f.failNeighbor(ns)
+		}
+	}
+}
+
+// Sends a failure detection probe to every neighbor.
+// A neighbor fails after losing the configured number of consecutive probes.
+func (f *failoverModule) sendProbes() {
+	f.dv.mutex.Lock()
+	names := make([]enc.Name, 0, f.dv.neighbors.Size())
+	for _, ns := range f.dv.neighbors.GetAll() {
+		names = append(names, ns.Name)
+	}
+	f.dv.mutex.Unlock()
+
+	interval := f.dv.config.FailureDetection.ProbeInterval()
+	for _, name := range names {
+		f.dv.expressLinkProbe(name, interval, func(ns *table.NeighborState, _ time.Duration, lost bool) {
+			if ns.RecvFailureProbe(lost) {
+				log.Info(f, "Neighbor lost failure detection probes", "router", ns.Name)
+				f.failNeighbor(ns)
+			}
+		})
+	}
+}
+
+// Removes a failed neighbor and switches the FIB to the remaining paths.
+// This function must be called with the router lock held.
+func (f *failoverModule) failNeighbor(ns *table.NeighborState) {
+	if f.dv.removeNeighbor(ns) {
+		go f.dv.postUpdateRib()
+	}
+}
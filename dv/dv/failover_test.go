@@ -0,0 +1,38 @@
+package dv
+
+import (
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailoverFaceEvent(t *testing.T) {
+	tu.SetT(t)
+
+	dv := newDiscoveryRouter(t)
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+	for i, router := range []string{"/ndn/r2", "/ndn/r3", "/ndn/r4"} {
+		dv.neighbors.Add(name(router)).RecvPing(uint64(20+i), true)
+	}
+	event := func(kind uint64, faceId uint64) {
+		dv.failover.onFaceEvent(&mgmt.FaceEventNotificationValue{FaceEventKind: kind, FaceId: faceId})
+	}
+
+	// Created and up faces do not change the neighbors
+	event(mgmt.FaceEventCreated, 20)
+	event(mgmt.FaceEventUp, 20)
+	require.NotNil(t, dv.neighbors.Get(name("/ndn/r2")))
+
+	// Neighbors fail when their face goes down or is destroyed
+	event(mgmt.FaceEventDown, 20)
+	require.Nil(t, dv.neighbors.Get(name("/ndn/r2")))
+	event(mgmt.FaceEventDestroyed, 21)
+	require.Nil(t, dv.neighbors.Get(name("/ndn/r3")))
+
+	// Other faces are ignored
+	event(mgmt.FaceEventDestroyed, 99)
+	require.NotNil(t, dv.neighbors.Get(name("/ndn/r4")))
+}
@@ -0,0 +1,105 @@
+package dv
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/dv/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// linkProbePrefix is the prefix of link probe Interests to a router.
+func linkProbePrefix(router enc.Name) enc.Name {
+	return enc.LOCALHOP.
+		Append(router...).
+		Append(enc.NewKeywordComponent("DV")).
+		Append(enc.NewKeywordComponent("ADV")).
+		Append(enc.NewKeywordComponent("PING"))
+}
+
+// Sends a link probe to every neighbor to measure the RTT and loss of the link.
+// The result updates the dynamic link cost of the neighbor.
+func (dv *Router) sendLinkProbes() {
+	dv.mutex.Lock()
+	names := make([]enc.Name, 0, dv.neighbors.Size())
+	for _, ns := range dv.neighbors.GetAll() {
+		names = append(names, ns.Name)
+	}
+	dv.mutex.Unlock()
+
+	for _, name := range names {
+		dv.sendLinkProbe(name)
+	}
+}
+
+// Sends a single link probe to a neighbor.
+func (dv *Router) sendLinkProbe(nName enc.Name) {
+	dv.expressLinkProbe(nName, 1*time.Second, func(ns *table.NeighborState, rtt time.Duration, lost bool) {
+		old := ns.Cost()
+		if ns.RecvProbe(rtt, lost) {
+			log.Info(dv, "Neighbor link cost change", "neighbor", nName, "cost", ns.Cost(), "old", old)
+			go dv.updateRib(ns)
+		}
+	})
+}
+
+// Expresses a probe to a neighbor with the given lifetime.
+// The result is called with the router lock held, if the neighbor still exists.
+func (dv *Router) expressLinkProbe(
+	nName enc.Name,
+	lifetime time.Duration,
+	result func(ns *table.NeighborState, rtt time.Duration, lost bool),
+) {
+	// Unique name so the probe is never answered from a cache
+	name := linkProbePrefix(nName).
+		Append(enc.NewTimestampComponent(uint64(time.Now().UnixMicro())))
+
+	intCfg := &ndn.InterestConfig{
+		Lifetime:    optional.Some(lifetime),
+		Nonce:       utils.ConvertNonce(dv.engine.Timer().Nonce()),
+		MustBeFresh: true,
+		HopLimit:    utils.IdPtr(byte(2)), // use localhop w/ this
+	}
+	interest, err := dv.engine.Spec().MakeInterest(name, intCfg, nil, nil)
+	if err != nil {
+		log.Warn(dv, "Failed to make link probe", "err", err)
+		return
+	}
+
+	start := time.Now()
+	err = dv.engine.Express(interest, func(args ndn.ExpressCallbackArgs) {
+		rtt := time.Since(start)
+		lost := args.Result != ndn.InterestResultData
+
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+
+		ns := dv.neighbors.Get(nName)
+		if ns == nil {
+			return
+		}
+		result(ns, rtt, lost)
+	})
+	if err != nil {
+		log.Warn(dv, "Failed to express link probe", "err", err)
+	}
+}
+
+// Replies to a link probe from a neighbor.
+func (dv *Router) onLinkProbe(args ndn.InterestHandlerArgs) {
+	cfg := &ndn.DataConfig{
+		ContentType: optional.Some(ndn.ContentTypeBlob),
+		Freshness:   optional.Some(time.Millisecond),
+	}
+
+	data, err := dv.engine.Spec().MakeData(args.Interest.Name(), cfg, nil, nil)
+	if err != nil {
+		log.Warn(dv, "Failed to make link probe reply", "err", err)
+		return
+	}
+
+	args.Reply(data.Wire)
+}
@@ -24,11 +24,25 @@ func (dv *Router) mgmtOnInterest(args ndn.InterestHandlerArgs) {
 
 	log.Trace(dv, "Received management Interest", "name", name)
 
+	// Segments of a dataset that was already produced
+	if segment, _ := dv.mgmtStore.Get(name, false); segment != nil {
+		args.Reply(enc.Wire{segment})
+		return
+	}
+
 	switch name[pfxLen].String() {
 	case "status":
 		dv.mgmtOnStatus(args)
 	case "rib":
 		dv.mgmtOnRib(args)
+	case "neighbors":
+		dv.mgmtOnNeighbors(args)
+	case "prefixes":
+		dv.mgmtOnPrefixes(args)
+	case "fib":
+		dv.mgmtOnFib(args)
+	case "route":
+		dv.mgmtOnRoute(args)
 	default:
 		log.Warn(dv, "Unknown management command", "name", name)
 	}
@@ -39,13 +53,22 @@ func (dv *Router) mgmtOnStatus(args ndn.InterestHandlerArgs) {
 	status := func() tlv.Status {
 		dv.mutex.Lock()
 		defer dv.mutex.Unlock()
+		var nRibEntries, nExportDenied, nImportDenied uint64
+		for _, area := range dv.areas {
+			nExport, nImport := area.pfx.DeniedCount()
+			nRibEntries += uint64(area.rib.Size())
+			nExportDenied += nExport
+			nImportDenied += nImport
+		}
 		return tlv.Status{
-			Version:     utils.NDNdVersion,
-			NetworkName: &tlv.Destination{Name: dv.config.NetworkName()},
-			RouterName:  &tlv.Destination{Name: dv.config.RouterName()},
-			NRibEntries: uint64(dv.rib.Size()),
-			NNeighbors:  uint64(dv.neighbors.Size()),
-			NFibEntries: uint64(dv.fib.Size()),
+			Version:       utils.NDNdVersion,
+			NetworkName:   &tlv.Destination{Name: dv.config.NetworkName()},
+			RouterName:    &tlv.Destination{Name: dv.config.RouterName()},
+			NRibEntries:   nRibEntries,
+			NNeighbors:    uint64(dv.neighbors.Size()),
+			NFibEntries:   uint64(dv.fib.Size()),
+			NExportDenied: nExportDenied,
+			NImportDenied: nImportDenied,
 		}
 	}()
 
@@ -119,11 +142,16 @@ func (dv *Router) mgmtOnRib(args ndn.InterestHandlerArgs) {
 	dv.mutex.Lock()
 	defer dv.mutex.Unlock()
 
+	// Local prefixes are announced in all areas of the router
 	switch cmd.String() {
 	case "register":
-		dv.pfx.Announce(name, face, cost)
+		for _, area := range dv.areas {
+			area.pfx.Announce(name, face, cost)
+		}
 	case "unregister":
-		dv.pfx.Withdraw(name, face)
+		for _, area := range dv.areas {
+			area.pfx.Withdraw(name, face)
+		}
 	default:
 		log.Warn(dv, "Unknown readvertise cmd", "cmd", cmd)
 		return
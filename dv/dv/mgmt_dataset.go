@@ -0,0 +1,267 @@
+package dv
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object"
+	sig "github.com/named-data/ndnd/std/security/signer"
+)
+
+// Replies to a dataset Interest with the first segment of a new version of the dataset.
+// Other segments are served from the management store.
+func (dv *Router) mgmtSendDataset(args ndn.InterestHandlerArgs, name enc.Name, dataset enc.Wire) {
+	objName, err := object.Produce(ndn.ProduceArgs{
+		Name:            name.WithVersion(enc.VersionUnixMicro),
+		Content:         dataset,
+		FreshnessPeriod: time.Millisecond,
+		NoMetadata:      true,
+	}, dv.mgmtStore, sig.NewSha256Signer())
+	if err != nil {
+		log.Warn(dv, "Unable to produce dataset", "err", err)
+		return
+	}
+	dv.mgmtDir.Push(objName)
+
+	// Evict oldest object if we have too many
+	if old := dv.mgmtDir.Pop(); old != nil {
+		if err := dv.mgmtStore.RemovePrefix(old); err != nil {
+			log.Warn(dv, "Unable to clean up old dataset", "err", err)
+		}
+	}
+
+	segment, err := dv.mgmtStore.Get(objName.Append(enc.NewSegmentComponent(0)), false)
+	if err != nil || segment == nil {
+		log.Warn(dv, "Unable to get first segment of dataset", "err", err)
+		return
+	}
+	args.Reply(enc.Wire{segment})
+}
+
+// Serves the neighbor dataset, with the face, sync state and link cost of each neighbor.
+func (dv *Router) mgmtOnNeighbors(args ndn.InterestHandlerArgs) {
+	dataset := func() *tlv.NeighborsDataset {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+
+		dataset := &tlv.NeighborsDataset{}
+		for _, ns := range dv.neighbors.GetAll() {
+			dataset.Neighbors = append(dataset.Neighbors, &tlv.NeighborStatus{
+				Name:       &tlv.Destination{Name: ns.Name},
+				FaceId:     ns.FaceId(),
+				FaceActive: ns.IsFaceActive(),
+				LastSeen:   uint64(ns.LastSeen().UnixMilli()),
+				AdvertBoot: ns.AdvertBoot,
+				AdvertSeq:  ns.AdvertSeq,
+				Cost:       ns.Cost(),
+				Discovered: dv.discovery.isDiscovered(ns.Name),
+			})
+		}
+		slices.SortFunc(dataset.Neighbors, func(a, b *tlv.NeighborStatus) int {
+			return a.Name.Name.Compare(b.Name.Name)
+		})
+		return dataset
+	}()
+
+	dv.mgmtSendDataset(args, dv.mgmtDatasetName(args, 1), dataset.Encode())
+}
+
+// Serves the global prefix table, with the exit routers of each prefix.
+func (dv *Router) mgmtOnPrefixes(args ndn.InterestHandlerArgs) {
+	dataset := func() *tlv.PrefixTableDataset {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+
+		prefixes := make(map[string]*tlv.PrefixStatus)
+		for _, area := range dv.areas {
+			for router := range area.pfx.Routers() {
+				for hash, entry := range router.Prefixes {
+					status := prefixes[hash]
+					if status == nil {
+						status = &tlv.PrefixStatus{Name: entry.Name}
+						prefixes[hash] = status
+					}
+					status.Exits = append(status.Exits, &tlv.PrefixExit{
+						Router: &tlv.Destination{Name: router.Name},
+						Cost:   entry.Cost,
+						Denied: entry.Denied,
+						Area:   area.tlvName(),
+					})
+				}
+			}
+		}
+
+		dataset := &tlv.PrefixTableDataset{}
+		for _, status := range prefixes {
+			slices.SortFunc(status.Exits, func(a, b *tlv.PrefixExit) int {
+				return cmp.Or(
+					cmp.Compare(a.Area.GetOr(""), b.Area.GetOr("")),
+					a.Router.Name.Compare(b.Router.Name))
+			})
+			dataset.Prefixes = append(dataset.Prefixes, status)
+		}
+		slices.SortFunc(dataset.Prefixes, func(a, b *tlv.PrefixStatus) int {
+			return a.Name.Compare(b.Name)
+		})
+		return dataset
+	}()
+
+	dv.mgmtSendDataset(args, dv.mgmtDatasetName(args, 1), dataset.Encode())
+}
+
+// Serves the FIB entries computed by the router.
+func (dv *Router) mgmtOnFib(args ndn.InterestHandlerArgs) {
+	dataset := func() *tlv.FibDataset {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+
+		dataset := &tlv.FibDataset{}
+		for name, entries := range dv.fib.Entries() {
+			status := &tlv.FibStatus{Name: name}
+			for _, entry := range entries {
+				status.NextHops = append(status.NextHops, &tlv.FibNextHop{
+					FaceId: entry.FaceId,
+					Cost:   entry.Cost,
+				})
+			}
+			slices.SortFunc(status.NextHops, func(a, b *tlv.FibNextHop) int {
+				return cmp.Compare(a.Cost, b.Cost)
+			})
+			dataset.Entries = append(dataset.Entries, status)
+		}
+		slices.SortFunc(dataset.Entries, func(a, b *tlv.FibStatus) int {
+			return a.Name.Compare(b.Name)
+		})
+		return dataset
+	}()
+
+	dv.mgmtSendDataset(args, dv.mgmtDatasetName(args, 1), dataset.Encode())
+}
+
+// Explains the route to a name, i.e. the longest matching prefix in the prefix table,
+// its exit routers and the next hops to each exit router from the RIB.
+// The Interest name is /localhost/nlsr/route/<name TLV>.
+func (dv *Router) mgmtOnRoute(args ndn.InterestHandlerArgs) {
+	iname := args.Interest.Name()
+	pfxLen := len(dv.config.MgmtPrefix())
+	if len(iname) < pfxLen+2 {
+		log.Warn(dv, "Invalid route Interest", "name", iname)
+		return
+	}
+
+	name, err := enc.NameFromBytes(iname[pfxLen+1].Val)
+	if err != nil {
+		log.Warn(dv, "Invalid name in route Interest", "err", err)
+		return
+	}
+
+	dataset := func() *tlv.RouteExplanation {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+		return dv.explainRoute(name)
+	}()
+
+	dv.mgmtSendDataset(args, dv.mgmtDatasetName(args, 2), dataset.Encode())
+}
+
+// explainRoute finds the exit routers of the longest prefix matching a name
+// in the prefix tables of all areas.
+func (dv *Router) explainRoute(name enc.Name) *tlv.RouteExplanation {
+	explanation := &tlv.RouteExplanation{Name: name}
+
+	// Find the longest matching prefix
+	var prefix enc.Name
+	for _, area := range dv.areas {
+		for router := range area.pfx.Routers() {
+			for _, entry := range router.Prefixes {
+				if entry.Name.IsPrefix(name) && (prefix == nil || len(entry.Name) > len(prefix)) {
+					prefix = entry.Name
+				}
+			}
+		}
+	}
+	if prefix == nil {
+		return explanation
+	}
+	explanation.Prefix = &tlv.Destination{Name: prefix}
+
+	for _, area := range dv.areas {
+		explanation.Exits = append(explanation.Exits, dv.explainAreaRoute(area, prefix)...)
+	}
+
+	// Usable exit routers first, in order of total cost
+	usable := func(e *tlv.RouteExit) bool { return e.Reachable && !e.Denied }
+	slices.SortFunc(explanation.Exits, func(a, b *tlv.RouteExit) int {
+		if ua, ub := usable(a), usable(b); ua != ub {
+			if ua {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.RouterCost+a.PrefixCost, b.RouterCost+b.PrefixCost)
+	})
+
+	return explanation
+}
+
+// explainAreaRoute lists the exit routers of a prefix in an area,
+// with the next hops to each exit router from the RIB of the area.
+func (dv *Router) explainAreaRoute(area *routingArea, prefix enc.Name) (exits []*tlv.RouteExit) {
+	hash := prefix.TlvStr()
+	for router := range area.pfx.Routers() {
+		entry := router.Prefixes[hash]
+		if entry == nil {
+			continue
+		}
+
+		exit := &tlv.RouteExit{
+			Router:     &tlv.Destination{Name: router.Name},
+			PrefixCost: entry.Cost,
+			Denied:     entry.Denied,
+			Area:       area.tlvName(),
+		}
+		exits = append(exits, exit)
+
+		// Local prefixes are reached through the registered faces
+		if router.Name.Equal(dv.config.RouterName()) {
+			exit.Reachable = true
+			for _, nh := range entry.NextHops {
+				exit.NextHops = append(exit.NextHops, &tlv.RouteHop{
+					FaceId: nh.Face,
+					Cost:   nh.Cost,
+				})
+			}
+			continue
+		}
+
+		ribEntry := area.rib.Get(router.Name)
+		if ribEntry == nil {
+			continue
+		}
+		exit.Reachable = true
+		exit.RouterCost = ribEntry.Cost()
+		for hop, cost := range ribEntry.Paths() {
+			routeHop := &tlv.RouteHop{
+				Neighbor: &tlv.Destination{Name: hop},
+				Cost:     cost,
+			}
+			if ns := dv.neighbors.Get(hop); ns != nil {
+				routeHop.FaceId = ns.FaceId()
+			}
+			exit.NextHops = append(exit.NextHops, routeHop)
+		}
+	}
+
+	return exits
+}
+
+// mgmtDatasetName is the name of a dataset requested by an Interest,
+// i.e. the management prefix and the given number of components after it.
+func (dv *Router) mgmtDatasetName(args ndn.InterestHandlerArgs, n int) enc.Name {
+	return args.Interest.Name().Prefix(len(dv.config.MgmtPrefix()) + n)
+}
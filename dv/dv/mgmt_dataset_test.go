@@ -0,0 +1,95 @@
+package dv
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(router string) *config.Config {
+	c := config.DefaultConfig()
+	c.Network = "/ndn"
+	c.Router = router
+	return c
+}
+
+func TestExplainRoute(t *testing.T) {
+	tu.SetT(t)
+
+	c := testConfig("/ndn/r1")
+	c.Policy.Import = []*config.PolicyRule{
+		{Prefix: "/ndn", Router: "/ndn/r4", Action: config.PolicyDeny},
+	}
+	require.NoError(t, c.Parse())
+
+	area := &routingArea{
+		config: c.RouterAreas()[0],
+		rib:    table.NewRib(c, ""),
+		pfx:    table.NewPrefixTable(c, func(enc.Wire) {}),
+	}
+	dv := &Router{
+		config:    c,
+		areas:     []*routingArea{area},
+		neighbors: table.NewNeighborTable(c, nil),
+	}
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+
+	// r2 and r4 are neighbors, r3 is reached through r2, r5 is unreachable
+	area.rib.Set(c.RouterName(), c.RouterName(), 0, 0)
+	area.rib.Set(name("/ndn/r2"), name("/ndn/r2"), 1, 0)
+	area.rib.Set(name("/ndn/r3"), name("/ndn/r2"), 3, 0)
+	area.rib.Set(name("/ndn/r4"), name("/ndn/r4"), 1, 0)
+
+	announce := func(router string, prefix string, cost uint64) {
+		rc := testConfig(router)
+		require.NoError(t, rc.Parse())
+		table.NewPrefixTable(rc, func(w enc.Wire) { area.pfx.Apply(w) }).Announce(name(prefix), 1, cost)
+	}
+	area.pfx.Announce(name("/ndn/app"), 7, 2)
+	announce("/ndn/r2", "/ndn/app", 5)
+	announce("/ndn/r3", "/ndn/app", 1)
+	announce("/ndn/r3", "/ndn/app/video", 10)
+	announce("/ndn/r4", "/ndn/app", 1)
+	announce("/ndn/r5", "/ndn/app", 7)
+
+	routers := func(exits []*tlv.RouteExit) (names []string) {
+		for _, exit := range exits {
+			names = append(names, exit.Router.Name.String())
+		}
+		return names
+	}
+
+	// Names without a matching prefix have no route
+	explanation := dv.explainRoute(name("/other/x"))
+	require.Nil(t, explanation.Prefix)
+	require.Empty(t, explanation.Exits)
+
+	// The longest matching prefix is explained
+	explanation = dv.explainRoute(name("/ndn/app/video/1"))
+	require.Equal(t, name("/ndn/app/video"), explanation.Prefix.Name)
+	require.Equal(t, []string{"/ndn/r3"}, routers(explanation.Exits))
+	require.Equal(t, uint64(13), explanation.Exits[0].RouterCost+explanation.Exits[0].PrefixCost)
+
+	// Usable exits come first in order of total cost,
+	// followed by denied and unreachable exits
+	explanation = dv.explainRoute(name("/ndn/app/x"))
+	require.Equal(t, name("/ndn/app"), explanation.Prefix.Name)
+	require.Equal(t, []string{"/ndn/r1", "/ndn/r3", "/ndn/r2", "/ndn/r4", "/ndn/r5"}, routers(explanation.Exits))
+
+	local, viaR2 := explanation.Exits[0], explanation.Exits[1]
+	require.True(t, local.Reachable)
+	require.Equal(t, uint64(7), local.NextHops[0].FaceId)
+	require.Equal(t, uint64(3), viaR2.RouterCost)
+	require.Equal(t, "/ndn/r2", viaR2.NextHops[0].Neighbor.Name.String())
+
+	denied, unreachable := explanation.Exits[3], explanation.Exits[4]
+	require.True(t, denied.Reachable)
+	require.True(t, denied.Denied)
+	require.False(t, unreachable.Reachable)
+	require.Empty(t, unreachable.NextHops)
+}
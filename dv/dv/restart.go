@@ -0,0 +1,273 @@
+package dv
+
+import (
+	"os"
+	"time"
+
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+)
+
+// Time to let neighbors fetch the advertisement with the grace period on shutdown.
+const gracefulDrainTime = 1 * time.Second
+
+// restartModule persists the routing state across a planned restart.
+// On shutdown, the router announces a grace period to its neighbors and saves
+// its state. On start, the state is restored as stale, and swept after all
+// neighbors have resynchronized or the grace period has ended.
+type restartModule struct {
+	// parent router
+	dv *Router
+	// state loaded from the state file, until restored
+	state *tlv.RouterState
+	// faces created by the previous instance
+	faces map[uint64]bool
+	// neighbors with stale routes in the restored RIB
+	stale map[uint64]enc.Name
+	// timer to sweep the stale state (nil when swept)
+	timer *time.Timer
+	// whether the router is shutting down for a restart
+	graceful bool
+}
+
+// Log identifier for the restart module.
+func (r *restartModule) String() string {
+	return "dv-restart"
+}
+
+// Loads the state saved by the previous instance, if graceful restart is enabled.
+// The advertisement boot time and sequence number continue from the state.
+func (r *restartModule) load() {
+	if !r.dv.config.GracefulRestart.Enabled {
+		return
+	}
+
+	file := r.dv.config.GracefulRestart.StateFile
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn(r, "Failed to read state file", "file", file, "err", err)
+		}
+		return
+	}
+
+	// The state is used only once. If the router fails after this, it restarts
+	// with a new boot time, so the sequence numbers never go back.
+	if err := os.Remove(file); err != nil {
+		log.Warn(r, "Failed to remove state file", "file", file, "err", err)
+		return
+	}
+
+	state, err := tlv.ParseRouterState(enc.NewBufferView(buf), true)
+	if err != nil {
+		log.Warn(r, "Failed to parse state file", "file", file, "err", err)
+		return
+	}
+	if state.RouterName == nil || !state.RouterName.Name.Equal(r.dv.config.RouterName()) {
+		log.Warn(r, "State file is for another router", "file", file)
+		return
+	}
+
+	// Neighbors have dropped the routes after the grace period
+	age := time.Since(time.UnixMilli(int64(state.Timestamp)))
+	if age > r.dv.config.GracefulRestart.GracePeriod() {
+		log.Info(r, "State file is older than the grace period", "file", file, "age", age)
+		return
+	}
+
+	r.state = state
+	for _, faceId := range state.CreatedFaces {
+		r.faces[faceId] = true
+	}
+	r.dv.advert.bootTime = state.BootTime
+	r.dv.advert.seq = state.AdvertSeq
+}
+
+// Returns the saved state of the prefix table sync group of an area, if any.
+func (r *restartModule) svsState(area string) enc.Wire {
+	if r.state == nil {
+		return nil
+	}
+	for _, as := range r.state.Areas {
+		if as.Area.GetOr("") == area {
+			return as.SvsState
+		}
+	}
+	return nil
+}
+
+// Returns true if the previous instance created the face.
+func (r *restartModule) ownsFace(faceId uint64) bool {
+	return r.faces[faceId]
+}
+
+// Restores the loaded RIB, prefix tables and FIB as stale state.
+// Returns true if the state was restored.
+func (r *restartModule) restore() bool {
+	r.dv.mutex.Lock()
+	defer r.dv.mutex.Unlock()
+
+	state := r.state
+	if state == nil {
+		return false
+	}
+	r.state = nil
+
+	for _, as := range state.Areas {
+		area := r.dv.getArea(as.Area.GetOr(""))
+		if area == nil {
+			continue
+		}
+
+		for _, rec := range as.Rib {
+			if rec.Destination == nil || rec.NextHop == nil {
+				continue
+			}
+			area.rib.Set(rec.Destination.Name, rec.NextHop.Name, rec.Cost, rec.Dist)
+			if !rec.NextHop.Name.Equal(r.dv.config.RouterName()) {
+				r.stale[rec.NextHop.Name.Hash()] = rec.NextHop.Name
+			}
+		}
+
+		for _, rec := range as.Prefixes {
+			area.pfx.Restore(rec)
+		}
+	}
+
+	// The FIB entries are still installed in the forwarder
+	for _, fs := range state.Fib {
+		entries := make([]table.FibEntry, 0, len(fs.NextHops))
+		for _, nh := range fs.NextHops {
+			entries = append(entries, table.FibEntry{
+				FaceId: nh.FaceId,
+				Cost:   nh.Cost,
+			})
+		}
+		r.dv.fib.Restore(fs.Name, entries)
+	}
+
+	log.Info(r, "Restored routing state", "boot", state.BootTime, "seq", state.AdvertSeq,
+		"neighbors", len(r.stale), "fib", len(state.Fib))
+
+	r.timer = time.AfterFunc(r.dv.config.GracefulRestart.GracePeriod(), r.sweep)
+	if len(r.stale) == 0 {
+		go r.sweep()
+	}
+	return true
+}
+
+// Call this when the advertisement of a neighbor is applied to the RIB.
+// The stale state is swept once all neighbors of the restored RIB have resynchronized.
+// This function must be called with the router lock held.
+func (r *restartModule) resync(ns *table.NeighborState) {
+	if r.timer == nil {
+		return
+	}
+
+	delete(r.stale, ns.Name.Hash())
+	if len(r.stale) == 0 {
+		go r.sweep()
+	}
+}
+
+// Removes the stale routes through neighbors that did not resynchronize,
+// and the stale FIB entries that are not computed from the new RIB.
+func (r *restartModule) sweep() {
+	r.dv.mutex.Lock()
+	if r.timer == nil {
+		r.dv.mutex.Unlock()
+		return // already swept
+	}
+	r.timer.Stop()
+	r.timer = nil
+
+	for _, name := range r.stale {
+		log.Info(r, "Neighbor did not resynchronize", "router", name)
+		for _, area := range r.dv.areas {
+			area.rib.RemoveNextHop(name)
+		}
+	}
+	for _, area := range r.dv.areas {
+		area.rib.Prune()
+	}
+	clear(r.stale)
+
+	// The FIB update removes unmarked entries
+	r.dv.fib.ClearStale()
+	r.dv.mutex.Unlock()
+
+	log.Info(r, "Swept stale routing state")
+	r.dv.postUpdateRib()
+}
+
+// Announces the grace period to neighbors and saves the routing state.
+// Faces and routes in the forwarder are kept for the restarted router.
+// This is a blocking call.
+func (r *restartModule) shutdown() {
+	r.dv.mutex.Lock()
+	r.graceful = true
+	r.dv.advert.grace = r.dv.config.GracefulRestart.GracePeriod_ms
+	r.dv.mutex.Unlock()
+
+	r.dv.advert.generate()
+	if err := r.save(); err != nil {
+		log.Error(r, "Failed to save state file", "err", err)
+	}
+
+	// Let neighbors fetch the advertisement
+	time.Sleep(gracefulDrainTime)
+}
+
+// Saves the routing state to the state file.
+func (r *restartModule) save() error {
+	r.dv.mutex.Lock()
+	state := &tlv.RouterState{
+		RouterName: &tlv.Destination{Name: r.dv.config.RouterName()},
+		Timestamp:  uint64(time.Now().UnixMilli()),
+		BootTime:   r.dv.advert.bootTime,
+		AdvertSeq:  r.dv.advert.seq,
+	}
+	for _, area := range r.dv.areas {
+		state.Areas = append(state.Areas, &tlv.AreaState{
+			Area:     area.tlvName(),
+			Rib:      area.rib.Records(),
+			Prefixes: area.pfx.Records(),
+			SvsState: area.pfxSvs.InstanceState(),
+		})
+	}
+	for name, fes := range r.dv.fib.Entries() {
+		fs := &tlv.FibStatus{Name: name}
+		for _, fe := range fes {
+			fs.NextHops = append(fs.NextHops, &tlv.FibNextHop{
+				FaceId: fe.FaceId,
+				Cost:   fe.Cost,
+			})
+		}
+		state.Fib = append(state.Fib, fs)
+	}
+	for _, neighbor := range r.dv.config.Neighbors {
+		if neighbor.Created {
+			state.CreatedFaces = append(state.CreatedFaces, neighbor.FaceId)
+		}
+	}
+	for _, link := range r.dv.discovery.links {
+		if link.created {
+			state.CreatedFaces = append(state.CreatedFaces, link.faceId)
+		}
+	}
+	r.dv.mutex.Unlock()
+
+	// Replace the file atomically
+	file := r.dv.config.GracefulRestart.StateFile
+	if err := os.WriteFile(file+".tmp", state.Encode().Join(), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(file+".tmp", file); err != nil {
+		return err
+	}
+
+	log.Info(r, "Saved routing state", "file", file, "seq", state.AdvertSeq)
+	return nil
+}
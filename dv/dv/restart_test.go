@@ -0,0 +1,136 @@
+package dv
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates a router on a dummy face, without running it.
+// Management commands are queued and never executed.
+func newRestartRouter(t *testing.T, c *config.Config) *Router {
+	engine := engine.NewBasicEngine(face.NewDummyFace())
+	require.NoError(t, engine.Start())
+	dv := tu.NoErr(NewRouter(c, engine))
+	require.NoError(t, dv.client.Start())
+	t.Cleanup(func() {
+		dv.client.Stop()
+		engine.Stop()
+	})
+	return dv
+}
+
+func TestRestartRestore(t *testing.T) {
+	tu.SetT(t)
+
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+	r2, r3 := name("/ndn/r2"), name("/ndn/r3")
+
+	c := testConfig("/ndn/r1")
+	c.KeyChainUri = "insecure"
+	c.GracefulRestart.Enabled = true
+	c.GracefulRestart.StateFile = filepath.Join(t.TempDir(), "dv.state")
+
+	// The previous instance routes through two neighbors
+	prev := newRestartRouter(t, c)
+	area := prev.areas[0]
+	area.rib.Set(prev.config.RouterName(), prev.config.RouterName(), 0, 0)
+	area.rib.Set(r2, r2, 1, 0)
+	area.rib.Set(r3, r3, 1, 0)
+	prev.neighbors.Add(r2).RecvPing(20, true)
+	prev.neighbors.Add(r3).RecvPing(30, true)
+	area.pfx.Announce(name("/ndn/r1/app"), 40, 1)
+	prev.advert.seq = 7
+	prev.updateFib()
+	require.Equal(t, 2, prev.fib.Size())
+	require.NoError(t, prev.restart.save())
+
+	// The state file is loaded only once, and continues the advertisements
+	dv := newRestartRouter(t, c)
+	require.NoFileExists(t, c.GracefulRestart.StateFile)
+	require.Equal(t, prev.advert.bootTime, dv.advert.bootTime)
+	require.Equal(t, uint64(7), dv.advert.seq)
+
+	// The RIB, prefix table and FIB are restored as stale
+	require.True(t, dv.restart.restore())
+	require.False(t, dv.restart.restore())
+	rib := dv.areas[0].rib
+	require.True(t, rib.Has(r2))
+	require.True(t, rib.Has(r3))
+	require.Len(t, dv.areas[0].pfx.GetRouter(c.RouterName()).Prefixes, 1)
+	require.Equal(t, 2, dv.fib.Size())
+	require.Len(t, dv.restart.stale, 2)
+
+	// The state is not swept until all neighbors resynchronize
+	ns := dv.neighbors.Add(r2)
+	ns.RecvPing(20, true)
+	dv.mutex.Lock()
+	dv.restart.resync(ns)
+	dv.mutex.Unlock()
+	require.Len(t, dv.restart.stale, 1)
+	require.NotNil(t, dv.restart.timer)
+
+	// Routes through neighbors that did not resynchronize are swept,
+	// with the FIB entries that are no longer computed
+	dv.restart.sweep()
+	require.Nil(t, dv.restart.timer)
+	require.Empty(t, dv.restart.stale)
+	require.True(t, rib.Has(r2))
+	require.False(t, rib.Has(r3))
+	require.Equal(t, 1, dv.fib.Size())
+	for _, entries := range dv.fib.Entries() {
+		require.Len(t, entries, 1)
+		require.Equal(t, uint64(20), entries[0].FaceId)
+	}
+
+	// Sweeping again has no effect
+	dv.restart.sweep()
+	require.Equal(t, 1, dv.fib.Size())
+}
+
+func TestRestartLoad(t *testing.T) {
+	tu.SetT(t)
+
+	c := testConfig("/ndn/r1")
+	c.KeyChainUri = "insecure"
+	c.GracefulRestart.Enabled = true
+	c.GracefulRestart.StateFile = filepath.Join(t.TempDir(), "dv.state")
+	prev := newRestartRouter(t, c)
+	prev.advert.seq = 7
+
+	// State files older than the grace period are ignored
+	require.NoError(t, prev.restart.save())
+	c.GracefulRestart.GracePeriod_ms = 1
+	time.Sleep(10 * time.Millisecond)
+	dv := newRestartRouter(t, c)
+	require.NoFileExists(t, c.GracefulRestart.StateFile)
+	require.Zero(t, dv.advert.seq)
+	require.False(t, dv.restart.restore())
+
+	// State files of another router are ignored
+	c.GracefulRestart.GracePeriod_ms = 60000
+	require.NoError(t, prev.restart.save())
+	other := testConfig("/ndn/r2")
+	other.KeyChainUri = "insecure"
+	other.GracefulRestart = c.GracefulRestart
+	dv = newRestartRouter(t, other)
+	require.Zero(t, dv.advert.seq)
+	require.False(t, dv.restart.restore())
+
+	// Without stale neighbors, the restored state is swept immediately
+	require.NoError(t, prev.restart.save())
+	dv = newRestartRouter(t, c)
+	require.True(t, dv.restart.restore())
+	require.Eventually(t, func() bool {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+		return dv.restart.timer == nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -16,7 +16,6 @@ import (
 	sec "github.com/named-data/ndnd/std/security"
 	"github.com/named-data/ndnd/std/security/keychain"
 	"github.com/named-data/ndnd/std/security/trust_schema"
-	ndn_sync "github.com/named-data/ndnd/std/sync"
 	"github.com/named-data/ndnd/std/types/optional"
 	"github.com/named-data/ndnd/std/utils"
 )
@@ -46,20 +45,27 @@ type Router struct {
 
 	// advertisement module
 	advert advertModule
-
-	// prefix table
-	pfx *table.PrefixTable
-	// prefix table svs instance
-	pfxSvs *ndn_sync.SvsALO
-	// prefix table svs subscriptions
-	pfxSubs map[uint64]enc.Name
+	// neighbor discovery module
+	discovery discoveryModule
+	// fast failure detection module
+	failover failoverModule
+	// graceful restart module
+	restart restartModule
+
+	// routing state of each area
+	areas []*routingArea
+	// announced cost of each aggregate prefix
+	aggregates map[string]uint64
 
 	// neighbor table
 	neighbors *table.NeighborTable
-	// routing information base
-	rib *table.Rib
 	// forwarding table
 	fib *table.Fib
+
+	// store for management datasets
+	mgmtStore ndn.Store
+	// recently produced management datasets
+	mgmtDir *storage.MemoryFifoDir
 }
 
 // Create a new DV router.
@@ -104,6 +110,9 @@ func NewRouter(config *config.Config, engine ndn.Engine) (*Router, error) {
 		client: object.NewClient(engine, store, trust),
 		nfdc:   nfdc.NewNfdMgmtThread(engine),
 		mutex:  sync.Mutex{},
+
+		mgmtStore: storage.NewMemoryStore(),
+		mgmtDir:   storage.NewMemoryFifoDir(16), // keep last few datasets
 	}
 
 	// Initialize advertisement module
@@ -114,12 +123,30 @@ func NewRouter(config *config.Config, engine ndn.Engine) (*Router, error) {
 		objDir:   storage.NewMemoryFifoDir(32), // keep last few advertisements
 	}
 
-	// Create prefix table
-	dv.createPrefixTable()
+	// Initialize neighbor discovery module
+	dv.discovery = discoveryModule{
+		dv:     dv,
+		faces:  make(map[uint64]*mgmt.FaceStatus),
+		links:  make(map[uint64]*discoveredLink),
+		hellos: make(map[uint64]uint64),
+	}
+
+	// Initialize failure detection module
+	dv.failover = failoverModule{dv: dv}
+
+	// Load the state of a graceful restart before the sync groups are created
+	dv.restart = restartModule{
+		dv:    dv,
+		faces: make(map[uint64]bool),
+		stale: make(map[uint64]enc.Name),
+	}
+	dv.restart.load()
+
+	// Create RIB and prefix table of each area
+	dv.createAreas()
 
 	// Create DV tables
 	dv.neighbors = table.NewNeighborTable(config, dv.nfdc)
-	dv.rib = table.NewRib(config)
 	dv.fib = table.NewFib(config, dv.nfdc)
 
 	return dv, nil
@@ -161,29 +188,66 @@ func (dv *Router) Start() (err error) {
 		return err
 	}
 
+	// Restore the routing state of a graceful restart
+	restored := dv.restart.restore()
+
 	// Register interest handlers
 	if err = dv.register(); err != nil {
 		return err
 	}
+	defer dv.discovery.stop()
+
+	// Watch for neighbor faces going down
+	dv.failover.start()
+	defer dv.failover.stop()
+
+	// Probe neighbors for fast failure detection
+	var probe <-chan time.Time
+	if dv.config.FailureDetection.ProbeInterval_ms > 0 {
+		ticker := time.NewTicker(dv.config.FailureDetection.ProbeInterval())
+		defer ticker.Stop()
+		probe = ticker.C
+	}
 
 	// Start sync groups
-	dv.pfxSvs.Start()
-	defer dv.pfxSvs.Stop()
+	for _, area := range dv.areas {
+		area.pfxSvs.Start()
+		defer area.pfxSvs.Stop()
+	}
 
-	// Add self to the RIB and make initial advertisement
-	dv.rib.Set(dv.config.RouterName(), dv.config.RouterName(), 0)
+	// Add self to the RIB of each area and make initial advertisement
+	for _, area := range dv.areas {
+		area.rib.Set(dv.config.RouterName(), dv.config.RouterName(), 0, 0)
+	}
 	dv.advert.generate()
 
-	// Initialize prefix table
-	dv.pfx.Reset()
+	// Initialize prefix tables
+	if restored {
+		dv.updatePrefixSubs()
+	} else {
+		for _, area := range dv.areas {
+			area.pfx.Reset()
+		}
+	}
 
 	for {
 		select {
 		case <-dv.heartbeat.C:
 			dv.advert.sendSyncInterest()
+			if dv.config.LinkCost.Dynamic {
+				go dv.sendLinkProbes()
+			}
+			if dv.config.Discovery.Enabled {
+				go dv.discovery.sendHellos()
+			}
 		case <-dv.deadcheck.C:
 			dv.checkDeadNeighbors()
+		case <-probe:
+			go dv.failover.sendProbes()
 		case <-dv.stop:
+			if dv.config.GracefulRestart.Enabled {
+				dv.restart.shutdown()
+			}
 			return nil
 		}
 	}
@@ -212,19 +276,30 @@ func (dv *Router) configureFace() (err error) {
 
 // Register interest handlers for DV prefixes.
 func (dv *Router) register() (err error) {
-	// Advertisement Sync (active)
-	err = dv.engine.AttachHandler(dv.config.AdvertisementSyncActivePrefix(),
-		func(args ndn.InterestHandlerArgs) {
-			go dv.advert.OnSyncInterest(args, true)
-		})
-	if err != nil {
-		return err
+	for _, area := range dv.areas {
+		// Advertisement Sync (active)
+		err = dv.engine.AttachHandler(area.config.AdvertisementSyncActivePrefix(),
+			func(args ndn.InterestHandlerArgs) {
+				go dv.advert.OnSyncInterest(args, area.config, true)
+			})
+		if err != nil {
+			return err
+		}
+
+		// Advertisement Sync (passive)
+		err = dv.engine.AttachHandler(area.config.AdvertisementSyncPassivePrefix(),
+			func(args ndn.InterestHandlerArgs) {
+				go dv.advert.OnSyncInterest(args, area.config, false)
+			})
+		if err != nil {
+			return err
+		}
 	}
 
-	// Advertisement Sync (passive)
-	err = dv.engine.AttachHandler(dv.config.AdvertisementSyncPassivePrefix(),
+	// Link probes from neighbors (for dynamic link costs)
+	err = dv.engine.AttachHandler(linkProbePrefix(dv.config.RouterName()),
 		func(args ndn.InterestHandlerArgs) {
-			go dv.advert.OnSyncInterest(args, false)
+			go dv.onLinkProbe(args)
 		})
 	if err != nil {
 		return err
@@ -239,14 +314,31 @@ func (dv *Router) register() (err error) {
 		return err
 	}
 
+	// Hellos from neighbors on multicast faces
+	if dv.config.Discovery.Enabled {
+		err = dv.engine.AttachHandler(dv.config.DiscoveryPrefix(),
+			func(args ndn.InterestHandlerArgs) {
+				go dv.discovery.onHello(args)
+			})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Register routes to forwarder
 	pfxs := []enc.Name{
-		dv.config.AdvertisementSyncPrefix(),
 		dv.config.AdvertisementDataPrefix(),
-		dv.pfxSvs.SyncPrefix(),
-		dv.pfxSvs.DataPrefix(),
 		dv.config.MgmtPrefix(),
 	}
+	if dv.config.Discovery.Enabled {
+		pfxs = append(pfxs, dv.config.DiscoveryPrefix())
+	}
+	for _, area := range dv.areas {
+		pfxs = append(pfxs,
+			area.config.AdvertisementSyncPrefix(),
+			area.pfxSvs.SyncPrefix(),
+			area.pfxSvs.DataPrefix())
+	}
 	for _, prefix := range pfxs {
 		dv.nfdc.Exec(nfdc.NfdMgmtCmd{
 			Module: "rib",
@@ -261,9 +353,11 @@ func (dv *Router) register() (err error) {
 	}
 
 	// Set strategy to multicast for sync prefixes
-	pfxs = []enc.Name{
-		dv.config.AdvertisementSyncPrefix(),
-		dv.pfxSvs.SyncPrefix(),
+	pfxs = []enc.Name{}
+	for _, area := range dv.areas {
+		pfxs = append(pfxs,
+			area.config.AdvertisementSyncPrefix(),
+			area.pfxSvs.SyncPrefix())
 	}
 	for _, prefix := range pfxs {
 		dv.nfdc.Exec(nfdc.NfdMgmtCmd{
@@ -303,14 +397,14 @@ func (dv *Router) createFaces() {
 
 		dv.mutex.Lock()
 		dv.config.Neighbors[i].FaceId = faceId
-		dv.config.Neighbors[i].Created = created
+		dv.config.Neighbors[i].Created = created || dv.restart.ownsFace(faceId)
 		dv.mutex.Unlock()
 
 		dv.nfdc.Exec(nfdc.NfdMgmtCmd{
 			Module: "rib",
 			Cmd:    "register",
 			Args: &mgmt.ControlArgs{
-				Name:   dv.config.AdvertisementSyncActivePrefix(),
+				Name:   dv.config.GetArea(neighbor.Area).AdvertisementSyncActivePrefix(),
 				Cost:   optional.Some(uint64(1)),
 				Origin: optional.Some(config.NlsrOrigin),
 				FaceId: optional.Some(faceId),
@@ -322,13 +416,18 @@ func (dv *Router) createFaces() {
 
 // destroyFaces synchronously destroys our faces to neighbors.
 func (dv *Router) destroyFaces() {
+	// Faces are kept for the restarted router
+	if dv.restart.graceful {
+		return
+	}
+
 	for _, neighbor := range dv.config.Neighbors {
 		if neighbor.FaceId == 0 {
 			continue
 		}
 
 		dv.engine.ExecMgmtCmd("rib", "unregister", &mgmt.ControlArgs{
-			Name:   dv.config.AdvertisementSyncActivePrefix(),
+			Name:   dv.config.GetArea(neighbor.Area).AdvertisementSyncActivePrefix(),
 			Origin: optional.Some(config.NlsrOrigin),
 			FaceId: optional.Some(neighbor.FaceId),
 		})
@@ -341,37 +440,3 @@ func (dv *Router) destroyFaces() {
 		}
 	}
 }
-
-// Initializes the router's prefix table with subscription tracking, synchronization via SVS (Synchronization with Versioning and Sync) for group updates, and automatic publication of changes through a configured client.
-func (dv *Router) createPrefixTable() {
-	// Subscription list
-	dv.pfxSubs = make(map[uint64]enc.Name)
-
-	// SVS delivery agent
-	var err error
-	dv.pfxSvs, err = ndn_sync.NewSvsALO(ndn_sync.SvsAloOpts{
-		Name: dv.config.RouterName(),
-		Svs: ndn_sync.SvSyncOpts{
-			Client:      dv.client,
-			GroupPrefix: dv.config.PrefixTableGroupPrefix(),
-			BootTime:    dv.advert.bootTime,
-		},
-		Snapshot: &ndn_sync.SnapshotNodeLatest{
-			Client: dv.client,
-			SnapMe: func(name enc.Name) (enc.Wire, error) {
-				return dv.pfx.Snap(), nil
-			},
-			Threshold: PrefixSnapThreshold,
-		},
-	})
-	if err != nil {
-		panic(err)
-	}
-
-	// Local prefix table
-	dv.pfx = table.NewPrefixTable(dv.config, func(w enc.Wire) {
-		if _, _, err := dv.pfxSvs.Publish(w); err != nil {
-			log.Error(dv, "Failed to publish prefix table update", "err", err)
-		}
-	})
-}
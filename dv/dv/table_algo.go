@@ -1,7 +1,6 @@
 package dv
 
 import (
-	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
@@ -26,39 +25,18 @@ func (dv *Router) updateRib(ns *table.NeighborState) {
 		return
 	}
 
-	// TODO: use cost to neighbor
-	localCost := uint64(1)
-
 	// Trigger our own advertisement if needed
 	var dirty bool = false
 
-	// Reset destinations for this neighbor
-	dv.rib.DirtyResetNextHop(ns.Name)
-
-	for _, entry := range ns.Advert.Entries {
-		// Use the advertised cost by default
-		cost := entry.Cost + localCost
-
-		// Poison reverse - try other cost if next hop is us
-		if entry.NextHop.Name.Equal(dv.config.RouterName()) {
-			if entry.OtherCost < config.CostInfinity {
-				cost = entry.OtherCost + localCost
-			} else {
-				cost = config.CostInfinity
-			}
-		}
-
-		// Skip unreachable destinations
-		if cost >= config.CostInfinity {
-			continue
+	// The advertisement is used in the areas of the link to the neighbor
+	for _, area := range dv.areas {
+		if ns.InArea(area.config.Name()) {
+			dirty = area.rib.ApplyAdvert(ns.Name, ns.Advert, ns.Cost()) || dirty
 		}
-
-		// Check advertisement changes
-		dirty = dv.rib.Set(entry.Destination.Name, ns.Name, cost) || dirty
 	}
 
-	// Drop dead entries
-	dirty = dv.rib.Prune() || dirty
+	// The restored state is swept after all neighbors resynchronize
+	dv.restart.resync(ns)
 
 	// If advert changed, increment sequence number
 	if dirty {
@@ -76,21 +54,35 @@ func (dv *Router) checkDeadNeighbors() {
 		// Check if the neighbor is entirely dead
 		if ns.IsDead() {
 			log.Info(dv, "Neighbor is dead", "router", ns.Name)
-
-			// This is the ONLY place that can remove neighbors
-			dv.neighbors.Remove(ns.Name)
-
-			// Remove neighbor from RIB and prune
-			dirty = dv.rib.RemoveNextHop(ns.Name) || dirty
-			dirty = dv.rib.Prune() || dirty
+			dirty = dv.removeNeighbor(ns) || dirty
 		}
 	}
 
+	// Tear down discovered links without hellos
+	dv.discovery.checkDeadLinks()
+
 	if dirty {
 		go dv.postUpdateRib()
 	}
 }
 
+// removeNeighbor removes a neighbor and all routes through it.
+// This is the ONLY place that can remove neighbors.
+// This function must be called with the router lock held.
+func (dv *Router) removeNeighbor(ns *table.NeighborState) (dirty bool) {
+	dv.neighbors.Remove(ns.Name)
+
+	// Tear down the face if the neighbor was discovered
+	dv.discovery.removeLink(ns.Name.Hash())
+
+	// Remove neighbor from the RIB of each area and prune
+	for _, area := range dv.areas {
+		dirty = area.rib.RemoveNextHop(ns.Name) || dirty
+		dirty = area.rib.Prune() || dirty
+	}
+	return dirty
+}
+
 // updateFib synchronizes the FIB with the RIB.
 func (dv *Router) updateFib() {
 	log.Debug(dv, "Sychronizing updates to forwarding table")
@@ -98,6 +90,9 @@ func (dv *Router) updateFib() {
 	dv.mutex.Lock()
 	defer dv.mutex.Unlock()
 
+	// Border routers announce aggregates of each area into the other areas
+	dv.updateAggregates()
+
 	// Name prefixes from global prefix table as well as RIB
 	names := make(map[uint64]enc.Name)
 	fibEntries := make(map[uint64][]table.FibEntry)
@@ -114,26 +109,33 @@ func (dv *Router) updateFib() {
 		}
 	}
 
-	// Update paths to all routers from RIB
-	for hash, router := range dv.rib.Entries() {
-		// Skip if this is us
-		if router.Name().Equal(dv.config.RouterName()) {
-			continue
-		}
+	// Update paths to all routers from the RIB of each area
+	for _, area := range dv.areas {
+		for hash, router := range area.rib.Entries() {
+			// Skip if this is us
+			if router.Name().Equal(dv.config.RouterName()) {
+				continue
+			}
 
-		// Get FIB entry to reach this router
-		fes := dv.rib.GetFibEntries(dv.neighbors, hash)
+			// Get FIB entry to reach this router
+			fes := area.rib.GetFibEntries(dv.neighbors, hash)
 
-		// Add entry for the router's prefix sync group prefix
-		proute := dv.config.PrefixTableGroupPrefix().
-			Append(router.Name()...)
-		register(proute, fes, 0)
+			// Add entry for the router's prefix sync group prefix
+			proute := area.config.PrefixTableGroupPrefix().
+				Append(router.Name()...)
+			register(proute, fes, 0)
 
-		// Add entries to all prefixes announced by this router
-		for _, prefix := range dv.pfx.GetRouter(router.Name()).Prefixes {
-			// Use the same nexthop entries as the exit router itself
-			// De-duplication is done by the fib table update function
-			register(prefix.Name, fes, prefix.Cost)
+			// Add entries to all prefixes announced by this router
+			for _, prefix := range area.pfx.GetRouter(router.Name()).Prefixes {
+				// Skip prefixes denied by the import policy
+				if prefix.Denied {
+					continue
+				}
+
+				// Use the same nexthop entries as the exit router itself
+				// De-duplication is done by the fib table update function
+				register(prefix.Name, fes, prefix.Cost)
+			}
 		}
 	}
 
@@ -152,22 +154,29 @@ func (dv *Router) updatePrefixSubs() {
 	dv.mutex.Lock()
 	defer dv.mutex.Unlock()
 
+	for _, area := range dv.areas {
+		dv.updateAreaPrefixSubs(area)
+	}
+}
+
+// updateAreaPrefixSubs updates the prefix table subscriptions of an area
+func (dv *Router) updateAreaPrefixSubs(area *routingArea) {
 	// Get all prefixes from the RIB
-	for hash, router := range dv.rib.Entries() {
+	for hash, router := range area.rib.Entries() {
 		if router.Name().Equal(dv.config.RouterName()) {
 			continue
 		}
 
-		if _, ok := dv.pfxSubs[hash]; !ok {
-			log.Info(dv, "Router is now reachable", "name", router.Name())
-			dv.pfxSubs[hash] = router.Name()
+		if _, ok := area.pfxSubs[hash]; !ok {
+			log.Info(area, "Router is now reachable", "name", router.Name())
+			area.pfxSubs[hash] = router.Name()
 
-			dv.pfxSvs.SubscribePublisher(router.Name(), func(sp sync.SvsPub) {
+			area.pfxSvs.SubscribePublisher(router.Name(), func(sp sync.SvsPub) {
 				dv.mutex.Lock()
 				defer dv.mutex.Unlock()
 
 				// Both snapshots and normal data are handled the same way
-				if dirty := dv.pfx.Apply(sp.Content); dirty {
+				if dirty := area.pfx.Apply(sp.Content); dirty {
 					// Update the local fib if prefix table changed
 					go dv.updateFib() // expensive
 				}
@@ -176,11 +185,11 @@ func (dv *Router) updatePrefixSubs() {
 	}
 
 	// Remove dead subscriptions
-	for hash, name := range dv.pfxSubs {
-		if !dv.rib.Has(name) {
-			log.Info(dv, "Router is now unreachable", "name", name)
-			dv.pfxSvs.UnsubscribePublisher(name)
-			delete(dv.pfxSubs, hash)
+	for hash, name := range area.pfxSubs {
+		if !area.rib.Has(name) {
+			log.Info(area, "Router is now unreachable", "name", name)
+			area.pfxSvs.UnsubscribePublisher(name)
+			delete(area.pfxSubs, hash)
 		}
 	}
 }
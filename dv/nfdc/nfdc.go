@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
 	"github.com/named-data/ndnd/std/ndn"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/object"
 )
 
 type NfdMgmtCmd struct {
@@ -89,3 +91,33 @@ func (m *NfdMgmtThread) CreateFace(args *mgmt.ControlArgs) (uint64, bool, error)
 
 	return faceId, created, nil
 }
+
+// ListFaces fetches the face dataset of the forwarder.
+// This is a blocking call.
+func (m *NfdMgmtThread) ListFaces() ([]*mgmt.FaceStatus, error) {
+	// consume-only client, the dataset is not validated
+	client := object.NewClient(m.engine, nil, nil)
+
+	ch := make(chan ndn.ConsumeState, 1)
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name: enc.Name{
+			enc.LOCALHOST,
+			enc.NewGenericComponent("nfd"),
+			enc.NewGenericComponent("faces"),
+			enc.NewGenericComponent("list"),
+		},
+		NoMetadata: true, // NFD has no RDR metadata
+		Callback:   func(status ndn.ConsumeState) { ch <- status },
+	})
+
+	state := <-ch
+	if err := state.Error(); err != nil {
+		return nil, err
+	}
+
+	status, err := mgmt.ParseFaceStatusMsg(enc.NewWireView(state.Content()), true)
+	if err != nil {
+		return nil, err
+	}
+	return status.Vals, nil
+}
@@ -0,0 +1,210 @@
+package table_test
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// testRouter is a router with one RIB for each of its areas.
+type testRouter struct {
+	name   enc.Name
+	config *config.Config
+	ribs   map[string]*table.Rib
+}
+
+// testLink is a link between two routers in an area.
+type testLink struct {
+	a, b string
+	area string
+	cost uint64
+}
+
+// Constructs a router in the /ndn network with a RIB for each area.
+func newTestRouter(name string, areas ...string) *testRouter {
+	c := config.DefaultConfig()
+	c.Network = "/ndn"
+	c.Router = "/ndn/" + name
+	c.Areas = areas
+	if err := c.Parse(); err != nil {
+		panic(err)
+	}
+
+	r := &testRouter{
+		name:   c.RouterName(),
+		config: c,
+		ribs:   make(map[string]*table.Rib),
+	}
+	for _, area := range c.RouterAreas() {
+		rib := table.NewRib(c, area.Name())
+		rib.Set(r.name, r.name, 0, 0)
+		r.ribs[area.Name()] = rib
+	}
+	return r
+}
+
+// Returns the advertisement of the router, with the entries of all areas.
+func (r *testRouter) advert() *tlv.Advertisement {
+	advert := &tlv.Advertisement{}
+	for _, rib := range r.ribs {
+		advert.Entries = append(advert.Entries, rib.Advert().Entries...)
+	}
+	return advert
+}
+
+// Exchanges advertisements over all links until no RIB changes.
+// Advertisements are encoded and parsed as they are on the wire.
+func converge(t *testing.T, routers map[string]*testRouter, links []testLink) {
+	for round := 0; ; round++ {
+		require.Less(t, round, 64, "routing did not converge")
+
+		dirty := false
+		for _, link := range links {
+			for _, dir := range [][2]string{{link.a, link.b}, {link.b, link.a}} {
+				src, dst := routers[dir[0]], routers[dir[1]]
+				advert := tu.NoErr(tlv.ParseAdvertisement(enc.NewWireView(src.advert().Encode()), false))
+				dirty = dst.ribs[link.area].ApplyAdvert(src.name, advert, link.cost) || dirty
+			}
+		}
+		if !dirty {
+			return
+		}
+	}
+}
+
+// Returns the cost to a router in an area, or -1 if it is unreachable.
+func routeCost(r *testRouter, area string, dest string) int {
+	entry := r.ribs[area].Get(enc.Name{enc.NewGenericComponent("ndn"), enc.NewGenericComponent(dest)})
+	if entry == nil {
+		return -1
+	}
+	return int(entry.Cost())
+}
+
+// Two areas joined by the border router r3:
+//
+//	area a: r1 -1- r2 -1- r3, r1 -5- r3
+//	area b: r3 -2- r4 -1- r5
+func TestAreaConvergence(t *testing.T) {
+	tu.SetT(t)
+
+	routers := map[string]*testRouter{
+		"r1": newTestRouter("r1", "a"),
+		"r2": newTestRouter("r2", "a"),
+		"r3": newTestRouter("r3", "a", "b"),
+		"r4": newTestRouter("r4", "b"),
+		"r5": newTestRouter("r5", "b"),
+	}
+	links := []testLink{
+		{"r1", "r2", "a", 1},
+		{"r2", "r3", "a", 1},
+		{"r1", "r3", "a", 5},
+		{"r3", "r4", "b", 2},
+		{"r4", "r5", "b", 1},
+	}
+	converge(t, routers, links)
+
+	// Shortest paths within each area
+	require.Equal(t, 1, routeCost(routers["r1"], "a", "r2"))
+	require.Equal(t, 2, routeCost(routers["r1"], "a", "r3"))
+	require.Equal(t, 2, routeCost(routers["r3"], "b", "r4"))
+	require.Equal(t, 3, routeCost(routers["r3"], "b", "r5"))
+	require.Equal(t, 3, routeCost(routers["r5"], "b", "r3"))
+
+	// The border router reaches both areas in separate RIBs
+	require.Equal(t, 2, routeCost(routers["r3"], "a", "r1"))
+	require.Equal(t, -1, routeCost(routers["r3"], "a", "r4"))
+	require.Equal(t, -1, routeCost(routers["r3"], "b", "r1"))
+
+	// Routers are not reachable across areas
+	require.Equal(t, -1, routeCost(routers["r1"], "a", "r4"))
+	require.Equal(t, -1, routeCost(routers["r1"], "a", "r5"))
+	require.Equal(t, -1, routeCost(routers["r5"], "b", "r1"))
+	require.Equal(t, -1, routeCost(routers["r4"], "b", "r2"))
+	require.Equal(t, 3, routers["r1"].ribs["a"].Size())
+	require.Equal(t, 3, routers["r5"].ribs["b"].Size())
+
+	// Failure of the link r2-r3 moves r1 to the direct link
+	links = []testLink{
+		{"r1", "r2", "a", 1},
+		{"r1", "r3", "a", 5},
+		{"r3", "r4", "b", 2},
+		{"r4", "r5", "b", 1},
+	}
+	routers["r2"].ribs["a"].RemoveNextHop(routers["r3"].name)
+	routers["r2"].ribs["a"].Prune()
+	routers["r3"].ribs["a"].RemoveNextHop(routers["r2"].name)
+	routers["r3"].ribs["a"].Prune()
+	converge(t, routers, links)
+
+	require.Equal(t, 5, routeCost(routers["r1"], "a", "r3"))
+	require.Equal(t, 6, routeCost(routers["r2"], "a", "r3"))
+	require.Equal(t, 3, routeCost(routers["r5"], "b", "r3"))
+}
+
+// Routers without areas use the default area, with untagged advertisement entries.
+func TestDefaultAreaConvergence(t *testing.T) {
+	tu.SetT(t)
+
+	routers := map[string]*testRouter{
+		"r1": newTestRouter("r1"),
+		"r2": newTestRouter("r2"),
+		"r3": newTestRouter("r3"),
+	}
+	links := []testLink{
+		{"r1", "r2", "", 1},
+		{"r2", "r3", "", 3},
+	}
+	converge(t, routers, links)
+
+	require.Equal(t, 4, routeCost(routers["r1"], "", "r3"))
+	require.Equal(t, 4, routeCost(routers["r3"], "", "r1"))
+	for _, entry := range routers["r1"].advert().Entries {
+		require.False(t, entry.Area.IsSet())
+	}
+}
+
+// Aggregates are reachable while a more specific prefix under them is reachable.
+func TestAggregateCost(t *testing.T) {
+	tu.SetT(t)
+
+	routers := map[string]*testRouter{
+		"r1": newTestRouter("r1", "a"),
+		"r2": newTestRouter("r2", "a", "b"),
+	}
+	converge(t, routers, []testLink{{"r1", "r2", "a", 3}})
+
+	// Prefix table of r2 in area a, with updates from r1
+	r2 := routers["r2"]
+	r2pfx := table.NewPrefixTable(r2.config, func(enc.Wire) {})
+	r1pfx := table.NewPrefixTable(routers["r1"].config, func(w enc.Wire) {
+		r2pfx.Apply(w)
+	})
+
+	aggregate := tu.NoErr(enc.NameFromStr("/ndn/site"))
+	rib := r2.ribs["a"]
+	require.Equal(t, config.CostPfxInfinity, r2pfx.AggregateCost(aggregate, rib))
+
+	// The aggregate itself is never summarized again
+	r1pfx.Announce(aggregate, 1, 0)
+	require.Equal(t, config.CostPfxInfinity, r2pfx.AggregateCost(aggregate, rib))
+
+	// More specific prefixes use the route to the exit router
+	r1pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/site/app")), 1, 2)
+	r1pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/site/web")), 1, 1)
+	r1pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/other/app")), 1, 0)
+	require.Equal(t, uint64(4), r2pfx.AggregateCost(aggregate, rib))
+
+	r1pfx.Withdraw(tu.NoErr(enc.NameFromStr("/ndn/site/web")), 1)
+	require.Equal(t, uint64(5), r2pfx.AggregateCost(aggregate, rib))
+
+	// Unreachable exit routers are not used
+	rib.RemoveNextHop(routers["r1"].name)
+	rib.Prune()
+	require.Equal(t, config.CostPfxInfinity, r2pfx.AggregateCost(aggregate, rib))
+}
@@ -1,6 +1,9 @@
 package table
 
 import (
+	"iter"
+	"slices"
+
 	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/nfdc"
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -21,19 +24,17 @@ type FibEntry struct {
 // router should be hash of the router name.
 func (rib *Rib) GetFibEntries(nt *NeighborTable, router uint64) (entries []FibEntry) {
 	ribEntry := rib.entries[router]
-	entries = make([]FibEntry, 0, 2)
-
-	if ns := nt.GetH(ribEntry.nextHop1); ns != nil && ribEntry.lowest1 < config.CostInfinity {
-		entries = append(entries, FibEntry{
-			FaceId: ns.faceId,
-			Cost:   ribEntry.lowest1,
-		})
-	}
-	if ns := nt.GetH(ribEntry.nextHop2); ns != nil && ribEntry.lowest2 < config.CostInfinity {
-		entries = append(entries, FibEntry{
-			FaceId: ns.faceId,
-			Cost:   ribEntry.lowest2,
-		})
+	entries = make([]FibEntry, 0, len(ribEntry.paths))
+
+	// All loop-free next hops are installed with their costs,
+	// so that the forwarding strategy can balance load across them.
+	for _, hop := range ribEntry.paths {
+		if ns := nt.GetH(hop); ns != nil {
+			entries = append(entries, FibEntry{
+				FaceId: ns.faceId,
+				Cost:   ribEntry.costs[hop],
+			})
+		}
 	}
 
 	return entries
@@ -45,6 +46,8 @@ type Fib struct {
 	names    map[uint64]enc.Name
 	prefixes map[uint64][]FibEntry
 	mark     map[uint64]bool
+	// entries restored after a restart, kept until swept
+	stale map[uint64][]FibEntry
 }
 
 // Constructs a new Fib instance with the provided configuration and NFD management thread, initializing internal maps for name entries, prefix routes, and marking flags.
@@ -55,6 +58,7 @@ func NewFib(config *config.Config, nfdc *nfdc.NfdMgmtThread) *Fib {
 		names:    make(map[uint64]enc.Name),
 		prefixes: make(map[uint64][]FibEntry),
 		mark:     make(map[uint64]bool),
+		stale:    make(map[uint64][]FibEntry),
 	}
 }
 
@@ -63,6 +67,17 @@ func (fib *Fib) Size() int {
 	return len(fib.prefixes)
 }
 
+// Get all names in the FIB with their next hops.
+func (fib *Fib) Entries() iter.Seq2[enc.Name, []FibEntry] {
+	return func(yield func(enc.Name, []FibEntry) bool) {
+		for nameH, entries := range fib.prefixes {
+			if !yield(fib.names[nameH], entries) {
+				return
+			}
+		}
+	}
+}
+
 // Updates the Forwarding Information Base (FIB) entries for the specified name using the provided entries, returning true if the update was successful.
 func (fib *Fib) Update(name enc.Name, newEntries []FibEntry) bool {
 	return fib.UpdateH(name.Hash(), name, newEntries)
@@ -74,6 +89,11 @@ func (fib *Fib) UpdateH(nameH uint64, name enc.Name, newEntries []FibEntry) bool
 		fib.names[nameH] = name
 	}
 
+	// Keep stale entries until they are swept
+	if stale := fib.stale[nameH]; len(stale) > 0 {
+		newEntries = append(slices.Clip(newEntries), stale...)
+	}
+
 	// Set cost of all current entries to infinite and store existing params
 	oldEntries := fib.prefixes[nameH]
 	for oi := range oldEntries {
@@ -160,6 +180,25 @@ func (fib *Fib) UpdateH(nameH uint64, name enc.Name, newEntries []FibEntry) bool
 	}
 }
 
+// Restore a FIB entry that is still installed in the forwarder after a restart.
+// The entries are kept as stale in all updates until ClearStale is called.
+func (fib *Fib) Restore(name enc.Name, entries []FibEntry) {
+	nameH := name.Hash()
+	for i := range entries {
+		entries[i].prevCost = entries[i].Cost
+	}
+	fib.names[nameH] = name
+	fib.prefixes[nameH] = entries
+	fib.stale[nameH] = slices.Clone(entries)
+}
+
+// Stop keeping the stale entries restored after a restart.
+// The next update with UnmarkAll and RemoveUnmarked sweeps the entries that
+// are no longer computed from the RIB.
+func (fib *Fib) ClearStale() {
+	clear(fib.stale)
+}
+
 // Marks the specified name as true in the Fib's internal mark map.
 func (fib *Fib) MarkH(name uint64) {
 	fib.mark[name] = true
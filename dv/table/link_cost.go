@@ -0,0 +1,97 @@
+package table
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+)
+
+// Weight of a new sample in the moving averages of link quality.
+const linkQualityAlpha = 0.125
+
+// linkQuality is the measured quality of the link to a neighbor.
+type linkQuality struct {
+	// smoothed round-trip time
+	srtt time.Duration
+	// smoothed fraction of lost probes
+	loss float64
+	// whether any probe was answered
+	measured bool
+}
+
+// update adds a probe result to the link quality.
+func (lq *linkQuality) update(rtt time.Duration, lost bool) {
+	if lost {
+		lq.loss += linkQualityAlpha * (1 - lq.loss)
+		return
+	}
+
+	lq.loss -= linkQualityAlpha * lq.loss
+	if !lq.measured {
+		lq.srtt = rtt
+		lq.measured = true
+	} else {
+		lq.srtt += time.Duration(linkQualityAlpha * float64(rtt-lq.srtt))
+	}
+}
+
+// cost derives the link cost from the base cost and the measured quality.
+// The cost is always less than CostInfinity, so the neighbor stays reachable.
+func (lq *linkQuality) cost(base uint64, cfg *config.Config) uint64 {
+	cost := base
+	if lq.measured {
+		cost += uint64(lq.srtt / cfg.LinkCost.RttUnit())
+	}
+	cost += uint64(lq.loss*float64(cfg.LinkCost.LossPenalty) + 0.5)
+	return min(max(cost, 1), cfg.CostInfinity-1)
+}
+
+// Cost of the link to this neighbor.
+func (ns *NeighborState) Cost() uint64 {
+	return ns.cost
+}
+
+// Call this when a link probe to this neighbor completes.
+// With dynamic link costs, the cost is updated if it changed by more than the hysteresis.
+// Return => true if the link cost has changed
+func (ns *NeighborState) RecvProbe(rtt time.Duration, lost bool) bool {
+	// Probes are not answered while the neighbor restarts
+	if ns.InGrace() {
+		return false
+	}
+
+	ns.link.update(rtt, lost)
+	if !ns.nt.config.LinkCost.Dynamic {
+		return false
+	}
+
+	cost := ns.link.cost(ns.baseCost, ns.nt.config)
+	diff := max(cost, ns.cost) - min(cost, ns.cost)
+	if diff == 0 || diff*100 < ns.cost*ns.nt.config.LinkCost.Hysteresis {
+		return false
+	}
+
+	ns.cost = cost
+	return true
+}
+
+// staticCost gets the configured cost of the link on a face.
+func (nt *NeighborTable) staticCost(faceId uint64) uint64 {
+	for _, neighbor := range nt.config.Neighbors {
+		if neighbor.FaceId == faceId && neighbor.Cost > 0 {
+			return neighbor.Cost
+		}
+	}
+	return config.DefaultLinkCost
+}
+
+// updateBaseCost sets the base cost of the link after a face change.
+func (ns *NeighborState) updateBaseCost(faceId uint64) {
+	ns.baseCost = ns.nt.staticCost(faceId)
+
+	cost := ns.baseCost
+	if ns.nt.config.LinkCost.Dynamic {
+		cost = ns.link.cost(ns.baseCost, ns.nt.config)
+	}
+	ns.cost = min(cost, ns.nt.config.CostInfinity-1)
+}
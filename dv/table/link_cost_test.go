@@ -0,0 +1,88 @@
+package table_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/dv/table"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// testProbe is the result of a link probe and the expected link cost after it.
+type testProbe struct {
+	rtt     time.Duration
+	lost    bool
+	changed bool
+	cost    uint64
+}
+
+func TestLinkCost(t *testing.T) {
+	tu.SetT(t)
+
+	ms := time.Millisecond
+	tests := []struct {
+		name    string
+		dynamic bool
+		probes  []testProbe
+	}{{
+		name:    "static cost ignores probes",
+		dynamic: false,
+		probes: []testProbe{
+			{rtt: 500 * ms, cost: 1},
+			{lost: true, cost: 1},
+		},
+	}, {
+		name:    "rtt adds one per unit",
+		dynamic: true,
+		probes: []testProbe{
+			{rtt: 50 * ms, changed: true, cost: 6},
+			{rtt: 50 * ms, cost: 6},
+		},
+	}, {
+		name:    "hysteresis suppresses small changes",
+		dynamic: true,
+		probes: []testProbe{
+			{rtt: 50 * ms, changed: true, cost: 6},
+			// srtt 60ms, cost 7 is within 20% of 6
+			{rtt: 130 * ms, cost: 6},
+			// srtt 68.75ms
+			{rtt: 130 * ms, cost: 6},
+			// srtt 76.4ms, cost 8 differs by more than 20%
+			{rtt: 130 * ms, changed: true, cost: 8},
+		},
+	}, {
+		name:    "lost probes add the loss penalty",
+		dynamic: true,
+		probes: []testProbe{
+			// loss 0.125 of penalty 10 adds 1
+			{lost: true, changed: true, cost: 2},
+			// loss 0.234 adds 2
+			{lost: true, changed: true, cost: 3},
+			// loss 0.330 adds 3
+			{lost: true, changed: true, cost: 4},
+			// answered probes reduce the loss to 0.289 and add the rtt
+			{rtt: 20 * ms, changed: true, cost: 6},
+		},
+	}, {
+		name:    "cost is clamped below infinity",
+		dynamic: true,
+		probes: []testProbe{
+			{rtt: 10 * time.Second, changed: true, cost: 15},
+			{lost: true, cost: 15},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := newTestRouter("r1")
+			r.config.LinkCost.Dynamic = test.dynamic
+			ns := table.NewNeighborTable(r.config, nil).Add(newTestRouter("r2").name)
+
+			for i, probe := range test.probes {
+				require.Equal(t, probe.changed, ns.RecvProbe(probe.rtt, probe.lost), "probe %d", i)
+				require.Equal(t, probe.cost, ns.Cost(), "probe %d", i)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"slices"
 	"time"
 
 	"github.com/named-data/ndnd/dv/config"
@@ -40,6 +41,19 @@ type NeighborState struct {
 	faceId uint64
 	// the received advertisement is active face
 	isFaceActive bool
+	// areas of the link to the neighbor
+	areas map[string]bool
+
+	// cost of the link to the neighbor
+	cost uint64
+	// configured cost of the link
+	baseCost uint64
+	// measured quality of the link
+	link linkQuality
+	// consecutive lost failure detection probes
+	probeMisses uint64
+	// end of the grace period of a restarting neighbor
+	graceUntil time.Time
 }
 
 // Constructs a new NeighborTable with the specified configuration and NFD control thread, initializing an empty map to track neighbor states.
@@ -82,6 +96,10 @@ func (nt *NeighborTable) Add(name enc.Name) *NeighborState {
 
 		lastSeen: time.Now(),
 		faceId:   0,
+		areas:    make(map[string]bool),
+
+		cost:     config.DefaultLinkCost,
+		baseCost: config.DefaultLinkCost,
 	}
 	nt.neighbors[name.Hash()] = neighbor
 	return neighbor
@@ -105,9 +123,84 @@ func (nt *NeighborTable) GetAll() []*NeighborState {
 	return neighbors
 }
 
+// Returns the ID of the latest known face to this neighbor.
+func (ns *NeighborState) FaceId() uint64 {
+	return ns.faceId
+}
+
+// Returns true if the face to this neighbor was learned from an active advertisement.
+func (ns *NeighborState) IsFaceActive() bool {
+	return ns.isFaceActive
+}
+
+// Returns the time of the last sync Interest from this neighbor.
+func (ns *NeighborState) LastSeen() time.Time {
+	return ns.lastSeen
+}
+
+// Returns true if the link to this neighbor is in the given area.
+func (ns *NeighborState) InArea(area string) bool {
+	return ns.areas[area]
+}
+
+// Returns the names of the areas of the link to this neighbor.
+func (ns *NeighborState) Areas() []string {
+	areas := make([]string, 0, len(ns.areas))
+	for area := range ns.areas {
+		areas = append(areas, area)
+	}
+	slices.Sort(areas)
+	return areas
+}
+
+// Call this when a ping is received from the neighbor in an area.
+// The routes of the area are registered to the face of the neighbor.
+// Return => true if the link was not in the area before
+func (ns *NeighborState) JoinArea(area *config.Area) bool {
+	if ns.areas[area.Name()] {
+		return false
+	}
+
+	log.Info(ns.nt, "Neighbor joined area", "neighbor", ns.Name, "area", area.Name())
+	ns.areas[area.Name()] = true
+	if ns.faceId != 0 {
+		ns.areaRouteRegister(area, ns.faceId)
+	}
+	return true
+}
+
 // "Returns true if the neighbor has not been seen for longer than the configured router dead interval."
 func (ns *NeighborState) IsDead() bool {
-	return time.Since(ns.lastSeen) > ns.nt.config.RouterDeadInterval()
+	return time.Since(ns.lastSeen) > ns.nt.config.RouterDeadInterval() && !ns.InGrace()
+}
+
+// Returns true if the neighbor is restarting and its routes are kept.
+func (ns *NeighborState) InGrace() bool {
+	return time.Now().Before(ns.graceUntil)
+}
+
+// Call this with the grace period in the advertisement of the neighbor.
+// The routes through a restarting neighbor are kept until the grace period ends,
+// which is at most the local grace period.
+func (ns *NeighborState) SetGracePeriod(period optional.Optional[uint64]) {
+	if ms, ok := period.Get(); ok {
+		ms = min(ms, ns.nt.config.GracefulRestart.GracePeriod_ms)
+		log.Info(ns.nt, "Neighbor is restarting", "neighbor", ns.Name, "grace", ms)
+		ns.graceUntil = time.Now().Add(time.Duration(ms) * time.Millisecond)
+	} else {
+		ns.graceUntil = time.Time{}
+	}
+}
+
+// Call this with the result of a failure detection probe to the neighbor.
+// Return => true if the neighbor lost the configured number of consecutive probes
+func (ns *NeighborState) RecvFailureProbe(lost bool) bool {
+	if !lost || ns.InGrace() {
+		ns.probeMisses = 0
+		return false
+	}
+	ns.probeMisses++
+	return ns.probeMisses >= ns.nt.config.FailureDetection.ProbeMultiplier
 }
 
 // Call this when a ping is received from a face.
@@ -131,6 +224,7 @@ func (ns *NeighborState) RecvPing(faceId uint64, active bool) (error, bool) {
 		log.Info(ns.nt, "Neighbor face change", "neighbor", ns.Name, "faceid", faceId, "old", ns.faceId)
 		ns.routeUnregister()
 		ns.routeRegister(faceId)
+		ns.updateBaseCost(faceId)
 		return nil, true
 	}
 
@@ -172,10 +266,35 @@ func (ns *NeighborState) routeRegister(faceId uint64) {
 
 	// For fetching advertisements from neighbor
 	register(ns.localRoute())
+
+	// Routes of all areas of the link
+	for name := range ns.areas {
+		if area := ns.nt.config.GetArea(name); area != nil {
+			ns.areaRouteRegister(area, faceId)
+		}
+	}
+}
+
+// Register the routes of an area to this neighbor
+func (ns *NeighborState) areaRouteRegister(area *config.Area, faceId uint64) {
+	register := func(route enc.Name) {
+		ns.nt.nfdc.Exec(nfdc.NfdMgmtCmd{
+			Module: "rib",
+			Cmd:    "register",
+			Args: &mgmt.ControlArgs{
+				Name:   route,
+				FaceId: optional.Some(faceId),
+				Origin: optional.Some(config.NlsrOrigin),
+				Cost:   optional.Some(uint64(0)),
+			},
+			Retries: 3,
+		})
+	}
+
 	// Passive advertisement sync to neighbor
-	register(ns.nt.config.AdvertisementSyncPassivePrefix())
+	register(area.AdvertisementSyncPassivePrefix())
 	// For prefix table sync group
-	register(ns.nt.config.PrefixTableGroupPrefix().
+	register(area.PrefixTableGroupPrefix().
 		Append(enc.NewKeywordComponent("svs")))
 }
 
@@ -201,15 +320,27 @@ func (ns *NeighborState) routeUnregister() {
 	// Always remove local data routes to neighbor
 	unregister(ns.localRoute())
 
-	// If there are multiple neighbors on this face, we do not
-	// want to unregister the global routes to the face.
-	for _, ons := range ns.nt.neighbors {
-		if ons != ns && ons.faceId == ns.faceId {
-			return // skip global unregistration
+	for name := range ns.areas {
+		area := ns.nt.config.GetArea(name)
+		if area == nil {
+			continue
 		}
-	}
 
-	unregister(ns.nt.config.AdvertisementSyncPassivePrefix())
-	unregister(ns.nt.config.PrefixTableGroupPrefix().
-		Append(enc.NewKeywordComponent("svs")))
+		// If there are multiple neighbors of this area on this face, we do
+		// not want to unregister the global routes of the area to the face.
+		shared := false
+		for _, ons := range ns.nt.neighbors {
+			if ons != ns && ons.faceId == ns.faceId && ons.areas[name] {
+				shared = true
+				break
+			}
+		}
+		if shared {
+			continue // skip global unregistration
+		}
+
+		unregister(area.AdvertisementSyncPassivePrefix())
+		unregister(area.PrefixTableGroupPrefix().
+			Append(enc.NewKeywordComponent("svs")))
+	}
 }
@@ -1,12 +1,14 @@
 package table
 
 import (
+	"iter"
 	"slices"
 
 	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/tlv"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/types/optional"
 )
 
 type PrefixTable struct {
@@ -17,6 +19,7 @@ type PrefixTable struct {
 }
 
 type PrefixTableRouter struct {
+	Name     enc.Name
 	Prefixes map[string]*PrefixEntry
 }
 
@@ -24,8 +27,13 @@ type PrefixEntry struct {
 	Name enc.Name
 	Cost uint64
 
+	// Whether the prefix is dropped by the import or export policy
+	Denied bool
+
 	// Only known for the local router
 	NextHops []PrefixNextHop
+	// Whether the prefix is currently announced to the network
+	exported bool
 }
 
 type PrefixNextHop struct {
@@ -58,6 +66,7 @@ func (pt *PrefixTable) GetRouter(name enc.Name) *PrefixTableRouter {
 	router := pt.routers[hash]
 	if router == nil {
 		router = &PrefixTableRouter{
+			Name:     name.Clone(),
 			Prefixes: make(map[string]*PrefixEntry),
 		}
 		pt.routers[hash] = router
@@ -65,6 +74,17 @@ func (pt *PrefixTable) GetRouter(name enc.Name) *PrefixTableRouter {
 	return router
 }
 
+// Get all routers in the prefix table, including the local router.
+func (pt *PrefixTable) Routers() iter.Seq[*PrefixTableRouter] {
+	return func(yield func(*PrefixTableRouter) bool) {
+		for _, router := range pt.routers {
+			if !yield(router) {
+				return
+			}
+		}
+	}
+}
+
 // Resets the prefix table by clearing all stored prefixes and publishing a network-wide reset operation that designates the current router as the exit point for the affected prefixes.
 func (pt *PrefixTable) Reset() {
 	log.Info(pt, "Reset table")
@@ -149,23 +169,33 @@ func (pt *PrefixTable) publishEntry(hash string) {
 		return // never
 	}
 
-	if entry.Cost < config.CostPfxInfinity {
-		log.Info(pt, "Global announce", "name", entry.Name, "cost", entry.Cost)
+	cost, accept := pt.config.Policy.ExportPrefix(entry.Name, entry.Cost)
+	entry.Denied = !accept
+
+	if entry.Cost < config.CostPfxInfinity && accept {
+		log.Info(pt, "Global announce", "name", entry.Name, "cost", cost)
 		op := tlv.PrefixOpList{
 			ExitRouter: &tlv.Destination{Name: pt.config.RouterName()},
 			PrefixOpAdds: []*tlv.PrefixOpAdd{{
 				Name: entry.Name,
-				Cost: entry.Cost,
+				Cost: cost,
 			}},
 		}
 		pt.publish(op.Encode())
-	} else {
+		entry.exported = true
+	} else if entry.exported {
 		log.Info(pt, "Global withdraw", "name", entry.Name)
 		op := tlv.PrefixOpList{
 			ExitRouter:      &tlv.Destination{Name: pt.config.RouterName()},
 			PrefixOpRemoves: []*tlv.PrefixOpRemove{{Name: entry.Name}},
 		}
 		pt.publish(op.Encode())
+		entry.exported = false
+	} else if entry.Denied {
+		log.Info(pt, "Export denied by policy", "name", entry.Name)
+	}
+
+	if entry.Cost >= config.CostPfxInfinity {
 		delete(pt.me.Prefixes, hash) // dead
 	}
 }
@@ -192,18 +222,33 @@ func (pt *PrefixTable) Apply(wire enc.Wire) (dirty bool) {
 	}
 
 	for _, add := range ops.PrefixOpAdds {
-		log.Info(pt, "Add remote prefix", "router", ops.ExitRouter.Name, "name", add.Name, "cost", add.Cost)
-		router.Prefixes[add.Name.TlvStr()] = &PrefixEntry{
-			Name: add.Name.Clone(),
-			Cost: add.Cost,
+		hash := add.Name.TlvStr()
+		cost, accept := pt.config.Policy.ImportPrefix(ops.ExitRouter.Name, add.Name, add.Cost)
+		if accept {
+			log.Info(pt, "Add remote prefix", "router", ops.ExitRouter.Name, "name", add.Name, "cost", cost)
+			dirty = true
+		} else {
+			log.Info(pt, "Import denied by policy", "router", ops.ExitRouter.Name, "name", add.Name)
+			if old := router.Prefixes[hash]; old != nil && !old.Denied {
+				dirty = true
+			}
+		}
+
+		// Denied prefixes are kept to be shown in the status
+		router.Prefixes[hash] = &PrefixEntry{
+			Name:   add.Name.Clone(),
+			Cost:   cost,
+			Denied: !accept,
 		}
-		dirty = true
 	}
 
 	for _, remove := range ops.PrefixOpRemoves {
 		log.Info(pt, "Remove remote prefix", "router", ops.ExitRouter.Name, "name", remove.Name)
-		delete(router.Prefixes, remove.Name.TlvStr())
-		dirty = true
+		hash := remove.Name.TlvStr()
+		if old := router.Prefixes[hash]; old != nil && !old.Denied {
+			dirty = true
+		}
+		delete(router.Prefixes, hash)
 	}
 
 	return dirty
@@ -218,15 +263,129 @@ func (pt *PrefixTable) Snap() enc.Wire {
 	}
 
 	for _, entry := range pt.me.Prefixes {
+		if !entry.exported {
+			continue
+		}
+		cost, _ := pt.config.Policy.ExportPrefix(entry.Name, entry.Cost)
 		snap.PrefixOpAdds = append(snap.PrefixOpAdds, &tlv.PrefixOpAdd{
 			Name: entry.Name,
-			Cost: entry.Cost,
+			Cost: cost,
 		})
 	}
 
 	return snap.Encode()
 }
 
+// Get all entries of the prefix table, to restore it after a restart.
+// Entries of the local router have one record for each next hop.
+func (pt *PrefixTable) Records() []*tlv.PrefixRecord {
+	records := make([]*tlv.PrefixRecord, 0)
+	for _, router := range pt.routers {
+		for _, entry := range router.Prefixes {
+			if router != pt.me {
+				records = append(records, &tlv.PrefixRecord{
+					Router: &tlv.Destination{Name: router.Name},
+					Name:   entry.Name,
+					Cost:   entry.Cost,
+					Denied: entry.Denied,
+				})
+				continue
+			}
+			for _, nh := range entry.NextHops {
+				records = append(records, &tlv.PrefixRecord{
+					Router: &tlv.Destination{Name: router.Name},
+					Name:   entry.Name,
+					Cost:   nh.Cost,
+					FaceId: optional.Some(nh.Face),
+				})
+			}
+		}
+	}
+	return records
+}
+
+// Restore an entry of the prefix table after a restart, without publishing it.
+// The sync group state is restored separately, so the network already has the entry.
+func (pt *PrefixTable) Restore(record *tlv.PrefixRecord) {
+	if record.Router == nil {
+		return
+	}
+	hash := record.Name.TlvStr()
+	router := pt.GetRouter(record.Router.Name)
+
+	if router != pt.me {
+		router.Prefixes[hash] = &PrefixEntry{
+			Name:   record.Name.Clone(),
+			Cost:   record.Cost,
+			Denied: record.Denied,
+		}
+		return
+	}
+
+	face, ok := record.FaceId.Get()
+	if !ok {
+		return
+	}
+	entry := router.Prefixes[hash]
+	if entry == nil {
+		entry = &PrefixEntry{
+			Name: record.Name.Clone(),
+			Cost: config.CostPfxInfinity,
+		}
+		router.Prefixes[hash] = entry
+	}
+	entry.NextHops = append(entry.NextHops, PrefixNextHop{Face: face, Cost: record.Cost})
+	entry.computeCost()
+
+	_, accept := pt.config.Policy.ExportPrefix(entry.Name, entry.Cost)
+	entry.Denied = !accept
+	entry.exported = accept && entry.Cost < config.CostPfxInfinity
+}
+
+// Computes the cost of an aggregate prefix, which is the lowest cost to any prefix
+// under the aggregate announced by a reachable remote router in the RIB.
+// Aggregates announced by other routers are not used, so aggregates are never
+// summarized again. Returns CostPfxInfinity if no such prefix is reachable.
+func (pt *PrefixTable) AggregateCost(aggregate enc.Name, rib *Rib) uint64 {
+	cost := config.CostPfxInfinity
+	for _, router := range pt.routers {
+		if router == pt.me {
+			continue
+		}
+
+		ribEntry := rib.Get(router.Name)
+		if ribEntry == nil {
+			continue
+		}
+
+		for _, entry := range router.Prefixes {
+			if entry.Denied || len(entry.Name) <= len(aggregate) || !aggregate.IsPrefix(entry.Name) {
+				continue
+			}
+			cost = min(cost, ribEntry.Cost()+entry.Cost)
+		}
+	}
+	return cost
+}
+
+// Counts the local prefixes denied by the export policy and the
+// remote prefixes denied by the import policy.
+func (pt *PrefixTable) DeniedCount() (nExport uint64, nImport uint64) {
+	for _, router := range pt.routers {
+		for _, entry := range router.Prefixes {
+			if !entry.Denied {
+				continue
+			}
+			if router == pt.me {
+				nExport++
+			} else {
+				nImport++
+			}
+		}
+	}
+	return nExport, nImport
+}
+
 // Computes the minimum cost among all next hops for the prefix entry and returns true if the entry's stored cost is updated as a result.
 func (e *PrefixEntry) computeCost() (dirty bool) {
 	cost := ^uint64(0)
@@ -0,0 +1,107 @@
+package table_test
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Local prefixes are announced and withdrawn according to the export policy.
+func TestPrefixTableExport(t *testing.T) {
+	tu.SetT(t)
+
+	r1 := newTestRouter("r1")
+	r1.config.Policy.Export = []*config.PolicyRule{
+		{Prefix: "/ndn/private", Action: config.PolicyDeny},
+		{Prefix: "/ndn", AddCost: 1},
+	}
+	require.NoError(t, r1.config.Policy.Parse())
+
+	ops := []*tlv.PrefixOpList{}
+	pfx := table.NewPrefixTable(r1.config, func(w enc.Wire) {
+		ops = append(ops, tu.NoErr(tlv.ParsePrefixOpList(enc.NewWireView(w), false)))
+	})
+	app := tu.NoErr(enc.NameFromStr("/ndn/app"))
+	private := tu.NoErr(enc.NameFromStr("/ndn/private"))
+
+	// Accepted prefixes are announced with the exported cost
+	pfx.Announce(app, 10, 2)
+	require.Len(t, ops, 1)
+	require.Equal(t, app, ops[0].PrefixOpAdds[0].Name)
+	require.Equal(t, uint64(3), ops[0].PrefixOpAdds[0].Cost)
+
+	// Cost changes are announced again
+	pfx.Announce(app, 11, 1)
+	require.Len(t, ops, 2)
+	require.Equal(t, uint64(2), ops[1].PrefixOpAdds[0].Cost)
+
+	// Denied prefixes are never published, but are shown as denied
+	pfx.Announce(private, 10, 1)
+	require.Len(t, ops, 2)
+	require.True(t, pfx.GetRouter(r1.name).Prefixes[private.TlvStr()].Denied)
+	nExport, _ := pfx.DeniedCount()
+	require.Equal(t, uint64(1), nExport)
+
+	// Withdrawing the last next hop withdraws an exported prefix only
+	pfx.Withdraw(private, 10)
+	require.Len(t, ops, 2)
+	nExport, _ = pfx.DeniedCount()
+	require.Zero(t, nExport)
+
+	pfx.Withdraw(app, 11)
+	require.Len(t, ops, 3)
+	require.Equal(t, uint64(3), ops[2].PrefixOpAdds[0].Cost)
+	pfx.Withdraw(app, 10)
+	require.Len(t, ops, 4)
+	require.Empty(t, ops[3].PrefixOpAdds)
+	require.Equal(t, app, ops[3].PrefixOpRemoves[0].Name)
+}
+
+// Remote prefixes are installed according to the import policy.
+func TestPrefixTableImport(t *testing.T) {
+	tu.SetT(t)
+
+	r1 := newTestRouter("r1")
+	r2 := newTestRouter("r2")
+	r2.config.Policy.Import = []*config.PolicyRule{
+		{Prefix: "/ndn/private", Action: config.PolicyDeny},
+		{Prefix: "/ndn", Router: "/ndn/r1", AddCost: 5},
+	}
+	require.NoError(t, r2.config.Policy.Parse())
+
+	r2pfx := table.NewPrefixTable(r2.config, func(enc.Wire) {})
+	dirty := false
+	pfx := table.NewPrefixTable(r1.config, func(w enc.Wire) { dirty = r2pfx.Apply(w) })
+	app := tu.NoErr(enc.NameFromStr("/ndn/app"))
+	private := tu.NoErr(enc.NameFromStr("/ndn/private"))
+	remote := func(name enc.Name) *table.PrefixEntry {
+		return r2pfx.GetRouter(r1.name).Prefixes[name.TlvStr()]
+	}
+
+	// Accepted prefixes change the routes with the imported cost
+	pfx.Announce(app, 10, 2)
+	require.True(t, dirty)
+	require.Equal(t, uint64(7), remote(app).Cost)
+	require.False(t, remote(app).Denied)
+
+	// Denied prefixes are kept as denied without changing the routes
+	pfx.Announce(private, 10, 1)
+	require.False(t, dirty)
+	require.True(t, remote(private).Denied)
+	_, nImport := r2pfx.DeniedCount()
+	require.Equal(t, uint64(1), nImport)
+
+	// Removing a denied prefix does not change the routes
+	pfx.Withdraw(private, 10)
+	require.False(t, dirty)
+	require.Nil(t, remote(private))
+
+	pfx.Withdraw(app, 10)
+	require.True(t, dirty)
+	require.Nil(t, remote(app))
+}
@@ -0,0 +1,160 @@
+package table_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/dv/nfdc"
+	"github.com/named-data/ndnd/dv/table"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// A RIB restored from its records has the same routes and loop-free paths.
+func TestRibRecords(t *testing.T) {
+	tu.SetT(t)
+
+	routers := map[string]*testRouter{
+		"r1": newTestRouter("r1"),
+		"r2": newTestRouter("r2"),
+		"r3": newTestRouter("r3"),
+	}
+	converge(t, routers, []testLink{
+		{"r1", "r2", "", 1},
+		{"r2", "r3", "", 1},
+		{"r1", "r3", "", 5},
+	})
+
+	r1 := routers["r1"]
+	state := &tlv.AreaState{Rib: r1.ribs[""].Records()}
+	state = tu.NoErr(tlv.ParseAreaState(enc.NewWireView(state.Encode()), false))
+
+	rib := table.NewRib(r1.config, "")
+	for _, rec := range state.Rib {
+		rib.Set(rec.Destination.Name, rec.NextHop.Name, rec.Cost, rec.Dist)
+	}
+	restored := &testRouter{name: r1.name, config: r1.config, ribs: map[string]*table.Rib{"": rib}}
+
+	require.Equal(t, r1.ribs[""].Size(), rib.Size())
+	require.Equal(t, 2, routeCost(restored, "", "r3"))
+	require.Equal(t, 1, routeCost(restored, "", "r2"))
+
+	// The alternate path is restored with the best path
+	rib.RemoveNextHop(routers["r2"].name)
+	rib.Prune()
+	require.Equal(t, 5, routeCost(restored, "", "r3"))
+}
+
+// A prefix table restored from its records has the same entries and is not published.
+func TestPrefixTableRecords(t *testing.T) {
+	tu.SetT(t)
+
+	r1 := newTestRouter("r1")
+	r2 := newTestRouter("r2")
+
+	r2pfx := table.NewPrefixTable(r2.config, func(enc.Wire) {})
+	pfx := table.NewPrefixTable(r1.config, func(w enc.Wire) { r2pfx.Apply(w) })
+	pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/app")), 10, 3)
+	pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/app")), 11, 1)
+	pfx.Announce(tu.NoErr(enc.NameFromStr("/ndn/web")), 10, 2)
+
+	// Local entries of r1 and remote entries of r1 in the table of r2
+	records := append(pfx.Records(), r2pfx.Records()...)
+	require.Len(t, records, 5)
+
+	published := 0
+	restored := table.NewPrefixTable(r1.config, func(enc.Wire) { published++ })
+	for _, rec := range records {
+		rec = tu.NoErr(tlv.ParsePrefixRecord(enc.NewWireView(rec.Encode()), false))
+		if rec.Router.Name.Equal(r1.name) && rec.FaceId.IsSet() {
+			restored.Restore(rec)
+		}
+	}
+	require.Equal(t, 0, published)
+
+	me := restored.GetRouter(r1.name)
+	require.Len(t, me.Prefixes, 2)
+	require.Equal(t, uint64(1), me.Prefixes[tu.NoErr(enc.NameFromStr("/ndn/app")).TlvStr()].Cost)
+	require.Len(t, me.Prefixes[tu.NoErr(enc.NameFromStr("/ndn/app")).TlvStr()].NextHops, 2)
+
+	// Withdrawing a restored next hop publishes the new cost
+	restored.Withdraw(tu.NoErr(enc.NameFromStr("/ndn/app")), 11)
+	require.Equal(t, 1, published)
+	require.Equal(t, uint64(3), me.Prefixes[tu.NoErr(enc.NameFromStr("/ndn/app")).TlvStr()].Cost)
+
+	// Remote entries are restored in the table of another router
+	remote := table.NewPrefixTable(r2.config, func(enc.Wire) { published++ })
+	for _, rec := range r2pfx.Records() {
+		remote.Restore(rec)
+	}
+	require.Len(t, remote.GetRouter(r1.name).Prefixes, 2)
+	require.Equal(t, 1, published)
+}
+
+// Restored FIB entries are kept in updates until they are swept.
+func TestFibRestore(t *testing.T) {
+	tu.SetT(t)
+
+	fib := table.NewFib(newTestRouter("r1").config, nfdc.NewNfdMgmtThread(nil))
+	app := tu.NoErr(enc.NameFromStr("/ndn/app"))
+	web := tu.NoErr(enc.NameFromStr("/ndn/web"))
+	costs := func(name enc.Name) map[uint64]uint64 {
+		res := make(map[uint64]uint64)
+		for n, entries := range fib.Entries() {
+			if n.Equal(name) {
+				for _, fe := range entries {
+					res[fe.FaceId] = fe.Cost
+				}
+			}
+		}
+		return res
+	}
+
+	fib.Restore(app, []table.FibEntry{{FaceId: 10, Cost: 5}, {FaceId: 11, Cost: 7}})
+	fib.Restore(web, []table.FibEntry{{FaceId: 10, Cost: 1}})
+	require.Equal(t, 2, fib.Size())
+	require.Equal(t, map[uint64]uint64{10: 5, 11: 7}, costs(app))
+
+	// New entries are merged with the stale entries, using the lowest cost of each face
+	require.True(t, fib.Update(app, []table.FibEntry{{FaceId: 10, Cost: 3}, {FaceId: 12, Cost: 4}}))
+	require.Equal(t, map[uint64]uint64{10: 3, 11: 7, 12: 4}, costs(app))
+
+	// Stale entries are not removed by updates without them
+	require.True(t, fib.Update(web, nil))
+	require.Equal(t, map[uint64]uint64{10: 1}, costs(web))
+
+	// Once cleared, the stale entries that are not computed are swept by the next update
+	fib.ClearStale()
+	fib.UnmarkAll()
+	if fib.Update(app, []table.FibEntry{{FaceId: 10, Cost: 3}}) {
+		fib.MarkH(app.Hash())
+	}
+	fib.RemoveUnmarked()
+	require.Equal(t, 1, fib.Size())
+	require.Equal(t, map[uint64]uint64{10: 3}, costs(app))
+	require.Empty(t, costs(web))
+}
+
+// The grace period of a restarting neighbor is limited by the local grace period.
+func TestNeighborGracePeriod(t *testing.T) {
+	tu.SetT(t)
+
+	c := newTestRouter("r1").config
+	c.GracefulRestart.GracePeriod_ms = 20
+	nt := table.NewNeighborTable(c, nfdc.NewNfdMgmtThread(nil))
+	ns := nt.Add(tu.NoErr(enc.NameFromStr("/ndn/r2")))
+
+	ns.SetGracePeriod(optional.Some(uint64(time.Hour.Milliseconds())))
+	require.True(t, ns.InGrace())
+	require.Eventually(t, func() bool {
+		return !ns.InGrace()
+	}, time.Second, 5*time.Millisecond)
+
+	// Advertisements without a grace period end it
+	ns.SetGracePeriod(optional.Some(uint64(10000)))
+	ns.SetGracePeriod(optional.None[uint64]())
+	require.False(t, ns.InGrace())
+}
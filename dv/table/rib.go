@@ -1,19 +1,24 @@
 package table
 
 import (
+	"cmp"
 	"fmt"
 	"iter"
+	"slices"
 
 	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/tlv"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/types/optional"
 )
 
 // Routing Information Base (RIB)
 type Rib struct {
 	// main router configuration
 	config *config.Config
+	// name of the area of this RIB
+	area string
 	// destination hash -> entry
 	entries map[uint64]*RibEntry
 	// neighbor hash -> neighbor name
@@ -27,6 +32,10 @@ type RibEntry struct {
 	name enc.Name
 	// neighbor hash -> cost
 	costs map[uint64]uint64
+	// neighbor hash -> cost advertised by the neighbor
+	dists map[uint64]uint64
+	// loop-free next hops ordered by cost (name hash)
+	paths []uint64
 	// next hop for lowest cost (name hash)
 	nextHop1 uint64
 	// second next hop for lowest cost (name hash)
@@ -39,10 +48,11 @@ type RibEntry struct {
 	dirty bool
 }
 
-// Constructs a new RIB (Routing Information Base) with the provided configuration, initializing empty maps for storing routing entries and neighbor names.
-func NewRib(config *config.Config) *Rib {
+// Constructs a new RIB (Routing Information Base) for an area with the provided configuration, initializing empty maps for storing routing entries and neighbor names.
+func NewRib(config *config.Config, area string) *Rib {
 	return &Rib{
 		config:    config,
+		area:      area,
 		entries:   make(map[uint64]*RibEntry),
 		neighbors: make(map[uint64]enc.Name),
 	}
@@ -58,7 +68,7 @@ func (r *Rib) Print() {
 	for _, entry := range r.entries {
 		fmt.Printf("=> Destination: %s\n", entry.name.String())
 		for hop, cost := range entry.costs {
-			if cost < config.CostInfinity {
+			if cost < r.config.CostInfinity {
 				fmt.Printf("===> NextHop: %s, Cost: %d\n", r.neighbors[hop].String(), cost)
 			}
 		}
@@ -71,7 +81,8 @@ func (r *Rib) Size() int {
 }
 
 // Set a destination in the RIB. Returns true if the Advertisement might change.
-func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
+// dist is the cost from the next hop to the destination, used to find loop-free paths.
+func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64, dist uint64) bool {
 	destHash := destName.Hash()
 	nextHopHash := nextHop.Hash()
 
@@ -82,6 +93,7 @@ func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
 			rib:   r,
 			name:  destName.Clone(),
 			costs: make(map[uint64]uint64),
+			dists: make(map[uint64]uint64),
 		}
 		r.entries[destHash] = entry
 	}
@@ -91,7 +103,7 @@ func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
 		r.neighbors[nextHopHash] = nextHop.Clone()
 	}
 
-	return entry.Set(nextHopHash, cost)
+	return entry.Set(nextHopHash, cost, dist)
 }
 
 // Check if a destination is reachable in the RIB.
@@ -100,14 +112,23 @@ func (r *Rib) Has(destName enc.Name) bool {
 	if entry == nil {
 		return false
 	}
-	return entry.lowest1 < config.CostInfinity
+	return entry.lowest1 < r.config.CostInfinity
+}
+
+// Get the entry for a destination if it is reachable.
+func (r *Rib) Get(destName enc.Name) *RibEntry {
+	entry := r.entries[destName.Hash()]
+	if entry == nil || entry.lowest1 >= r.config.CostInfinity {
+		return nil
+	}
+	return entry
 }
 
 // Get all destinations reachable in the RIB.
 func (r *Rib) Entries() iter.Seq2[uint64, *RibEntry] {
 	return func(yield func(uint64, *RibEntry) bool) {
 		for hash, entry := range r.entries {
-			if entry.lowest1 < config.CostInfinity {
+			if entry.lowest1 < r.config.CostInfinity {
 				if !yield(hash, entry) {
 					return
 				}
@@ -125,6 +146,7 @@ func (r *Rib) RemoveNextHop(nextHop enc.Name) bool {
 	for _, entry := range r.entries {
 		if _, ok := entry.costs[nextHopHash]; ok {
 			delete(entry.costs, nextHopHash)
+			delete(entry.dists, nextHopHash)
 			dirty = entry.refresh() || dirty
 		}
 	}
@@ -138,7 +160,7 @@ func (r *Rib) RemoveNextHop(nextHop enc.Name) bool {
 func (r *Rib) DirtyResetNextHop(nextHop enc.Name) {
 	nextHopHash := nextHop.Hash()
 	for _, entry := range r.entries {
-		entry.costs[nextHopHash] = config.CostInfinity
+		entry.costs[nextHopHash] = r.config.CostInfinity
 		entry.dirty = true
 	}
 }
@@ -155,7 +177,7 @@ func (r *Rib) Prune() bool {
 		}
 
 		// Remove if no valid next hops
-		if entry.lowest1 == config.CostInfinity {
+		if entry.lowest1 >= r.config.CostInfinity {
 			delete(r.entries, entry.name.Hash())
 			dirty = true
 		}
@@ -164,11 +186,17 @@ func (r *Rib) Prune() bool {
 }
 
 // Get all advertisement entries in the RIB.
+// Entries of a named area are tagged with the area.
 func (r *Rib) Advert() *tlv.Advertisement {
 	advert := &tlv.Advertisement{
 		Entries: make([]*tlv.AdvEntry, 0, len(r.entries)),
 	}
 
+	var area optional.Optional[string]
+	if r.area != "" {
+		area = optional.Some(r.area)
+	}
+
 	for _, entry := range r.entries {
 		advert.Entries = append(advert.Entries, &tlv.AdvEntry{
 			Destination: &tlv.Destination{Name: entry.name},
@@ -177,21 +205,96 @@ func (r *Rib) Advert() *tlv.Advertisement {
 			},
 			Cost:      entry.lowest1,
 			OtherCost: entry.lowest2,
+			Area:      area,
 		})
 	}
 
 	return advert
 }
 
+// Get the costs through all next hops of the RIB, to restore it after a restart.
+func (r *Rib) Records() []*tlv.RibRecord {
+	records := make([]*tlv.RibRecord, 0, len(r.entries))
+	for _, entry := range r.entries {
+		for hop, cost := range entry.costs {
+			if cost >= r.config.CostInfinity {
+				continue
+			}
+			records = append(records, &tlv.RibRecord{
+				Destination: &tlv.Destination{Name: entry.name},
+				NextHop:     &tlv.Destination{Name: r.neighbors[hop]},
+				Cost:        cost,
+				Dist:        entry.dists[hop],
+			})
+		}
+	}
+	return records
+}
+
+// Update the RIB with the advertisement of a neighbor, reached with the given link cost.
+// Only the entries of the area of this RIB are used.
+// Returns true if the Advertisement might change.
+func (r *Rib) ApplyAdvert(neighbor enc.Name, advert *tlv.Advertisement, localCost uint64) (dirty bool) {
+	infinity := r.config.CostInfinity
+
+	// Reset destinations for this neighbor
+	r.DirtyResetNextHop(neighbor)
+
+	for _, entry := range advert.Entries {
+		// Entries of other areas are never used
+		if entry.Area.GetOr("") != r.area {
+			continue
+		}
+
+		// Use the advertised cost by default
+		// Costs are clamped to avoid overflow with large advertised costs
+		dist := min(entry.Cost, infinity)
+
+		// Poison reverse - try other cost if next hop is us
+		if entry.NextHop.Name.Equal(r.config.RouterName()) {
+			dist = min(entry.OtherCost, infinity)
+		}
+		cost := dist + localCost
+
+		// Skip unreachable destinations
+		if cost >= infinity {
+			continue
+		}
+
+		// Check advertisement changes
+		dirty = r.Set(entry.Destination.Name, neighbor, cost, dist) || dirty
+	}
+
+	// Drop dead entries
+	return r.Prune() || dirty
+}
+
 // Returns the name associated with this RIB entry as an `enc.Name` structure.
 func (e *RibEntry) Name() enc.Name {
 	return e.name
 }
 
-// Updates the cost for the given next hop in the routing entry and triggers a refresh if the cost differs from the existing value; returns false if no change occurs to avoid unnecessary refreshes.
-func (e *RibEntry) Set(nextHop uint64, cost uint64) bool {
-	if known, ok := e.costs[nextHop]; !ok || known != cost {
+// Returns the lowest cost to the destination of this entry.
+func (e *RibEntry) Cost() uint64 {
+	return e.lowest1
+}
+
+// Get the loop-free next hops of this entry with their costs, ordered by cost.
+func (e *RibEntry) Paths() iter.Seq2[enc.Name, uint64] {
+	return func(yield func(enc.Name, uint64) bool) {
+		for _, hop := range e.paths {
+			if !yield(e.rib.neighbors[hop], e.costs[hop]) {
+				return
+			}
+		}
+	}
+}
+
+// Updates the cost for the given next hop in the routing entry and triggers a refresh if the cost or the distance from the next hop differs from the existing value; returns false if no change occurs to avoid unnecessary refreshes.
+func (e *RibEntry) Set(nextHop uint64, cost uint64, dist uint64) bool {
+	if known, ok := e.costs[nextHop]; !ok || known != cost || e.dists[nextHop] != dist {
 		e.costs[nextHop] = cost
+		e.dists[nextHop] = dist
 		return e.refresh()
 	}
 
@@ -202,8 +305,8 @@ func (e *RibEntry) Set(nextHop uint64, cost uint64) bool {
 // Update lowest and second lowest costs for the entry.
 func (e *RibEntry) refresh() bool {
 	e.dirty = false
-	lowest1 := config.CostInfinity
-	lowest2 := config.CostInfinity
+	lowest1 := e.rib.config.CostInfinity
+	lowest2 := e.rib.config.CostInfinity
 	nextHop1 := uint64(0)
 	nextHop2 := uint64(0)
 
@@ -219,16 +322,50 @@ func (e *RibEntry) refresh() bool {
 		}
 	}
 
+	paths := e.loopFreePaths(lowest1)
+
 	if e.lowest1 != lowest1 || e.lowest2 != lowest2 || e.nextHop1 != nextHop1 || e.nextHop2 != nextHop2 {
 		e.lowest1 = lowest1
 		e.lowest2 = lowest2
 		e.nextHop1 = nextHop1
 		e.nextHop2 = nextHop2
+		e.paths = paths
 		log.Info(e.rib, "Update next hop", "name", e.name,
 			"hop1", e.rib.neighbors[nextHop1], "cost1", lowest1,
-			"hop2", e.rib.neighbors[nextHop2], "cost2", lowest2)
+			"hop2", e.rib.neighbors[nextHop2], "cost2", lowest2,
+			"paths", len(paths))
+		return true
+	}
+
+	if !slices.Equal(e.paths, paths) {
+		e.paths = paths
+		log.Info(e.rib, "Update paths", "name", e.name, "paths", len(paths))
 		return true
 	}
 
 	return false
 }
+
+// Get the loop-free next hops for the lowest cost, ordered by cost.
+// A next hop is loop-free if its own cost to the destination is lower than ours
+// (downstream path criterion). At most MaxPaths next hops are returned.
+func (e *RibEntry) loopFreePaths(lowest uint64) []uint64 {
+	paths := make([]uint64, 0, len(e.costs))
+	for hop, cost := range e.costs {
+		if cost < e.rib.config.CostInfinity && e.dists[hop] < lowest {
+			paths = append(paths, hop)
+		}
+	}
+
+	slices.SortFunc(paths, func(a, b uint64) int {
+		if c := cmp.Compare(e.costs[a], e.costs[b]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	if maxPaths := e.rib.config.MaxPaths; maxPaths > 0 && len(paths) > maxPaths {
+		paths = paths[:maxPaths]
+	}
+	return paths
+}
@@ -0,0 +1,134 @@
+package table_test
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/dv/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// The RIB switches to the alternate path of a failed neighbor without new advertisements.
+//
+//	r1 -1- r2 -1- r3, r1 -5- r3
+func TestRemoveNextHopAlternate(t *testing.T) {
+	tu.SetT(t)
+
+	routers := map[string]*testRouter{
+		"r1": newTestRouter("r1"),
+		"r2": newTestRouter("r2"),
+		"r3": newTestRouter("r3"),
+	}
+	converge(t, routers, []testLink{
+		{"r1", "r2", "", 1},
+		{"r2", "r3", "", 1},
+		{"r1", "r3", "", 5},
+	})
+
+	r1 := routers["r1"]
+	require.Equal(t, 1, routeCost(r1, "", "r2"))
+	require.Equal(t, 2, routeCost(r1, "", "r3"))
+
+	// Failure of r2 uses the direct link to r3 immediately
+	require.True(t, r1.ribs[""].RemoveNextHop(routers["r2"].name))
+	r1.ribs[""].Prune()
+	require.Equal(t, 5, routeCost(r1, "", "r3"))
+	require.Equal(t, 6, routeCost(r1, "", "r2"))
+}
+
+// Neighbors fail after the configured number of consecutive lost probes.
+func TestRecvFailureProbe(t *testing.T) {
+	tu.SetT(t)
+
+	r1 := newTestRouter("r1")
+	r1.config.FailureDetection.ProbeMultiplier = 3
+	ns := table.NewNeighborTable(r1.config, nil).Add(newTestRouter("r2").name)
+
+	// Answered probes reset the count
+	require.False(t, ns.RecvFailureProbe(true))
+	require.False(t, ns.RecvFailureProbe(true))
+	require.False(t, ns.RecvFailureProbe(false))
+	require.False(t, ns.RecvFailureProbe(true))
+	require.False(t, ns.RecvFailureProbe(true))
+	require.True(t, ns.RecvFailureProbe(true))
+
+	// Restarting neighbors do not fail
+	ns.RecvFailureProbe(false)
+	ns.SetGracePeriod(optional.Some(uint64(60000)))
+	for range 5 {
+		require.False(t, ns.RecvFailureProbe(true))
+	}
+	ns.SetGracePeriod(optional.None[uint64]())
+	require.False(t, ns.RecvFailureProbe(true))
+}
+
+// Multiple paths in a fat tree, where the edge router e1 reaches e2 through
+// four aggregation routers, and also has a link to the edge router e3:
+//
+//	e1 -1- a1, a2, a4 -1- e2
+//	e1 -2- a3 -1- e2
+//	e1 -1- e3 -1- a1
+func TestLoopFreePaths(t *testing.T) {
+	tu.SetT(t)
+
+	fatTree := func(maxPaths int) map[string]*testRouter {
+		routers := make(map[string]*testRouter)
+		for _, name := range []string{"e1", "e2", "e3", "a1", "a2", "a3", "a4"} {
+			routers[name] = newTestRouter(name)
+		}
+		routers["e1"].config.MaxPaths = maxPaths
+		converge(t, routers, []testLink{
+			{"e1", "a1", "", 1},
+			{"e1", "a2", "", 1},
+			{"e1", "a3", "", 2},
+			{"e1", "a4", "", 1},
+			{"a1", "e2", "", 1},
+			{"a2", "e2", "", 1},
+			{"a3", "e2", "", 1},
+			{"a4", "e2", "", 1},
+			{"e1", "e3", "", 1},
+			{"e3", "a1", "", 1},
+		})
+		return routers
+	}
+
+	// Returns the next hops and costs of the loop-free paths to e2.
+	paths := func(routers map[string]*testRouter) ([]enc.Name, []uint64) {
+		dest := enc.Name{enc.NewGenericComponent("ndn"), enc.NewGenericComponent("e2")}
+		hops, costs := []enc.Name{}, []uint64{}
+		for hop, cost := range routers["e1"].ribs[""].Get(dest).Paths() {
+			hops = append(hops, hop)
+			costs = append(costs, cost)
+		}
+		return hops, costs
+	}
+	name := func(routers map[string]*testRouter, names ...string) []enc.Name {
+		res := make([]enc.Name, len(names))
+		for i, n := range names {
+			res[i] = routers[n].name
+		}
+		return res
+	}
+
+	// All aggregation routers are downstream of e1, including the more
+	// expensive a3. e3 is at the same distance to e2 as e1, so it is not.
+	routers := fatTree(0)
+	require.Equal(t, 2, routeCost(routers["e1"], "", "e2"))
+	hops, costs := paths(routers)
+	require.ElementsMatch(t, name(routers, "a1", "a2", "a3", "a4"), hops)
+	require.Equal(t, []uint64{2, 2, 2, 3}, costs)
+	require.Equal(t, routers["a3"].name, hops[3])
+
+	// Equal costs are ordered consistently
+	again, _ := paths(fatTree(0))
+	require.Equal(t, hops, again)
+
+	// Paths are truncated to the cheapest
+	routers = fatTree(2)
+	hops, costs = paths(routers)
+	require.Len(t, hops, 2)
+	require.Equal(t, []uint64{2, 2}, costs)
+	require.NotContains(t, hops, routers["a3"].name)
+}
@@ -1,7 +1,10 @@
 //go:generate gondn_tlv_gen
 package tlv
 
-import enc "github.com/named-data/ndnd/std/encoding"
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+)
 
 type Packet struct {
 	//+field:struct:Advertisement
@@ -13,6 +16,8 @@ type Packet struct {
 type Advertisement struct {
 	//+field:sequence:*AdvEntry:struct:AdvEntry
 	Entries []*AdvEntry `tlv:"0xCA"`
+	//+field:natural:optional
+	GracePeriod optional.Optional[uint64] `tlv:"0xD6"`
 }
 
 type AdvEntry struct {
@@ -24,6 +29,8 @@ type AdvEntry struct {
 	Cost uint64 `tlv:"0xD0"`
 	//+field:natural
 	OtherCost uint64 `tlv:"0xD2"`
+	//+field:string:optional
+	Area optional.Optional[string] `tlv:"0xD4"`
 }
 
 type Destination struct {
@@ -54,6 +61,13 @@ type PrefixOpRemove struct {
 	Name enc.Name `tlv:"0x07"`
 }
 
+type Hello struct {
+	//+field:string
+	Endpoint string `tlv:"0x1E1"`
+	//+field:string:optional
+	Area optional.Optional[string] `tlv:"0xD4"`
+}
+
 type Status struct {
 	//+field:string
 	Version string `tlv:"0x191"`
@@ -67,4 +81,161 @@ type Status struct {
 	NNeighbors uint64 `tlv:"0x199"`
 	//+field:natural
 	NFibEntries uint64 `tlv:"0x19B"`
+	//+field:natural
+	NExportDenied uint64 `tlv:"0x19D"`
+	//+field:natural
+	NImportDenied uint64 `tlv:"0x19F"`
+}
+
+type NeighborsDataset struct {
+	//+field:sequence:*NeighborStatus:struct:NeighborStatus
+	Neighbors []*NeighborStatus `tlv:"0x1A1"`
+}
+
+type NeighborStatus struct {
+	//+field:struct:Destination
+	Name *Destination `tlv:"0xCC"`
+	//+field:natural
+	FaceId uint64 `tlv:"0x69"`
+	//+field:bool
+	FaceActive bool `tlv:"0x1A3"`
+	//+field:natural
+	LastSeen uint64 `tlv:"0x1A5"`
+	//+field:natural
+	AdvertBoot uint64 `tlv:"0x1A7"`
+	//+field:natural
+	AdvertSeq uint64 `tlv:"0x1A9"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:bool
+	Discovered bool `tlv:"0x1AB"`
+}
+
+type PrefixTableDataset struct {
+	//+field:sequence:*PrefixStatus:struct:PrefixStatus
+	Prefixes []*PrefixStatus `tlv:"0x1B1"`
+}
+
+type PrefixStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:sequence:*PrefixExit:struct:PrefixExit
+	Exits []*PrefixExit `tlv:"0x1B3"`
+}
+
+type PrefixExit struct {
+	//+field:struct:Destination
+	Router *Destination `tlv:"0xCC"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:bool
+	Denied bool `tlv:"0x1B5"`
+	//+field:string:optional
+	Area optional.Optional[string] `tlv:"0xD4"`
+}
+
+type FibDataset struct {
+	//+field:sequence:*FibStatus:struct:FibStatus
+	Entries []*FibStatus `tlv:"0x1C1"`
+}
+
+type FibStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:sequence:*FibNextHop:struct:FibNextHop
+	NextHops []*FibNextHop `tlv:"0x1C3"`
+}
+
+type FibNextHop struct {
+	//+field:natural
+	FaceId uint64 `tlv:"0x69"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+}
+
+type RouteExplanation struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:struct:Destination
+	Prefix *Destination `tlv:"0x1D1"`
+	//+field:sequence:*RouteExit:struct:RouteExit
+	Exits []*RouteExit `tlv:"0x1D3"`
+}
+
+type RouteExit struct {
+	//+field:struct:Destination
+	Router *Destination `tlv:"0xCC"`
+	//+field:natural
+	PrefixCost uint64 `tlv:"0x1D5"`
+	//+field:natural
+	RouterCost uint64 `tlv:"0x1D7"`
+	//+field:bool
+	Reachable bool `tlv:"0x1D9"`
+	//+field:bool
+	Denied bool `tlv:"0x1B5"`
+	//+field:sequence:*RouteHop:struct:RouteHop
+	NextHops []*RouteHop `tlv:"0x1DB"`
+	//+field:string:optional
+	Area optional.Optional[string] `tlv:"0xD4"`
+}
+
+type RouteHop struct {
+	//+field:struct:Destination
+	Neighbor *Destination `tlv:"0xCE"`
+	//+field:natural
+	FaceId uint64 `tlv:"0x69"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+}
+
+type RouterState struct {
+	//+field:struct:Destination
+	RouterName *Destination `tlv:"0x195"`
+	//+field:natural
+	Timestamp uint64 `tlv:"0x1F1"`
+	//+field:natural
+	BootTime uint64 `tlv:"0x1F3"`
+	//+field:natural
+	AdvertSeq uint64 `tlv:"0x1F5"`
+	//+field:sequence:*AreaState:struct:AreaState
+	Areas []*AreaState `tlv:"0x1F7"`
+	//+field:sequence:*FibStatus:struct:FibStatus
+	Fib []*FibStatus `tlv:"0x1C1"`
+	//+field:sequence:uint64:natural
+	CreatedFaces []uint64 `tlv:"0x201"`
+}
+
+type AreaState struct {
+	//+field:string:optional
+	Area optional.Optional[string] `tlv:"0xD4"`
+	//+field:sequence:*RibRecord:struct:RibRecord
+	Rib []*RibRecord `tlv:"0x1F9"`
+	//+field:sequence:*PrefixRecord:struct:PrefixRecord
+	Prefixes []*PrefixRecord `tlv:"0x1FB"`
+	//+field:wire
+	SvsState enc.Wire `tlv:"0x1FD"`
+}
+
+type RibRecord struct {
+	//+field:struct:Destination
+	Destination *Destination `tlv:"0xCC"`
+	//+field:struct:Destination
+	NextHop *Destination `tlv:"0xCE"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:natural
+	Dist uint64 `tlv:"0x1FF"`
+}
+
+type PrefixRecord struct {
+	//+field:struct:Destination
+	Router *Destination `tlv:"0xCC"`
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:bool
+	Denied bool `tlv:"0x1B5"`
+	//+field:natural:optional
+	FaceId optional.Optional[uint64] `tlv:"0x69"`
 }
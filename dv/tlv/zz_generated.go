@@ -242,6 +242,10 @@ func (encoder *AdvertisementEncoder) Init(value *Advertisement) {
 			}
 		}
 	}
+	if optval, ok := value.GracePeriod.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
 	encoder.Length = l
 
 }
@@ -249,6 +253,7 @@ func (encoder *AdvertisementEncoder) Init(value *Advertisement) {
 // Initializes the advertisement parsing context by initializing its internal entries context.
 func (context *AdvertisementParsingContext) Init() {
 	context.Entries_context.Init()
+
 }
 
 // Encodes the entries of an Advertisement into a binary TLV format in the provided buffer, using type code 202 for each entry with its corresponding sub-encoder.
@@ -281,6 +286,14 @@ func (encoder *AdvertisementEncoder) EncodeInto(value *Advertisement, buf []byte
 			}
 		}
 	}
+	if optval, ok := value.GracePeriod.Get(); ok {
+		buf[pos] = byte(214)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
 }
 
 // Encodes an Advertisement into a wire-format TLV block as a single byte slice for transmission.
@@ -298,6 +311,7 @@ func (encoder *AdvertisementEncoder) Encode(value *Advertisement) enc.Wire {
 func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*Advertisement, error) {
 
 	var handled_Entries bool = false
+	var handled_GracePeriod bool = false
 
 	progress := -1
 	_ = progress
@@ -344,6 +358,29 @@ func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCri
 					}
 					progress--
 				}
+			case 214:
+				if true {
+					handled = true
+					handled_GracePeriod = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.GracePeriod.Set(optval)
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -365,6 +402,9 @@ func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCri
 	if !handled_Entries && err == nil {
 		// sequence - skip
 	}
+	if !handled_GracePeriod && err == nil {
+		value.GracePeriod.Unset()
+	}
 
 	if err != nil {
 		return nil, err
@@ -428,6 +468,11 @@ func (encoder *AdvEntryEncoder) Init(value *AdvEntry) {
 	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
 	l += 1
 	l += uint(1 + enc.Nat(value.OtherCost).EncodingLength())
+	if optval, ok := value.Area.Get(); ok {
+		l += 1
+		l += uint(enc.TLNum(len(optval)).EncodingLength())
+		l += uint(len(optval))
+	}
 	encoder.Length = l
 
 }
@@ -472,6 +517,13 @@ func (encoder *AdvEntryEncoder) EncodeInto(value *AdvEntry, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.OtherCost).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	if optval, ok := value.Area.Get(); ok {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(len(optval)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], optval)
+		pos += uint(len(optval))
+	}
 }
 
 // Encodes an AdvEntry into a byte slice using the AdvEntryEncoder's specified length and returns it as a Wire (a slice containing a single byte slice).
@@ -492,6 +544,7 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 	var handled_NextHop bool = false
 	var handled_Cost bool = false
 	var handled_OtherCost bool = false
+	var handled_Area bool = false
 
 	progress := -1
 	_ = progress
@@ -568,6 +621,18 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 						}
 					}
 				}
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Area.Set(builder.String())
+						}
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -598,6 +663,9 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 	if !handled_OtherCost && err == nil {
 		err = enc.ErrSkipRequired{Name: "OtherCost", TypeNum: 210}
 	}
+	if !handled_Area && err == nil {
+		value.Area.Unset()
+	}
 
 	if err != nil {
 		return nil, err
@@ -1455,6 +1523,163 @@ func ParsePrefixOpRemove(reader enc.WireView, ignoreCritical bool) (*PrefixOpRem
 	return context.Parse(reader, ignoreCritical)
 }
 
+type HelloEncoder struct {
+	Length uint
+}
+
+type HelloParsingContext struct {
+}
+
+func (encoder *HelloEncoder) Init(value *Hello) {
+
+	l := uint(0)
+	l += 3
+	l += uint(enc.TLNum(len(value.Endpoint)).EncodingLength())
+	l += uint(len(value.Endpoint))
+	if optval, ok := value.Area.Get(); ok {
+		l += 1
+		l += uint(enc.TLNum(len(optval)).EncodingLength())
+		l += uint(len(optval))
+	}
+	encoder.Length = l
+
+}
+
+func (context *HelloParsingContext) Init() {
+
+}
+
+func (encoder *HelloEncoder) EncodeInto(value *Hello, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(481))
+	pos += 3
+	pos += uint(enc.TLNum(len(value.Endpoint)).EncodeInto(buf[pos:]))
+	copy(buf[pos:], value.Endpoint)
+	pos += uint(len(value.Endpoint))
+	if optval, ok := value.Area.Get(); ok {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(len(optval)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], optval)
+		pos += uint(len(optval))
+	}
+}
+
+func (encoder *HelloEncoder) Encode(value *Hello) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *HelloParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*Hello, error) {
+
+	var handled_Endpoint bool = false
+	var handled_Area bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &Hello{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 481:
+				if true {
+					handled = true
+					handled_Endpoint = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Endpoint = builder.String()
+						}
+					}
+				}
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Area.Set(builder.String())
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Endpoint && err == nil {
+		err = enc.ErrSkipRequired{Name: "Endpoint", TypeNum: 481}
+	}
+	if !handled_Area && err == nil {
+		value.Area.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *Hello) Encode() enc.Wire {
+	encoder := HelloEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *Hello) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseHello(reader enc.WireView, ignoreCritical bool) (*Hello, error) {
+	context := HelloParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
 type StatusEncoder struct {
 	Length uint
 
@@ -1497,6 +1722,10 @@ func (encoder *StatusEncoder) Init(value *Status) {
 	l += uint(1 + enc.Nat(value.NNeighbors).EncodingLength())
 	l += 3
 	l += uint(1 + enc.Nat(value.NFibEntries).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.NExportDenied).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.NImportDenied).EncodingLength())
 	encoder.Length = l
 
 }
@@ -1558,6 +1787,18 @@ func (encoder *StatusEncoder) EncodeInto(value *Status, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.NFibEntries).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(413))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.NExportDenied).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(415))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.NImportDenied).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
 }
 
 // Encodes a Status object into a binary wire format using the encoder's specified length and returns it as a single slice within a Wire structure.
@@ -1580,6 +1821,8 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	var handled_NRibEntries bool = false
 	var handled_NNeighbors bool = false
 	var handled_NFibEntries bool = false
+	var handled_NExportDenied bool = false
+	var handled_NImportDenied bool = false
 
 	progress := -1
 	_ = progress
@@ -1687,6 +1930,44 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 						}
 					}
 				}
+			case 413:
+				if true {
+					handled = true
+					handled_NExportDenied = true
+					value.NExportDenied = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.NExportDenied = uint64(value.NExportDenied<<8) | uint64(x)
+						}
+					}
+				}
+			case 415:
+				if true {
+					handled = true
+					handled_NImportDenied = true
+					value.NImportDenied = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.NImportDenied = uint64(value.NImportDenied<<8) | uint64(x)
+						}
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -1723,6 +2004,12 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	if !handled_NFibEntries && err == nil {
 		err = enc.ErrSkipRequired{Name: "NFibEntries", TypeNum: 411}
 	}
+	if !handled_NExportDenied && err == nil {
+		err = enc.ErrSkipRequired{Name: "NExportDenied", TypeNum: 413}
+	}
+	if !handled_NImportDenied && err == nil {
+		err = enc.ErrSkipRequired{Name: "NImportDenied", TypeNum: 415}
+	}
 
 	if err != nil {
 		return nil, err
@@ -1751,3 +2038,4004 @@ func ParseStatus(reader enc.WireView, ignoreCritical bool) (*Status, error) {
 	context.Init()
 	return context.Parse(reader, ignoreCritical)
 }
+
+type NeighborsDatasetEncoder struct {
+	Length uint
+
+	Neighbors_subencoder []struct {
+		Neighbors_encoder NeighborStatusEncoder
+	}
+}
+
+type NeighborsDatasetParsingContext struct {
+	Neighbors_context NeighborStatusParsingContext
+}
+
+func (encoder *NeighborsDatasetEncoder) Init(value *NeighborsDataset) {
+	{
+		Neighbors_l := len(value.Neighbors)
+		encoder.Neighbors_subencoder = make([]struct {
+			Neighbors_encoder NeighborStatusEncoder
+		}, Neighbors_l)
+		for i := 0; i < Neighbors_l; i++ {
+			pseudoEncoder := &encoder.Neighbors_subencoder[i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: value.Neighbors[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					encoder.Neighbors_encoder.Init(value.Neighbors)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodingLength())
+					l += encoder.Neighbors_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *NeighborsDatasetParsingContext) Init() {
+	context.Neighbors_context.Init()
+}
+
+func (encoder *NeighborsDatasetEncoder) EncodeInto(value *NeighborsDataset, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(417))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Neighbors_encoder.Length > 0 {
+						encoder.Neighbors_encoder.EncodeInto(value.Neighbors, buf[pos:])
+						pos += encoder.Neighbors_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *NeighborsDatasetEncoder) Encode(value *NeighborsDataset) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *NeighborsDatasetParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*NeighborsDataset, error) {
+
+	var handled_Neighbors bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &NeighborsDataset{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 417:
+				if true {
+					handled = true
+					handled_Neighbors = true
+					if value.Neighbors == nil {
+						value.Neighbors = make([]*NeighborStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Neighbors *NeighborStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Neighbors, err = context.Neighbors_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Neighbors = append(value.Neighbors, pseudoValue.Neighbors)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Neighbors && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *NeighborsDataset) Encode() enc.Wire {
+	encoder := NeighborsDatasetEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *NeighborsDataset) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseNeighborsDataset(reader enc.WireView, ignoreCritical bool) (*NeighborsDataset, error) {
+	context := NeighborsDatasetParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type NeighborStatusEncoder struct {
+	Length uint
+
+	Name_encoder DestinationEncoder
+}
+
+type NeighborStatusParsingContext struct {
+	Name_context DestinationParsingContext
+}
+
+func (encoder *NeighborStatusEncoder) Init(value *NeighborStatus) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	if value.FaceActive {
+		l += 3
+		l += 1
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.LastSeen).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.AdvertBoot).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.AdvertSeq).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	if value.Discovered {
+		l += 3
+		l += 1
+	}
+	encoder.Length = l
+
+}
+
+func (context *NeighborStatusParsingContext) Init() {
+	context.Name_context.Init()
+
+}
+
+func (encoder *NeighborStatusEncoder) EncodeInto(value *NeighborStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(204)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+	buf[pos] = byte(105)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.FaceActive {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(419))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(421))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.LastSeen).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(423))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.AdvertBoot).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(425))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.AdvertSeq).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Discovered {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(427))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+}
+
+func (encoder *NeighborStatusEncoder) Encode(value *NeighborStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *NeighborStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*NeighborStatus, error) {
+
+	var handled_Name bool = false
+	var handled_FaceId bool = false
+	var handled_FaceActive bool = false
+	var handled_LastSeen bool = false
+	var handled_AdvertBoot bool = false
+	var handled_AdvertSeq bool = false
+	var handled_Cost bool = false
+	var handled_Discovered bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &NeighborStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 204:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 105:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 419:
+				if true {
+					handled = true
+					handled_FaceActive = true
+					value.FaceActive = true
+					err = reader.Skip(int(l))
+				}
+			case 421:
+				if true {
+					handled = true
+					handled_LastSeen = true
+					value.LastSeen = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.LastSeen = uint64(value.LastSeen<<8) | uint64(x)
+						}
+					}
+				}
+			case 423:
+				if true {
+					handled = true
+					handled_AdvertBoot = true
+					value.AdvertBoot = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.AdvertBoot = uint64(value.AdvertBoot<<8) | uint64(x)
+						}
+					}
+				}
+			case 425:
+				if true {
+					handled = true
+					handled_AdvertSeq = true
+					value.AdvertSeq = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.AdvertSeq = uint64(value.AdvertSeq<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 427:
+				if true {
+					handled = true
+					handled_Discovered = true
+					value.Discovered = true
+					err = reader.Skip(int(l))
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 105}
+	}
+	if !handled_FaceActive && err == nil {
+		value.FaceActive = false
+	}
+	if !handled_LastSeen && err == nil {
+		err = enc.ErrSkipRequired{Name: "LastSeen", TypeNum: 421}
+	}
+	if !handled_AdvertBoot && err == nil {
+		err = enc.ErrSkipRequired{Name: "AdvertBoot", TypeNum: 423}
+	}
+	if !handled_AdvertSeq && err == nil {
+		err = enc.ErrSkipRequired{Name: "AdvertSeq", TypeNum: 425}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_Discovered && err == nil {
+		value.Discovered = false
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *NeighborStatus) Encode() enc.Wire {
+	encoder := NeighborStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *NeighborStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseNeighborStatus(reader enc.WireView, ignoreCritical bool) (*NeighborStatus, error) {
+	context := NeighborStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixTableDatasetEncoder struct {
+	Length uint
+
+	Prefixes_subencoder []struct {
+		Prefixes_encoder PrefixStatusEncoder
+	}
+}
+
+type PrefixTableDatasetParsingContext struct {
+	Prefixes_context PrefixStatusParsingContext
+}
+
+func (encoder *PrefixTableDatasetEncoder) Init(value *PrefixTableDataset) {
+	{
+		Prefixes_l := len(value.Prefixes)
+		encoder.Prefixes_subencoder = make([]struct {
+			Prefixes_encoder PrefixStatusEncoder
+		}, Prefixes_l)
+		for i := 0; i < Prefixes_l; i++ {
+			pseudoEncoder := &encoder.Prefixes_subencoder[i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: value.Prefixes[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					encoder.Prefixes_encoder.Init(value.Prefixes)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodingLength())
+					l += encoder.Prefixes_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixTableDatasetParsingContext) Init() {
+	context.Prefixes_context.Init()
+}
+
+func (encoder *PrefixTableDatasetEncoder) EncodeInto(value *PrefixTableDataset, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(433))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Prefixes_encoder.Length > 0 {
+						encoder.Prefixes_encoder.EncodeInto(value.Prefixes, buf[pos:])
+						pos += encoder.Prefixes_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *PrefixTableDatasetEncoder) Encode(value *PrefixTableDataset) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixTableDatasetParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixTableDataset, error) {
+
+	var handled_Prefixes bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixTableDataset{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 433:
+				if true {
+					handled = true
+					handled_Prefixes = true
+					if value.Prefixes == nil {
+						value.Prefixes = make([]*PrefixStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Prefixes *PrefixStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Prefixes, err = context.Prefixes_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Prefixes = append(value.Prefixes, pseudoValue.Prefixes)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Prefixes && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixTableDataset) Encode() enc.Wire {
+	encoder := PrefixTableDatasetEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixTableDataset) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixTableDataset(reader enc.WireView, ignoreCritical bool) (*PrefixTableDataset, error) {
+	context := PrefixTableDatasetParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixStatusEncoder struct {
+	Length uint
+
+	Name_length      uint
+	Exits_subencoder []struct {
+		Exits_encoder PrefixExitEncoder
+	}
+}
+
+type PrefixStatusParsingContext struct {
+	Exits_context PrefixExitParsingContext
+}
+
+func (encoder *PrefixStatusEncoder) Init(value *PrefixStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+	{
+		Exits_l := len(value.Exits)
+		encoder.Exits_subencoder = make([]struct {
+			Exits_encoder PrefixExitEncoder
+		}, Exits_l)
+		for i := 0; i < Exits_l; i++ {
+			pseudoEncoder := &encoder.Exits_subencoder[i]
+			pseudoValue := struct {
+				Exits *PrefixExit
+			}{
+				Exits: value.Exits[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					encoder.Exits_encoder.Init(value.Exits)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	if value.Exits != nil {
+		for seq_i, seq_v := range value.Exits {
+			pseudoEncoder := &encoder.Exits_subencoder[seq_i]
+			pseudoValue := struct {
+				Exits *PrefixExit
+			}{
+				Exits: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Exits_encoder.Length).EncodingLength())
+					l += encoder.Exits_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixStatusParsingContext) Init() {
+
+	context.Exits_context.Init()
+}
+
+func (encoder *PrefixStatusEncoder) EncodeInto(value *PrefixStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if value.Exits != nil {
+		for seq_i, seq_v := range value.Exits {
+			pseudoEncoder := &encoder.Exits_subencoder[seq_i]
+			pseudoValue := struct {
+				Exits *PrefixExit
+			}{
+				Exits: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(435))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Exits_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Exits_encoder.Length > 0 {
+						encoder.Exits_encoder.EncodeInto(value.Exits, buf[pos:])
+						pos += encoder.Exits_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *PrefixStatusEncoder) Encode(value *PrefixStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+
+	var handled_Name bool = false
+	var handled_Exits bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 435:
+				if true {
+					handled = true
+					handled_Exits = true
+					if value.Exits == nil {
+						value.Exits = make([]*PrefixExit, 0)
+					}
+					{
+						pseudoValue := struct {
+							Exits *PrefixExit
+						}{}
+						{
+							value := &pseudoValue
+							value.Exits, err = context.Exits_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Exits = append(value.Exits, pseudoValue.Exits)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Exits && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixStatus) Encode() enc.Wire {
+	encoder := PrefixStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixStatus(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+	context := PrefixStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixExitEncoder struct {
+	Length uint
+
+	Router_encoder DestinationEncoder
+}
+
+type PrefixExitParsingContext struct {
+	Router_context DestinationParsingContext
+}
+
+func (encoder *PrefixExitEncoder) Init(value *PrefixExit) {
+	if value.Router != nil {
+		encoder.Router_encoder.Init(value.Router)
+	}
+
+	l := uint(0)
+	if value.Router != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Router_encoder.Length).EncodingLength())
+		l += encoder.Router_encoder.Length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	if value.Denied {
+		l += 3
+		l += 1
+	}
+	if optval, ok := value.Area.Get(); ok {
+		l += 1
+		l += uint(enc.TLNum(len(optval)).EncodingLength())
+		l += uint(len(optval))
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixExitParsingContext) Init() {
+	context.Router_context.Init()
+
+}
+
+func (encoder *PrefixExitEncoder) EncodeInto(value *PrefixExit, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Router != nil {
+		buf[pos] = byte(204)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Router_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Router_encoder.Length > 0 {
+			encoder.Router_encoder.EncodeInto(value.Router, buf[pos:])
+			pos += encoder.Router_encoder.Length
+		}
+	}
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Denied {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(437))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	if optval, ok := value.Area.Get(); ok {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(len(optval)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], optval)
+		pos += uint(len(optval))
+	}
+}
+
+func (encoder *PrefixExitEncoder) Encode(value *PrefixExit) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixExitParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixExit, error) {
+
+	var handled_Router bool = false
+	var handled_Cost bool = false
+	var handled_Denied bool = false
+	var handled_Area bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixExit{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 204:
+				if true {
+					handled = true
+					handled_Router = true
+					value.Router, err = context.Router_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 437:
+				if true {
+					handled = true
+					handled_Denied = true
+					value.Denied = true
+					err = reader.Skip(int(l))
+				}
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Area.Set(builder.String())
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Router && err == nil {
+		value.Router = nil
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_Denied && err == nil {
+		value.Denied = false
+	}
+	if !handled_Area && err == nil {
+		value.Area.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixExit) Encode() enc.Wire {
+	encoder := PrefixExitEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixExit) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixExit(reader enc.WireView, ignoreCritical bool) (*PrefixExit, error) {
+	context := PrefixExitParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibDatasetEncoder struct {
+	Length uint
+
+	Entries_subencoder []struct {
+		Entries_encoder FibStatusEncoder
+	}
+}
+
+type FibDatasetParsingContext struct {
+	Entries_context FibStatusParsingContext
+}
+
+func (encoder *FibDatasetEncoder) Init(value *FibDataset) {
+	{
+		Entries_l := len(value.Entries)
+		encoder.Entries_subencoder = make([]struct {
+			Entries_encoder FibStatusEncoder
+		}, Entries_l)
+		for i := 0; i < Entries_l; i++ {
+			pseudoEncoder := &encoder.Entries_subencoder[i]
+			pseudoValue := struct {
+				Entries *FibStatus
+			}{
+				Entries: value.Entries[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					encoder.Entries_encoder.Init(value.Entries)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *FibStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodingLength())
+					l += encoder.Entries_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *FibDatasetParsingContext) Init() {
+	context.Entries_context.Init()
+}
+
+func (encoder *FibDatasetEncoder) EncodeInto(value *FibDataset, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *FibStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(449))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Entries_encoder.Length > 0 {
+						encoder.Entries_encoder.EncodeInto(value.Entries, buf[pos:])
+						pos += encoder.Entries_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *FibDatasetEncoder) Encode(value *FibDataset) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibDatasetParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibDataset, error) {
+
+	var handled_Entries bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibDataset{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 449:
+				if true {
+					handled = true
+					handled_Entries = true
+					if value.Entries == nil {
+						value.Entries = make([]*FibStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Entries *FibStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Entries, err = context.Entries_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Entries = append(value.Entries, pseudoValue.Entries)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Entries && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibDataset) Encode() enc.Wire {
+	encoder := FibDatasetEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibDataset) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibDataset(reader enc.WireView, ignoreCritical bool) (*FibDataset, error) {
+	context := FibDatasetParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibStatusEncoder struct {
+	Length uint
+
+	Name_length         uint
+	NextHops_subencoder []struct {
+		NextHops_encoder FibNextHopEncoder
+	}
+}
+
+type FibStatusParsingContext struct {
+	NextHops_context FibNextHopParsingContext
+}
+
+func (encoder *FibStatusEncoder) Init(value *FibStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+	{
+		NextHops_l := len(value.NextHops)
+		encoder.NextHops_subencoder = make([]struct {
+			NextHops_encoder FibNextHopEncoder
+		}, NextHops_l)
+		for i := 0; i < NextHops_l; i++ {
+			pseudoEncoder := &encoder.NextHops_subencoder[i]
+			pseudoValue := struct {
+				NextHops *FibNextHop
+			}{
+				NextHops: value.NextHops[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					encoder.NextHops_encoder.Init(value.NextHops)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *FibNextHop
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodingLength())
+					l += encoder.NextHops_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *FibStatusParsingContext) Init() {
+
+	context.NextHops_context.Init()
+}
+
+func (encoder *FibStatusEncoder) EncodeInto(value *FibStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *FibNextHop
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(451))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.NextHops_encoder.Length > 0 {
+						encoder.NextHops_encoder.EncodeInto(value.NextHops, buf[pos:])
+						pos += encoder.NextHops_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *FibStatusEncoder) Encode(value *FibStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibStatus, error) {
+
+	var handled_Name bool = false
+	var handled_NextHops bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 451:
+				if true {
+					handled = true
+					handled_NextHops = true
+					if value.NextHops == nil {
+						value.NextHops = make([]*FibNextHop, 0)
+					}
+					{
+						pseudoValue := struct {
+							NextHops *FibNextHop
+						}{}
+						{
+							value := &pseudoValue
+							value.NextHops, err = context.NextHops_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.NextHops = append(value.NextHops, pseudoValue.NextHops)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_NextHops && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibStatus) Encode() enc.Wire {
+	encoder := FibStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibStatus(reader enc.WireView, ignoreCritical bool) (*FibStatus, error) {
+	context := FibStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibNextHopEncoder struct {
+	Length uint
+}
+
+type FibNextHopParsingContext struct {
+}
+
+func (encoder *FibNextHopEncoder) Init(value *FibNextHop) {
+
+	l := uint(0)
+	l += 1
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *FibNextHopParsingContext) Init() {
+
+}
+
+func (encoder *FibNextHopEncoder) EncodeInto(value *FibNextHop, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = byte(105)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *FibNextHopEncoder) Encode(value *FibNextHop) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibNextHopParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibNextHop, error) {
+
+	var handled_FaceId bool = false
+	var handled_Cost bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibNextHop{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 105:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 105}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibNextHop) Encode() enc.Wire {
+	encoder := FibNextHopEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibNextHop) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibNextHop(reader enc.WireView, ignoreCritical bool) (*FibNextHop, error) {
+	context := FibNextHopParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RouteExplanationEncoder struct {
+	Length uint
+
+	Name_length      uint
+	Prefix_encoder   DestinationEncoder
+	Exits_subencoder []struct {
+		Exits_encoder RouteExitEncoder
+	}
+}
+
+type RouteExplanationParsingContext struct {
+	Prefix_context DestinationParsingContext
+	Exits_context  RouteExitParsingContext
+}
+
+func (encoder *RouteExplanationEncoder) Init(value *RouteExplanation) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+	if value.Prefix != nil {
+		encoder.Prefix_encoder.Init(value.Prefix)
+	}
+	{
+		Exits_l := len(value.Exits)
+		encoder.Exits_subencoder = make([]struct {
+			Exits_encoder RouteExitEncoder
+		}, Exits_l)
+		for i := 0; i < Exits_l; i++ {
+			pseudoEncoder := &encoder.Exits_subencoder[i]
+			pseudoValue := struct {
+				Exits *RouteExit
+			}{
+				Exits: value.Exits[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					encoder.Exits_encoder.Init(value.Exits)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	if value.Prefix != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Prefix_encoder.Length).EncodingLength())
+		l += encoder.Prefix_encoder.Length
+	}
+	if value.Exits != nil {
+		for seq_i, seq_v := range value.Exits {
+			pseudoEncoder := &encoder.Exits_subencoder[seq_i]
+			pseudoValue := struct {
+				Exits *RouteExit
+			}{
+				Exits: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Exits_encoder.Length).EncodingLength())
+					l += encoder.Exits_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *RouteExplanationParsingContext) Init() {
+
+	context.Prefix_context.Init()
+	context.Exits_context.Init()
+}
+
+func (encoder *RouteExplanationEncoder) EncodeInto(value *RouteExplanation, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if value.Prefix != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(465))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Prefix_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Prefix_encoder.Length > 0 {
+			encoder.Prefix_encoder.EncodeInto(value.Prefix, buf[pos:])
+			pos += encoder.Prefix_encoder.Length
+		}
+	}
+	if value.Exits != nil {
+		for seq_i, seq_v := range value.Exits {
+			pseudoEncoder := &encoder.Exits_subencoder[seq_i]
+			pseudoValue := struct {
+				Exits *RouteExit
+			}{
+				Exits: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Exits != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(467))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Exits_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Exits_encoder.Length > 0 {
+						encoder.Exits_encoder.EncodeInto(value.Exits, buf[pos:])
+						pos += encoder.Exits_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *RouteExplanationEncoder) Encode(value *RouteExplanation) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RouteExplanationParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RouteExplanation, error) {
+
+	var handled_Name bool = false
+	var handled_Prefix bool = false
+	var handled_Exits bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RouteExplanation{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 465:
+				if true {
+					handled = true
+					handled_Prefix = true
+					value.Prefix, err = context.Prefix_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 467:
+				if true {
+					handled = true
+					handled_Exits = true
+					if value.Exits == nil {
+						value.Exits = make([]*RouteExit, 0)
+					}
+					{
+						pseudoValue := struct {
+							Exits *RouteExit
+						}{}
+						{
+							value := &pseudoValue
+							value.Exits, err = context.Exits_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Exits = append(value.Exits, pseudoValue.Exits)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Prefix && err == nil {
+		value.Prefix = nil
+	}
+	if !handled_Exits && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RouteExplanation) Encode() enc.Wire {
+	encoder := RouteExplanationEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RouteExplanation) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRouteExplanation(reader enc.WireView, ignoreCritical bool) (*RouteExplanation, error) {
+	context := RouteExplanationParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RouteExitEncoder struct {
+	Length uint
+
+	Router_encoder DestinationEncoder
+
+	NextHops_subencoder []struct {
+		NextHops_encoder RouteHopEncoder
+	}
+}
+
+type RouteExitParsingContext struct {
+	Router_context DestinationParsingContext
+
+	NextHops_context RouteHopParsingContext
+}
+
+func (encoder *RouteExitEncoder) Init(value *RouteExit) {
+	if value.Router != nil {
+		encoder.Router_encoder.Init(value.Router)
+	}
+
+	{
+		NextHops_l := len(value.NextHops)
+		encoder.NextHops_subencoder = make([]struct {
+			NextHops_encoder RouteHopEncoder
+		}, NextHops_l)
+		for i := 0; i < NextHops_l; i++ {
+			pseudoEncoder := &encoder.NextHops_subencoder[i]
+			pseudoValue := struct {
+				NextHops *RouteHop
+			}{
+				NextHops: value.NextHops[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					encoder.NextHops_encoder.Init(value.NextHops)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Router != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Router_encoder.Length).EncodingLength())
+		l += encoder.Router_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.PrefixCost).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.RouterCost).EncodingLength())
+	if value.Reachable {
+		l += 3
+		l += 1
+	}
+	if value.Denied {
+		l += 3
+		l += 1
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *RouteHop
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodingLength())
+					l += encoder.NextHops_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if optval, ok := value.Area.Get(); ok {
+		l += 1
+		l += uint(enc.TLNum(len(optval)).EncodingLength())
+		l += uint(len(optval))
+	}
+	encoder.Length = l
+
+}
+
+func (context *RouteExitParsingContext) Init() {
+	context.Router_context.Init()
+
+	context.NextHops_context.Init()
+
+}
+
+func (encoder *RouteExitEncoder) EncodeInto(value *RouteExit, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Router != nil {
+		buf[pos] = byte(204)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Router_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Router_encoder.Length > 0 {
+			encoder.Router_encoder.EncodeInto(value.Router, buf[pos:])
+			pos += encoder.Router_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(469))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.PrefixCost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(471))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.RouterCost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Reachable {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(473))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	if value.Denied {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(437))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *RouteHop
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(475))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.NextHops_encoder.Length > 0 {
+						encoder.NextHops_encoder.EncodeInto(value.NextHops, buf[pos:])
+						pos += encoder.NextHops_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if optval, ok := value.Area.Get(); ok {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(len(optval)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], optval)
+		pos += uint(len(optval))
+	}
+}
+
+func (encoder *RouteExitEncoder) Encode(value *RouteExit) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RouteExitParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RouteExit, error) {
+
+	var handled_Router bool = false
+	var handled_PrefixCost bool = false
+	var handled_RouterCost bool = false
+	var handled_Reachable bool = false
+	var handled_Denied bool = false
+	var handled_NextHops bool = false
+	var handled_Area bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RouteExit{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 204:
+				if true {
+					handled = true
+					handled_Router = true
+					value.Router, err = context.Router_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 469:
+				if true {
+					handled = true
+					handled_PrefixCost = true
+					value.PrefixCost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.PrefixCost = uint64(value.PrefixCost<<8) | uint64(x)
+						}
+					}
+				}
+			case 471:
+				if true {
+					handled = true
+					handled_RouterCost = true
+					value.RouterCost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.RouterCost = uint64(value.RouterCost<<8) | uint64(x)
+						}
+					}
+				}
+			case 473:
+				if true {
+					handled = true
+					handled_Reachable = true
+					value.Reachable = true
+					err = reader.Skip(int(l))
+				}
+			case 437:
+				if true {
+					handled = true
+					handled_Denied = true
+					value.Denied = true
+					err = reader.Skip(int(l))
+				}
+			case 475:
+				if true {
+					handled = true
+					handled_NextHops = true
+					if value.NextHops == nil {
+						value.NextHops = make([]*RouteHop, 0)
+					}
+					{
+						pseudoValue := struct {
+							NextHops *RouteHop
+						}{}
+						{
+							value := &pseudoValue
+							value.NextHops, err = context.NextHops_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.NextHops = append(value.NextHops, pseudoValue.NextHops)
+					}
+					progress--
+				}
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Area.Set(builder.String())
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Router && err == nil {
+		value.Router = nil
+	}
+	if !handled_PrefixCost && err == nil {
+		err = enc.ErrSkipRequired{Name: "PrefixCost", TypeNum: 469}
+	}
+	if !handled_RouterCost && err == nil {
+		err = enc.ErrSkipRequired{Name: "RouterCost", TypeNum: 471}
+	}
+	if !handled_Reachable && err == nil {
+		value.Reachable = false
+	}
+	if !handled_Denied && err == nil {
+		value.Denied = false
+	}
+	if !handled_NextHops && err == nil {
+		// sequence - skip
+	}
+	if !handled_Area && err == nil {
+		value.Area.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RouteExit) Encode() enc.Wire {
+	encoder := RouteExitEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RouteExit) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRouteExit(reader enc.WireView, ignoreCritical bool) (*RouteExit, error) {
+	context := RouteExitParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RouteHopEncoder struct {
+	Length uint
+
+	Neighbor_encoder DestinationEncoder
+}
+
+type RouteHopParsingContext struct {
+	Neighbor_context DestinationParsingContext
+}
+
+func (encoder *RouteHopEncoder) Init(value *RouteHop) {
+	if value.Neighbor != nil {
+		encoder.Neighbor_encoder.Init(value.Neighbor)
+	}
+
+	l := uint(0)
+	if value.Neighbor != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Neighbor_encoder.Length).EncodingLength())
+		l += encoder.Neighbor_encoder.Length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *RouteHopParsingContext) Init() {
+	context.Neighbor_context.Init()
+
+}
+
+func (encoder *RouteHopEncoder) EncodeInto(value *RouteHop, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Neighbor != nil {
+		buf[pos] = byte(206)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Neighbor_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Neighbor_encoder.Length > 0 {
+			encoder.Neighbor_encoder.EncodeInto(value.Neighbor, buf[pos:])
+			pos += encoder.Neighbor_encoder.Length
+		}
+	}
+	buf[pos] = byte(105)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *RouteHopEncoder) Encode(value *RouteHop) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RouteHopParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RouteHop, error) {
+
+	var handled_Neighbor bool = false
+	var handled_FaceId bool = false
+	var handled_Cost bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RouteHop{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 206:
+				if true {
+					handled = true
+					handled_Neighbor = true
+					value.Neighbor, err = context.Neighbor_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 105:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Neighbor && err == nil {
+		value.Neighbor = nil
+	}
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 105}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RouteHop) Encode() enc.Wire {
+	encoder := RouteHopEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RouteHop) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRouteHop(reader enc.WireView, ignoreCritical bool) (*RouteHop, error) {
+	context := RouteHopParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RouterStateEncoder struct {
+	Length uint
+
+	RouterName_encoder DestinationEncoder
+
+	Areas_subencoder []struct {
+		Areas_encoder AreaStateEncoder
+	}
+	Fib_subencoder []struct {
+		Fib_encoder FibStatusEncoder
+	}
+	CreatedFaces_subencoder []struct {
+	}
+}
+
+type RouterStateParsingContext struct {
+	RouterName_context DestinationParsingContext
+
+	Areas_context AreaStateParsingContext
+	Fib_context   FibStatusParsingContext
+}
+
+func (encoder *RouterStateEncoder) Init(value *RouterState) {
+	if value.RouterName != nil {
+		encoder.RouterName_encoder.Init(value.RouterName)
+	}
+
+	{
+		Areas_l := len(value.Areas)
+		encoder.Areas_subencoder = make([]struct {
+			Areas_encoder AreaStateEncoder
+		}, Areas_l)
+		for i := 0; i < Areas_l; i++ {
+			pseudoEncoder := &encoder.Areas_subencoder[i]
+			pseudoValue := struct {
+				Areas *AreaState
+			}{
+				Areas: value.Areas[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Areas != nil {
+					encoder.Areas_encoder.Init(value.Areas)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Fib_l := len(value.Fib)
+		encoder.Fib_subencoder = make([]struct {
+			Fib_encoder FibStatusEncoder
+		}, Fib_l)
+		for i := 0; i < Fib_l; i++ {
+			pseudoEncoder := &encoder.Fib_subencoder[i]
+			pseudoValue := struct {
+				Fib *FibStatus
+			}{
+				Fib: value.Fib[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Fib != nil {
+					encoder.Fib_encoder.Init(value.Fib)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		CreatedFaces_l := len(value.CreatedFaces)
+		encoder.CreatedFaces_subencoder = make([]struct {
+		}, CreatedFaces_l)
+		for i := 0; i < CreatedFaces_l; i++ {
+			pseudoEncoder := &encoder.CreatedFaces_subencoder[i]
+			pseudoValue := struct {
+				CreatedFaces uint64
+			}{
+				CreatedFaces: value.CreatedFaces[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.RouterName != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.RouterName_encoder.Length).EncodingLength())
+		l += encoder.RouterName_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.Timestamp).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.BootTime).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.AdvertSeq).EncodingLength())
+	if value.Areas != nil {
+		for seq_i, seq_v := range value.Areas {
+			pseudoEncoder := &encoder.Areas_subencoder[seq_i]
+			pseudoValue := struct {
+				Areas *AreaState
+			}{
+				Areas: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Areas != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Areas_encoder.Length).EncodingLength())
+					l += encoder.Areas_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Fib != nil {
+		for seq_i, seq_v := range value.Fib {
+			pseudoEncoder := &encoder.Fib_subencoder[seq_i]
+			pseudoValue := struct {
+				Fib *FibStatus
+			}{
+				Fib: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Fib != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Fib_encoder.Length).EncodingLength())
+					l += encoder.Fib_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.CreatedFaces != nil {
+		for seq_i, seq_v := range value.CreatedFaces {
+			pseudoEncoder := &encoder.CreatedFaces_subencoder[seq_i]
+			pseudoValue := struct {
+				CreatedFaces uint64
+			}{
+				CreatedFaces: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				l += 3
+				l += uint(1 + enc.Nat(value.CreatedFaces).EncodingLength())
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *RouterStateParsingContext) Init() {
+	context.RouterName_context.Init()
+
+	context.Areas_context.Init()
+	context.Fib_context.Init()
+
+}
+
+func (encoder *RouterStateEncoder) EncodeInto(value *RouterState, buf []byte) {
+
+	pos := uint(0)
+
+	if value.RouterName != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(405))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.RouterName_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.RouterName_encoder.Length > 0 {
+			encoder.RouterName_encoder.EncodeInto(value.RouterName, buf[pos:])
+			pos += encoder.RouterName_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(497))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Timestamp).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(499))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.BootTime).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(501))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.AdvertSeq).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Areas != nil {
+		for seq_i, seq_v := range value.Areas {
+			pseudoEncoder := &encoder.Areas_subencoder[seq_i]
+			pseudoValue := struct {
+				Areas *AreaState
+			}{
+				Areas: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Areas != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(503))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Areas_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Areas_encoder.Length > 0 {
+						encoder.Areas_encoder.EncodeInto(value.Areas, buf[pos:])
+						pos += encoder.Areas_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Fib != nil {
+		for seq_i, seq_v := range value.Fib {
+			pseudoEncoder := &encoder.Fib_subencoder[seq_i]
+			pseudoValue := struct {
+				Fib *FibStatus
+			}{
+				Fib: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Fib != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(449))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Fib_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Fib_encoder.Length > 0 {
+						encoder.Fib_encoder.EncodeInto(value.Fib, buf[pos:])
+						pos += encoder.Fib_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.CreatedFaces != nil {
+		for seq_i, seq_v := range value.CreatedFaces {
+			pseudoEncoder := &encoder.CreatedFaces_subencoder[seq_i]
+			pseudoValue := struct {
+				CreatedFaces uint64
+			}{
+				CreatedFaces: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				buf[pos] = 253
+				binary.BigEndian.PutUint16(buf[pos+1:], uint16(513))
+				pos += 3
+
+				buf[pos] = byte(enc.Nat(value.CreatedFaces).EncodeInto(buf[pos+1:]))
+				pos += uint(1 + buf[pos])
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *RouterStateEncoder) Encode(value *RouterState) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RouterStateParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RouterState, error) {
+
+	var handled_RouterName bool = false
+	var handled_Timestamp bool = false
+	var handled_BootTime bool = false
+	var handled_AdvertSeq bool = false
+	var handled_Areas bool = false
+	var handled_Fib bool = false
+	var handled_CreatedFaces bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RouterState{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 405:
+				if true {
+					handled = true
+					handled_RouterName = true
+					value.RouterName, err = context.RouterName_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 497:
+				if true {
+					handled = true
+					handled_Timestamp = true
+					value.Timestamp = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Timestamp = uint64(value.Timestamp<<8) | uint64(x)
+						}
+					}
+				}
+			case 499:
+				if true {
+					handled = true
+					handled_BootTime = true
+					value.BootTime = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.BootTime = uint64(value.BootTime<<8) | uint64(x)
+						}
+					}
+				}
+			case 501:
+				if true {
+					handled = true
+					handled_AdvertSeq = true
+					value.AdvertSeq = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.AdvertSeq = uint64(value.AdvertSeq<<8) | uint64(x)
+						}
+					}
+				}
+			case 503:
+				if true {
+					handled = true
+					handled_Areas = true
+					if value.Areas == nil {
+						value.Areas = make([]*AreaState, 0)
+					}
+					{
+						pseudoValue := struct {
+							Areas *AreaState
+						}{}
+						{
+							value := &pseudoValue
+							value.Areas, err = context.Areas_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Areas = append(value.Areas, pseudoValue.Areas)
+					}
+					progress--
+				}
+			case 449:
+				if true {
+					handled = true
+					handled_Fib = true
+					if value.Fib == nil {
+						value.Fib = make([]*FibStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Fib *FibStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Fib, err = context.Fib_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Fib = append(value.Fib, pseudoValue.Fib)
+					}
+					progress--
+				}
+			case 513:
+				if true {
+					handled = true
+					handled_CreatedFaces = true
+					if value.CreatedFaces == nil {
+						value.CreatedFaces = make([]uint64, 0)
+					}
+					{
+						pseudoValue := struct {
+							CreatedFaces uint64
+						}{}
+						{
+							value := &pseudoValue
+							value.CreatedFaces = uint64(0)
+							{
+								for i := 0; i < int(l); i++ {
+									x := byte(0)
+									x, err = reader.ReadByte()
+									if err != nil {
+										if err == io.EOF {
+											err = io.ErrUnexpectedEOF
+										}
+										break
+									}
+									value.CreatedFaces = uint64(value.CreatedFaces<<8) | uint64(x)
+								}
+							}
+							_ = value
+						}
+						value.CreatedFaces = append(value.CreatedFaces, pseudoValue.CreatedFaces)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_RouterName && err == nil {
+		value.RouterName = nil
+	}
+	if !handled_Timestamp && err == nil {
+		err = enc.ErrSkipRequired{Name: "Timestamp", TypeNum: 497}
+	}
+	if !handled_BootTime && err == nil {
+		err = enc.ErrSkipRequired{Name: "BootTime", TypeNum: 499}
+	}
+	if !handled_AdvertSeq && err == nil {
+		err = enc.ErrSkipRequired{Name: "AdvertSeq", TypeNum: 501}
+	}
+	if !handled_Areas && err == nil {
+		// sequence - skip
+	}
+	if !handled_Fib && err == nil {
+		// sequence - skip
+	}
+	if !handled_CreatedFaces && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RouterState) Encode() enc.Wire {
+	encoder := RouterStateEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RouterState) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRouterState(reader enc.WireView, ignoreCritical bool) (*RouterState, error) {
+	context := RouterStateParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type AreaStateEncoder struct {
+	Length uint
+
+	Rib_subencoder []struct {
+		Rib_encoder RibRecordEncoder
+	}
+	Prefixes_subencoder []struct {
+		Prefixes_encoder PrefixRecordEncoder
+	}
+	SvsState_length uint
+}
+
+type AreaStateParsingContext struct {
+	Rib_context      RibRecordParsingContext
+	Prefixes_context PrefixRecordParsingContext
+}
+
+func (encoder *AreaStateEncoder) Init(value *AreaState) {
+
+	{
+		Rib_l := len(value.Rib)
+		encoder.Rib_subencoder = make([]struct {
+			Rib_encoder RibRecordEncoder
+		}, Rib_l)
+		for i := 0; i < Rib_l; i++ {
+			pseudoEncoder := &encoder.Rib_subencoder[i]
+			pseudoValue := struct {
+				Rib *RibRecord
+			}{
+				Rib: value.Rib[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Rib != nil {
+					encoder.Rib_encoder.Init(value.Rib)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Prefixes_l := len(value.Prefixes)
+		encoder.Prefixes_subencoder = make([]struct {
+			Prefixes_encoder PrefixRecordEncoder
+		}, Prefixes_l)
+		for i := 0; i < Prefixes_l; i++ {
+			pseudoEncoder := &encoder.Prefixes_subencoder[i]
+			pseudoValue := struct {
+				Prefixes *PrefixRecord
+			}{
+				Prefixes: value.Prefixes[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					encoder.Prefixes_encoder.Init(value.Prefixes)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.SvsState != nil {
+		encoder.SvsState_length = 0
+		for _, c := range value.SvsState {
+			encoder.SvsState_length += uint(len(c))
+		}
+	}
+
+	l := uint(0)
+	if optval, ok := value.Area.Get(); ok {
+		l += 1
+		l += uint(enc.TLNum(len(optval)).EncodingLength())
+		l += uint(len(optval))
+	}
+	if value.Rib != nil {
+		for seq_i, seq_v := range value.Rib {
+			pseudoEncoder := &encoder.Rib_subencoder[seq_i]
+			pseudoValue := struct {
+				Rib *RibRecord
+			}{
+				Rib: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Rib != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Rib_encoder.Length).EncodingLength())
+					l += encoder.Rib_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixRecord
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodingLength())
+					l += encoder.Prefixes_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.SvsState != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.SvsState_length).EncodingLength())
+		l += encoder.SvsState_length
+	}
+	encoder.Length = l
+
+}
+
+func (context *AreaStateParsingContext) Init() {
+
+	context.Rib_context.Init()
+	context.Prefixes_context.Init()
+
+}
+
+func (encoder *AreaStateEncoder) EncodeInto(value *AreaState, buf []byte) {
+
+	pos := uint(0)
+
+	if optval, ok := value.Area.Get(); ok {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(len(optval)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], optval)
+		pos += uint(len(optval))
+	}
+	if value.Rib != nil {
+		for seq_i, seq_v := range value.Rib {
+			pseudoEncoder := &encoder.Rib_subencoder[seq_i]
+			pseudoValue := struct {
+				Rib *RibRecord
+			}{
+				Rib: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Rib != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(505))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Rib_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Rib_encoder.Length > 0 {
+						encoder.Rib_encoder.EncodeInto(value.Rib, buf[pos:])
+						pos += encoder.Rib_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixRecord
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(507))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Prefixes_encoder.Length > 0 {
+						encoder.Prefixes_encoder.EncodeInto(value.Prefixes, buf[pos:])
+						pos += encoder.Prefixes_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.SvsState != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(509))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.SvsState_length).EncodeInto(buf[pos:]))
+		for _, w := range value.SvsState {
+			copy(buf[pos:], w)
+			pos += uint(len(w))
+		}
+	}
+}
+
+func (encoder *AreaStateEncoder) Encode(value *AreaState) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *AreaStateParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*AreaState, error) {
+
+	var handled_Area bool = false
+	var handled_Rib bool = false
+	var handled_Prefixes bool = false
+	var handled_SvsState bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &AreaState{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Area.Set(builder.String())
+						}
+					}
+				}
+			case 505:
+				if true {
+					handled = true
+					handled_Rib = true
+					if value.Rib == nil {
+						value.Rib = make([]*RibRecord, 0)
+					}
+					{
+						pseudoValue := struct {
+							Rib *RibRecord
+						}{}
+						{
+							value := &pseudoValue
+							value.Rib, err = context.Rib_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Rib = append(value.Rib, pseudoValue.Rib)
+					}
+					progress--
+				}
+			case 507:
+				if true {
+					handled = true
+					handled_Prefixes = true
+					if value.Prefixes == nil {
+						value.Prefixes = make([]*PrefixRecord, 0)
+					}
+					{
+						pseudoValue := struct {
+							Prefixes *PrefixRecord
+						}{}
+						{
+							value := &pseudoValue
+							value.Prefixes, err = context.Prefixes_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Prefixes = append(value.Prefixes, pseudoValue.Prefixes)
+					}
+					progress--
+				}
+			case 509:
+				if true {
+					handled = true
+					handled_SvsState = true
+					value.SvsState, err = reader.ReadWire(int(l))
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Area && err == nil {
+		value.Area.Unset()
+	}
+	if !handled_Rib && err == nil {
+		// sequence - skip
+	}
+	if !handled_Prefixes && err == nil {
+		// sequence - skip
+	}
+	if !handled_SvsState && err == nil {
+		value.SvsState = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *AreaState) Encode() enc.Wire {
+	encoder := AreaStateEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *AreaState) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseAreaState(reader enc.WireView, ignoreCritical bool) (*AreaState, error) {
+	context := AreaStateParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RibRecordEncoder struct {
+	Length uint
+
+	Destination_encoder DestinationEncoder
+	NextHop_encoder     DestinationEncoder
+}
+
+type RibRecordParsingContext struct {
+	Destination_context DestinationParsingContext
+	NextHop_context     DestinationParsingContext
+}
+
+func (encoder *RibRecordEncoder) Init(value *RibRecord) {
+	if value.Destination != nil {
+		encoder.Destination_encoder.Init(value.Destination)
+	}
+	if value.NextHop != nil {
+		encoder.NextHop_encoder.Init(value.NextHop)
+	}
+
+	l := uint(0)
+	if value.Destination != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Destination_encoder.Length).EncodingLength())
+		l += encoder.Destination_encoder.Length
+	}
+	if value.NextHop != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.NextHop_encoder.Length).EncodingLength())
+		l += encoder.NextHop_encoder.Length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.Dist).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *RibRecordParsingContext) Init() {
+	context.Destination_context.Init()
+	context.NextHop_context.Init()
+
+}
+
+func (encoder *RibRecordEncoder) EncodeInto(value *RibRecord, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Destination != nil {
+		buf[pos] = byte(204)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Destination_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Destination_encoder.Length > 0 {
+			encoder.Destination_encoder.EncodeInto(value.Destination, buf[pos:])
+			pos += encoder.Destination_encoder.Length
+		}
+	}
+	if value.NextHop != nil {
+		buf[pos] = byte(206)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.NextHop_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.NextHop_encoder.Length > 0 {
+			encoder.NextHop_encoder.EncodeInto(value.NextHop, buf[pos:])
+			pos += encoder.NextHop_encoder.Length
+		}
+	}
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(511))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Dist).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *RibRecordEncoder) Encode(value *RibRecord) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RibRecordParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RibRecord, error) {
+
+	var handled_Destination bool = false
+	var handled_NextHop bool = false
+	var handled_Cost bool = false
+	var handled_Dist bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RibRecord{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 204:
+				if true {
+					handled = true
+					handled_Destination = true
+					value.Destination, err = context.Destination_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 206:
+				if true {
+					handled = true
+					handled_NextHop = true
+					value.NextHop, err = context.NextHop_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 511:
+				if true {
+					handled = true
+					handled_Dist = true
+					value.Dist = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Dist = uint64(value.Dist<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Destination && err == nil {
+		value.Destination = nil
+	}
+	if !handled_NextHop && err == nil {
+		value.NextHop = nil
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_Dist && err == nil {
+		err = enc.ErrSkipRequired{Name: "Dist", TypeNum: 511}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RibRecord) Encode() enc.Wire {
+	encoder := RibRecordEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RibRecord) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRibRecord(reader enc.WireView, ignoreCritical bool) (*RibRecord, error) {
+	context := RibRecordParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixRecordEncoder struct {
+	Length uint
+
+	Router_encoder DestinationEncoder
+	Name_length    uint
+}
+
+type PrefixRecordParsingContext struct {
+	Router_context DestinationParsingContext
+}
+
+func (encoder *PrefixRecordEncoder) Init(value *PrefixRecord) {
+	if value.Router != nil {
+		encoder.Router_encoder.Init(value.Router)
+	}
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Router != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Router_encoder.Length).EncodingLength())
+		l += encoder.Router_encoder.Length
+	}
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	if value.Denied {
+		l += 3
+		l += 1
+	}
+	if optval, ok := value.FaceId.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixRecordParsingContext) Init() {
+	context.Router_context.Init()
+
+}
+
+func (encoder *PrefixRecordEncoder) EncodeInto(value *PrefixRecord, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Router != nil {
+		buf[pos] = byte(204)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Router_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Router_encoder.Length > 0 {
+			encoder.Router_encoder.EncodeInto(value.Router, buf[pos:])
+			pos += encoder.Router_encoder.Length
+		}
+	}
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Denied {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(437))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	if optval, ok := value.FaceId.Get(); ok {
+		buf[pos] = byte(105)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+}
+
+func (encoder *PrefixRecordEncoder) Encode(value *PrefixRecord) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixRecordParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixRecord, error) {
+
+	var handled_Router bool = false
+	var handled_Name bool = false
+	var handled_Cost bool = false
+	var handled_Denied bool = false
+	var handled_FaceId bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixRecord{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 204:
+				if true {
+					handled = true
+					handled_Router = true
+					value.Router, err = context.Router_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 437:
+				if true {
+					handled = true
+					handled_Denied = true
+					value.Denied = true
+					err = reader.Skip(int(l))
+				}
+			case 105:
+				if true {
+					handled = true
+					handled_FaceId = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.FaceId.Set(optval)
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Router && err == nil {
+		value.Router = nil
+	}
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_Denied && err == nil {
+		value.Denied = false
+	}
+	if !handled_FaceId && err == nil {
+		value.FaceId.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixRecord) Encode() enc.Wire {
+	encoder := PrefixRecordEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixRecord) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixRecord(reader enc.WireView, ignoreCritical bool) (*PrefixRecord, error) {
+	context := PrefixRecordParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
@@ -0,0 +1,126 @@
+package emu
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// appFace is an application face connected to a forwarder through
+// an app transport, used as the face of an engine on a node.
+type appFace struct {
+	node    *Node
+	running atomic.Bool
+	onPkt   func(frame []byte)
+	onError func(err error)
+
+	link      face.LinkService
+	transport *face.InternalTransport
+}
+
+// Creates an application face on a node. The face is opened by the engine.
+func newAppFace(node *Node) *appFace {
+	return &appFace{node: node}
+}
+
+// Returns a string representation of the face with the name of its node.
+func (f *appFace) String() string {
+	return fmt.Sprintf("emu-app-face (%s)", f.node.name)
+}
+
+// Returns true if the face is running.
+func (f *appFace) IsRunning() bool {
+	return f.running.Load()
+}
+
+// Returns true since the face is local to the forwarder.
+func (f *appFace) IsLocal() bool {
+	return true
+}
+
+// Sets the callback for receiving packets.
+func (f *appFace) OnPacket(onPkt func(frame []byte)) {
+	f.onPkt = onPkt
+}
+
+// Sets the callback for fatal errors.
+func (f *appFace) OnError(onError func(err error)) {
+	f.onError = onError
+}
+
+// Creates the internal face in the forwarder and starts receiving packets.
+func (f *appFace) Open() error {
+	if f.onError == nil || f.onPkt == nil {
+		return fmt.Errorf("face callbacks are not set")
+	}
+	if f.running.Load() {
+		return fmt.Errorf("face is already running")
+	}
+
+	// Applications on the node are numbered like file descriptors
+	uri := defn.MakeFDFaceURI(int(f.node.nApps.Add(1)))
+	f.link, f.transport = face.RegisterAppTransport(f.node.fw.Faces(), uri)
+	f.running.Store(true)
+	go f.receive()
+
+	return nil
+}
+
+// Closes the internal face in the forwarder.
+func (f *appFace) Close() error {
+	if f.running.Swap(false) {
+		f.link.Close()
+	}
+	return nil
+}
+
+// Sends a packet to the forwarder, wrapping it in an LpPacket if needed.
+func (f *appFace) Send(pkt enc.Wire) error {
+	if !f.running.Load() {
+		return fmt.Errorf("face is not running")
+	}
+
+	packet, _, err := spec.ReadPacket(enc.NewWireView(pkt))
+	if err != nil {
+		return err
+	}
+
+	lpPkt := packet.LpPacket
+	if lpPkt == nil {
+		lpPkt = &spec.LpPacket{Fragment: pkt}
+	}
+
+	f.transport.Send(lpPkt)
+	return nil
+}
+
+// The face is up as soon as it is opened, so the callback is never called.
+func (f *appFace) OnUp(onUp func()) (cancel func()) {
+	return func() {}
+}
+
+// The face never goes down without being closed, so the callback is never called.
+func (f *appFace) OnDown(onDown func()) (cancel func()) {
+	return func() {}
+}
+
+// Receives packets from the forwarder until the internal face is closed.
+func (f *appFace) receive() {
+	for {
+		lpPkt := f.transport.Receive()
+		if lpPkt == nil {
+			return
+		}
+
+		pkt := &spec.Packet{LpPacket: lpPkt}
+		encoder := spec.PacketEncoder{}
+		encoder.Init(pkt)
+		if wire := encoder.Encode(pkt); wire != nil {
+			f.onPkt(wire.Join())
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package emu_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/e2e/emu"
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+	"github.com/named-data/ndnd/std/sync/crdt"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Starts a replica of a data type in a sync group on a node.
+// Sync Interests of the group are multicast to all replicas on the node.
+func startReplica(t *testing.T, node *emu.Node, group enc.Name, name string, data crdt.Crdt, threshold uint64) *crdt.Replicator {
+	client := newClient(t, node)
+	rep := tu.NoErr(crdt.NewReplicator(crdt.ReplicatorOpts{
+		Data: data,
+		Alo: ndn_sync.SvsAloOpts{
+			Name: tu.NoErr(enc.NameFromStr(name)),
+			Svs: ndn_sync.SvSyncOpts{
+				Client:      client,
+				GroupPrefix: group,
+			},
+		},
+		SnapshotThreshold: threshold,
+	}))
+
+	multicast := defn.STRATEGY_PREFIX.Append(enc.NewGenericComponent("multicast"), enc.NewVersionComponent(1))
+	node.Forwarder().Fib().SetStrategyEnc(rep.ALO().SyncPrefix(), multicast)
+	for _, route := range []enc.Name{rep.ALO().SyncPrefix(), rep.ALO().DataPrefix()} {
+		client.AnnouncePrefix(ndn.Announcement{Name: route})
+	}
+	require.Eventually(t, func() bool {
+		return len(node.NextHops(rep.ALO().DataPrefix())) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, rep.Start())
+	t.Cleanup(func() { rep.Stop() })
+	return rep
+}
+
+func TestCrdtConverge(t *testing.T) {
+	tu.SetT(t)
+
+	network := emu.NewNetwork(nil)
+	t.Cleanup(network.Stop)
+	a := network.AddNode("a")
+	group := tu.NoErr(enc.NameFromStr("/emu/crdt/map"))
+
+	maps := make([]*crdt.LWWMap, 3)
+	reps := make([]*crdt.Replicator, 3)
+	for i := range maps {
+		maps[i] = crdt.NewLWWMap(fmt.Sprintf("r%d", i))
+		reps[i] = startReplica(t, a, group, fmt.Sprintf("/emu/r%d", i), maps[i], 100)
+	}
+
+	// All replicas write their own keys and the same key concurrently,
+	// and delete the keys of the next replica
+	var wg sync.WaitGroup
+	errs := make(chan error, len(maps))
+	for i := range maps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 5 {
+				if err := reps[i].Publish(maps[i].Set(fmt.Sprintf("r%d-%d", i, j), []byte("value"))); err != nil {
+					errs <- err
+					return
+				}
+				if err := reps[i].Publish(maps[i].Set("shared", []byte(fmt.Sprintf("r%d", i)))); err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- reps[i].Publish(maps[i].Delete(fmt.Sprintf("r%d-0", (i+1)%len(maps))))
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// The replicas converge to the same state
+	require.Eventually(t, func() bool {
+		for _, m := range maps[1:] {
+			if !sameState(maps[0], m) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	keys := maps[0].Keys()
+	require.Contains(t, keys, "shared")
+	require.Contains(t, keys, "r0-4")
+	require.Contains(t, keys, "r1-4")
+	require.Contains(t, keys, "r2-4")
+	shared, _ := maps[0].Get("shared")
+	require.Contains(t, []string{"r0", "r1", "r2"}, string(shared))
+}
+
+func TestCrdtLateJoiner(t *testing.T) {
+	tu.SetT(t)
+
+	network := emu.NewNetwork(nil)
+	t.Cleanup(network.Stop)
+	a := network.AddNode("a")
+	group := tu.NoErr(enc.NameFromStr("/emu/crdt/set"))
+
+	// With a threshold of 3, the first publications are pruned by the time
+	// the replicas publish 12 times, so they can only be learned from a snapshot
+	sets := []*crdt.ORSet{crdt.NewORSet(), crdt.NewORSet()}
+	reps := []*crdt.Replicator{
+		startReplica(t, a, group, "/emu/r0", sets[0], 3),
+		startReplica(t, a, group, "/emu/r1", sets[1], 3),
+	}
+	for i := range 12 {
+		for j, rep := range reps {
+			require.NoError(t, rep.Publish(sets[j].Add([]byte(fmt.Sprintf("r%d-%02d", j, i)))))
+		}
+	}
+	require.Eventually(t, func() bool {
+		return len(sets[0].Values()) == 24 && len(sets[1].Values()) == 24
+	}, 10*time.Second, 50*time.Millisecond)
+
+	// The late joiner bootstraps from the snapshots of the merged state
+	late := crdt.NewORSet()
+	startReplica(t, a, group, "/emu/r2", late, 3)
+	require.Eventually(t, func() bool {
+		return len(late.Values()) == 24
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Equal(t, sets[0].Values(), late.Values())
+	require.True(t, late.Contains([]byte("r0-00")))
+	require.True(t, late.Contains([]byte("r1-00")))
+}
+
+// Checks if two replicas have the same state.
+func sameState(a crdt.Crdt, b crdt.Crdt) bool {
+	return string(a.State().Encode().Join()) == string(b.State().Encode().Join())
+}
@@ -0,0 +1,141 @@
+package emu_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/e2e/emu"
+	"github.com/named-data/ndnd/fw/face"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Returns the IDs of the next hop faces of a node for a name.
+func nextHopFaces(node *emu.Node, name enc.Name) []uint64 {
+	faces := make([]uint64, 0)
+	for _, hop := range node.NextHops(name) {
+		faces = append(faces, hop.Nexthop)
+	}
+	return faces
+}
+
+// Waits until the routers of a network know all other routers. The prefix table of each
+// router is then reachable, so that prefixes announced later are synchronized at once.
+func waitRouters(t *testing.T, network *emu.Network) {
+	for _, node := range network.Nodes() {
+		for _, other := range network.Nodes() {
+			if node == other {
+				continue
+			}
+			pfs := tu.NoErr(enc.NameFromStr("/emu/32=DV/32=PFS/emu/" + other.Name()))
+			require.Eventually(t, func() bool {
+				return len(node.NextHops(pfs)) > 0
+			}, 20*time.Second, 50*time.Millisecond, "%s does not know %s", node.Name(), other.Name())
+		}
+	}
+}
+
+// Starts ndn-dv on all nodes of a network.
+func startDv(t *testing.T, network *emu.Network) {
+	for _, node := range network.Nodes() {
+		tu.NoErr(node.StartDv(node.DvConfig("/emu")))
+	}
+}
+
+func TestDvConvergence(t *testing.T) {
+	tu.SetT(t)
+
+	network := emu.NewNetwork(nil)
+	t.Cleanup(network.Stop)
+
+	// a -- b -- c -- d
+	nodes := []*emu.Node{
+		network.AddNode("a"),
+		network.AddNode("b"),
+		network.AddNode("c"),
+		network.AddNode("d"),
+	}
+	for i := 1; i < len(nodes); i++ {
+		network.Connect(nodes[i-1], nodes[i], face.VirtualLinkConfig{Delay: 5 * time.Millisecond})
+	}
+	startDv(t, network)
+	waitRouters(t, network)
+
+	// Producers announce their prefixes to the routers
+	names := make([]enc.Name, len(nodes))
+	for i, node := range nodes {
+		prefix := tu.NoErr(enc.NameFromStr("/emu/app/" + node.Name()))
+		names[i] = startProducer(t, node, prefix, "hello "+node.Name(), true)
+	}
+
+	// Every node learns a route to every other producer
+	for i, node := range nodes {
+		for j := range nodes {
+			if i == j {
+				continue
+			}
+			require.Eventually(t, func() bool {
+				return len(node.NextHops(names[j])) > 0
+			}, 20*time.Second, 100*time.Millisecond, "%s has no route to %s", node.Name(), names[j])
+		}
+	}
+
+	// Data can be fetched across the network
+	consumer := newClient(t, nodes[0])
+	require.Equal(t, "hello d", tu.NoErr(fetch(consumer, names[3])))
+	consumer = newClient(t, nodes[3])
+	require.Equal(t, "hello a", tu.NoErr(fetch(consumer, names[0])))
+}
+
+func TestDvLinkFailure(t *testing.T) {
+	tu.SetT(t)
+
+	network := emu.NewNetwork(nil)
+	t.Cleanup(network.Stop)
+
+	//  a ---- c
+	//   \    /
+	//     b
+	a := network.AddNode("a")
+	b := network.AddNode("b")
+	c := network.AddNode("c")
+	config := face.VirtualLinkConfig{Delay: 5 * time.Millisecond}
+	direct := network.Connect(a, c, config)
+	network.Connect(a, b, config)
+	network.Connect(b, c, config)
+
+	// Use only the best path
+	for _, node := range network.Nodes() {
+		cfg := node.DvConfig("/emu")
+		cfg.MaxPaths = 1
+		tu.NoErr(node.StartDv(cfg))
+	}
+	waitRouters(t, network)
+
+	prefix := tu.NoErr(enc.NameFromStr("/emu/app/c"))
+	name := startProducer(t, c, prefix, "hello c", true)
+	viaDirect := []uint64{direct.FaceID(a)}
+	viaB := []uint64{network.Link(a, b).FaceID(a)}
+
+	// The direct link is used first
+	require.Eventually(t, func() bool {
+		return slices.Equal(viaDirect, nextHopFaces(a, name))
+	}, 20*time.Second, 100*time.Millisecond)
+	consumer := newClient(t, a)
+	require.Equal(t, "hello c", tu.NoErr(fetch(consumer, name)))
+
+	// Traffic is rerouted through b when the direct link fails
+	direct.SetUp(false)
+	require.Eventually(t, func() bool {
+		return slices.Equal(viaB, nextHopFaces(a, name))
+	}, 20*time.Second, 100*time.Millisecond)
+	require.Equal(t, "hello c", tu.NoErr(fetch(consumer, name)))
+
+	// The direct link is used again once it recovers
+	direct.SetUp(true)
+	require.Eventually(t, func() bool {
+		return slices.Equal(viaDirect, nextHopFaces(a, name))
+	}, 20*time.Second, 100*time.Millisecond)
+}
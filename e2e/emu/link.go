@@ -0,0 +1,101 @@
+package emu
+
+import (
+	"fmt"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+)
+
+// linkPort is the port of the unicast UDP URIs assigned to link faces.
+const linkPort = 6363
+
+// Link is a virtual point-to-point link between two nodes.
+//
+// Each end is a face in the forwarder of its node. The faces have unicast
+// UDP URIs with addresses unique to the link, so that routers configured
+// with these URIs as neighbors use the existing faces instead of creating new ones.
+type Link struct {
+	a *Node
+	b *Node
+
+	// transport of the face in a, sending towards b
+	ta *face.VirtualTransport
+	// transport of the face in b, sending towards a
+	tb *face.VirtualTransport
+}
+
+// Creates the faces of a link in both forwarders.
+// The link index is used to assign the addresses 10.X.Y.1 and 10.X.Y.2.
+func newLink(a *Node, b *Node, index int, config face.VirtualLinkConfig) *Link {
+	addr := func(host int) *defn.URI {
+		ip := fmt.Sprintf("10.%d.%d.%d", (index>>8)&0xff, index&0xff, host)
+		return defn.MakeUDPFaceURI(4, ip, linkPort)
+	}
+
+	link := &Link{a: a, b: b}
+	link.ta, link.tb = face.MakeVirtualLink(addr(1), addr(2), config)
+
+	options := face.MakeNDNLPLinkServiceOptions()
+	face.MakeNDNLPLinkService(a.fw.Faces(), link.ta, options).Run(nil)
+	face.MakeNDNLPLinkService(b.fw.Faces(), link.tb, options).Run(nil)
+
+	return link
+}
+
+// Returns a string representation of the link with the names of its nodes.
+func (l *Link) String() string {
+	return fmt.Sprintf("link (%s <-> %s)", l.a.name, l.b.name)
+}
+
+// Nodes returns the two nodes connected by the link.
+func (l *Link) Nodes() (*Node, *Node) {
+	return l.a, l.b
+}
+
+// Peer returns the node at the other end of the link from node.
+func (l *Link) Peer(node *Node) *Node {
+	if node == l.a {
+		return l.b
+	}
+	return l.a
+}
+
+// Transport returns the transport of the face of node on this link.
+func (l *Link) Transport(node *Node) *face.VirtualTransport {
+	if node == l.a {
+		return l.ta
+	}
+	return l.tb
+}
+
+// FaceID returns the ID of the face of node on this link.
+func (l *Link) FaceID(node *Node) uint64 {
+	return l.Transport(node).FaceID()
+}
+
+// RemoteURI returns the remote URI of the face of node on this link,
+// which is the neighbor URI to use in the configuration of a router on node.
+func (l *Link) RemoteURI(node *Node) string {
+	return l.Transport(node).RemoteURI().String()
+}
+
+// SetConfig changes the configuration of both directions of the link.
+func (l *Link) SetConfig(config face.VirtualLinkConfig) {
+	l.ta.SetConfig(config)
+	l.tb.SetConfig(config)
+}
+
+// SetUp brings both directions of the link up or down.
+// The faces stay up while the link is down, like a cut cable.
+func (l *Link) SetUp(up bool) {
+	l.ta.SetUp(up)
+	l.tb.SetUp(up)
+}
+
+// Remove closes the faces of the link and removes it from the network.
+func (l *Link) Remove() {
+	l.ta.Close()
+	l.tb.Close()
+	l.a.network.removeLink(l)
+}
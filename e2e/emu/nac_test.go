@@ -0,0 +1,104 @@
+package emu_test
+
+import (
+	"crypto/elliptic"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/e2e/emu"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/nac"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates a self-signed certificate for a key.
+func selfSigned(t *testing.T, key ndn.Signer) ndn.Data {
+	wire := tu.NoErr(sec.SelfSign(sec.SignCertArgs{
+		Signer:    key,
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}))
+	cert, _, err := spec.Spec{}.ReadData(enc.NewWireView(wire))
+	require.NoError(t, err)
+	return cert
+}
+
+// Fetches an object with a decrypter and returns its content.
+func fetchDecrypt(client ndn.Client, name enc.Name, decrypter ndn.ContentDecrypter) (string, error) {
+	done := make(chan ndn.ConsumeState, 1)
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:      name,
+		Decrypter: decrypter,
+		Callback: func(status ndn.ConsumeState) {
+			if status.IsComplete() || status.Error() != nil {
+				done <- status
+			}
+		},
+	})
+	status := <-done
+	if status.Error() != nil {
+		return "", status.Error()
+	}
+	return string(status.Content().Join()), nil
+}
+
+func TestNacProduceConsume(t *testing.T) {
+	tu.SetT(t)
+
+	network := emu.NewNetwork(nil)
+	t.Cleanup(network.Stop)
+	a := network.AddNode("a")
+
+	accessPrefix := tu.NoErr(enc.NameFromStr("/emu/access"))
+	producerPrefix := tu.NoErr(enc.NameFromStr("/emu/producer"))
+
+	// The access manager and the producer run on separate clients
+	managerClient := newClient(t, a)
+	managerClient.AnnouncePrefix(ndn.Announcement{Name: accessPrefix})
+	producerClient := newClient(t, a)
+	producerClient.AnnouncePrefix(ndn.Announcement{Name: producerPrefix})
+
+	aliceName := tu.NoErr(enc.NameFromStr("/emu/alice/KEY/1"))
+	alice := tu.NoErr(sig.KeygenEcc(aliceName, elliptic.P256()))
+	bobName := tu.NoErr(enc.NameFromStr("/emu/bob/KEY/1"))
+	bob := tu.NoErr(sig.KeygenEcc(bobName, elliptic.P256()))
+
+	manager := tu.NoErr(nac.NewAccessManager(managerClient, accessPrefix))
+	require.NoError(t, manager.Grant(selfSigned(t, alice)))
+
+	producer := nac.NewProducer(producerClient, producerPrefix)
+	rekeyed := make(chan error, 1)
+	producer.Rekey(accessPrefix, func(err error) { rekeyed <- err })
+	require.NoError(t, <-rekeyed)
+
+	content := tu.NoErr(producer.Encrypt(enc.Wire{[]byte("secret message")}))
+	name := tu.NoErr(producerClient.Produce(ndn.ProduceArgs{
+		Name:    producerPrefix.Append(enc.NewGenericComponent("obj")).WithVersion(1),
+		Content: content,
+	}))
+
+	consumer := newClient(t, a)
+
+	// Without a decrypter the content stays encrypted
+	raw := tu.NoErr(fetch(consumer, name))
+	require.NotContains(t, raw, "secret message")
+
+	// Granted key decrypts the content
+	require.Equal(t, "secret message", tu.NoErr(fetchDecrypt(consumer, name, nac.NewConsumer(alice))))
+
+	// A different private key with the granted key name cannot open the KDK
+	mallory := tu.NoErr(sig.KeygenEcc(aliceName, elliptic.P256()))
+	_, err := fetchDecrypt(consumer, name, nac.NewConsumer(mallory))
+	require.ErrorIs(t, err, ndn.ErrSecurity)
+	require.ErrorContains(t, err, "failed to decrypt KDK")
+
+	// No KDK is published for a key that was never granted
+	_, err = fetchDecrypt(consumer, name, nac.NewConsumer(bob))
+	require.ErrorIs(t, err, ndn.ErrSecurity)
+	require.ErrorContains(t, err, "failed to fetch key")
+}
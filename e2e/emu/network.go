@@ -0,0 +1,165 @@
+// Package emu emulates a network of forwarders in a single process.
+//
+// Each node runs its own YaNFD instance without any listeners. Nodes are
+// connected by virtual links with configurable delay, loss and bandwidth,
+// and applications and ndn-dv routers attach to a node through an internal
+// face. Links can be reconfigured, brought down or removed at runtime to
+// script topology changes, so routing, sync and fetch tests run under go test.
+//
+// The forwarder configuration and logger are process-wide, so all nodes
+// share the configuration given to NewNetwork. Only one network should
+// exist in a process at a time.
+package emu
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/named-data/ndnd/fw/cmd"
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/face"
+	"github.com/named-data/ndnd/fw/table"
+	"github.com/named-data/ndnd/std/log"
+)
+
+// configured is set once the global forwarder configuration is set up.
+var configured atomic.Bool
+
+// Network is a set of emulated nodes connected by virtual links.
+type Network struct {
+	mutex sync.Mutex
+	nodes map[string]*Node
+	order []*Node
+	links []*Link
+	// number of links created, used to assign addresses
+	nLinks int
+}
+
+// DefaultConfig returns the forwarder configuration used for emulated nodes.
+// Listeners are never started, so only the forwarding options matter.
+func DefaultConfig() *core.Config {
+	config := core.DefaultConfig()
+	config.Core.LogLevel = "WARN"
+	config.Faces.Udp.EnabledUnicast = false
+	config.Faces.Udp.EnabledMulticast = false
+	config.Faces.Tcp.Enabled = false
+	config.Faces.Unix.Enabled = false
+	config.Faces.WebSocket.Enabled = false
+	config.Fw.Threads = 2
+	return config
+}
+
+// NewNetwork creates an empty network. The forwarder configuration of the
+// process is set to config, or to DefaultConfig if config is nil.
+// The log settings of the first network are kept for the whole process.
+func NewNetwork(config *core.Config) *Network {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	// The logger is only opened by the first network, since the
+	// forwarders of a stopped network may still log while quitting.
+	if !configured.Swap(true) {
+		cmd.Configure(config)
+
+		// Use the same log level for routers and applications
+		if level, err := log.ParseLevel(config.Core.LogLevel); err == nil {
+			log.Default().SetLevel(level)
+		}
+	} else {
+		core.C = config
+		table.Initialize()
+	}
+
+	return &Network{
+		nodes: make(map[string]*Node),
+	}
+}
+
+// AddNode creates and starts a node with a new forwarder.
+func (n *Network) AddNode(name string) *Node {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if _, ok := n.nodes[name]; ok {
+		panic(fmt.Sprintf("emu: duplicate node %s", name))
+	}
+
+	node := &Node{
+		name:    name,
+		network: n,
+		fw:      cmd.NewForwarder(),
+	}
+	node.fw.StartForwarder()
+
+	n.nodes[name] = node
+	n.order = append(n.order, node)
+	return node
+}
+
+// Node returns the node with the given name, or nil if it does not exist.
+func (n *Network) Node(name string) *Node {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.nodes[name]
+}
+
+// Nodes returns all nodes in the order they were added.
+func (n *Network) Nodes() []*Node {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([]*Node(nil), n.order...)
+}
+
+// Connect creates a virtual link between two nodes, with the same
+// configuration in both directions.
+func (n *Network) Connect(a *Node, b *Node, config face.VirtualLinkConfig) *Link {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.nLinks++
+	link := newLink(a, b, n.nLinks, config)
+	n.links = append(n.links, link)
+	return link
+}
+
+// Links returns all links that have not been removed.
+func (n *Network) Links() []*Link {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([]*Link(nil), n.links...)
+}
+
+// Link returns a link between the two nodes, or nil if they are not connected.
+func (n *Network) Link(a *Node, b *Node) *Link {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for _, link := range n.links {
+		if (link.a == a && link.b == b) || (link.a == b && link.b == a) {
+			return link
+		}
+	}
+	return nil
+}
+
+// Removes a link from the network.
+func (n *Network) removeLink(link *Link) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for i, l := range n.links {
+		if l == link {
+			n.links = append(n.links[:i], n.links[i+1:]...)
+			return
+		}
+	}
+}
+
+// Stop stops all routers, applications and forwarders in the network.
+func (n *Network) Stop() {
+	for _, node := range n.Nodes() {
+		node.stop()
+	}
+}
@@ -0,0 +1,119 @@
+package emu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/e2e/emu"
+	"github.com/named-data/ndnd/fw/face"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/named-data/ndnd/std/types/optional"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates and starts an object client on a node.
+func newClient(t *testing.T, node *emu.Node) ndn.Client {
+	client := object.NewClient(tu.NoErr(node.NewEngine()), storage.NewMemoryStore(), nil)
+	require.NoError(t, client.Start())
+	t.Cleanup(func() { client.Stop() })
+	return client
+}
+
+// Produces an object with version 1 under prefix on a node and registers the prefix.
+// If expose is set, the prefix is announced to the routing daemon.
+func startProducer(t *testing.T, node *emu.Node, prefix enc.Name, content string, expose bool) enc.Name {
+	client := newClient(t, node)
+	name := tu.NoErr(client.Produce(ndn.ProduceArgs{
+		Name:    prefix.WithVersion(1),
+		Content: enc.Wire{[]byte(content)},
+	}))
+	client.AnnouncePrefix(ndn.Announcement{Name: prefix, Expose: expose})
+	return name
+}
+
+// Fetches an object and returns its content.
+func fetch(client ndn.Client, name enc.Name) (string, error) {
+	done := make(chan ndn.ConsumeState, 1)
+	client.Consume(name, func(status ndn.ConsumeState) {
+		if status.IsComplete() || status.Error() != nil {
+			done <- status
+		}
+	})
+	status := <-done
+	if status.Error() != nil {
+		return "", status.Error()
+	}
+	return string(status.Content().Join()), nil
+}
+
+// Expresses an Interest for the first segment of an object and returns the result.
+func express(client ndn.Client, name enc.Name, lifetime time.Duration) ndn.InterestResult {
+	done := make(chan ndn.InterestResult, 1)
+	client.ExpressR(ndn.ExpressRArgs{
+		Name: name.Append(enc.NewSegmentComponent(0)),
+		Config: &ndn.InterestConfig{
+			Lifetime: optional.Some(lifetime),
+		},
+		Callback: func(args ndn.ExpressCallbackArgs) {
+			done <- args.Result
+		},
+	})
+	return <-done
+}
+
+func TestVirtualLink(t *testing.T) {
+	tu.SetT(t)
+
+	// Do not serve repeated Interests from the content store
+	config := emu.DefaultConfig()
+	config.Tables.ContentStore.Serve = false
+	network := emu.NewNetwork(config)
+	t.Cleanup(network.Stop)
+
+	a := network.AddNode("a")
+	b := network.AddNode("b")
+	delay := 20 * time.Millisecond
+	link := network.Connect(a, b, face.VirtualLinkConfig{Delay: delay})
+	require.Equal(t, link, network.Link(b, a))
+	require.Equal(t, []*emu.Link{link}, a.Links())
+	require.Equal(t, "udp4://10.0.1.2:6363", link.RemoteURI(a))
+
+	// Static route from a to the producer on b
+	prefix := tu.NoErr(enc.NameFromStr("/emu/b"))
+	name := startProducer(t, b, prefix, "hello", false)
+	a.Forwarder().Fib().InsertNextHopEnc(prefix, link.FaceID(a), 0)
+	consumer := newClient(t, a)
+
+	start := time.Now()
+	require.Equal(t, "hello", tu.NoErr(fetch(consumer, name)))
+	require.GreaterOrEqual(t, time.Since(start), 2*delay)
+
+	// Nothing arrives while the link is down
+	link.SetUp(false)
+	require.Equal(t, ndn.InterestResultTimeout, express(consumer, name, 200*time.Millisecond))
+
+	// Lossy link
+	link.SetUp(true)
+	link.SetConfig(face.VirtualLinkConfig{Loss: 1})
+	require.Equal(t, ndn.InterestResultTimeout, express(consumer, name, 200*time.Millisecond))
+	link.SetConfig(face.VirtualLinkConfig{})
+	require.Equal(t, ndn.InterestResultData, express(consumer, name, time.Second))
+
+	// Limited bandwidth delays a large object
+	link.SetConfig(face.VirtualLinkConfig{Bandwidth: 1_000_000})
+	large := startProducer(t, b, prefix.Append(enc.NewGenericComponent("large")), string(make([]byte, 64*1024)), false)
+	start = time.Now()
+	require.Len(t, tu.NoErr(fetch(consumer, large)), 64*1024)
+	require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond) // 512 kbit at 1 Mbps
+
+	// Removed links close their faces
+	link.Remove()
+	require.Nil(t, network.Link(a, b))
+	require.Eventually(t, func() bool {
+		return a.Forwarder().Faces().Get(link.FaceID(a)) == nil
+	}, time.Second, 10*time.Millisecond)
+}
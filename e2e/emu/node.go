@@ -0,0 +1,167 @@
+package emu
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/dv"
+	"github.com/named-data/ndnd/fw/cmd"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+)
+
+// routerStartTimeout is the time to wait for a router to register with the forwarder.
+const routerStartTimeout = 5 * time.Second
+
+// Node is an emulated host running a forwarder.
+type Node struct {
+	name    string
+	network *Network
+	fw      *cmd.YaNFD
+
+	mutex   sync.Mutex
+	nApps   atomic.Int32
+	engines []ndn.Engine
+	routers []*runningRouter
+}
+
+// runningRouter is an ndn-dv router started on a node.
+type runningRouter struct {
+	router *dv.Router
+	done   chan struct{}
+}
+
+// Returns a string representation of the node with its name.
+func (n *Node) String() string {
+	return "emu-node (" + n.name + ")"
+}
+
+// Name returns the name of the node.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// Forwarder returns the forwarder of the node.
+func (n *Node) Forwarder() *cmd.YaNFD {
+	return n.fw
+}
+
+// Links returns the links of the node that have not been removed.
+func (n *Node) Links() []*Link {
+	links := make([]*Link, 0)
+	for _, link := range n.network.Links() {
+		if link.a == n || link.b == n {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// NextHops returns the next hops of the forwarder for a name.
+func (n *Node) NextHops(name enc.Name) []*table.FibNextHopEntry {
+	return n.fw.Fib().FindNextHopsEnc(name)
+}
+
+// NewEngine creates and starts an engine connected to the forwarder of the
+// node by a local face. The engine is stopped when the network is stopped.
+func (n *Node) NewEngine() (ndn.Engine, error) {
+	app := engine.NewBasicEngine(newAppFace(n))
+	if err := app.Start(); err != nil {
+		return nil, err
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.engines = append(n.engines, app)
+	return app, nil
+}
+
+// DvConfig returns an ndn-dv configuration for the node with short timers
+// and security disabled. The router name is the network name followed by
+// the node name, and the neighbors are the peers on the current links.
+func (n *Node) DvConfig(network string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Network = network
+	cfg.Router = network + "/" + n.name
+	cfg.KeyChainUri = "insecure"
+	cfg.AdvertisementSyncInterval_ms = 1000
+	cfg.RouterDeadInterval_ms = 3000
+
+	for _, link := range n.Links() {
+		cfg.Neighbors = append(cfg.Neighbors, config.Neighbor{
+			Uri: link.RemoteURI(n),
+		})
+	}
+
+	return cfg
+}
+
+// StartDv starts an ndn-dv router on the node with its own engine, and returns
+// once the router is ready for prefix announcements from the forwarder.
+// The router is stopped when the network is stopped.
+func (n *Node) StartDv(cfg *config.Config) (*dv.Router, error) {
+	app, err := n.NewEngine()
+	if err != nil {
+		return nil, err
+	}
+
+	router, err := dv.NewRouter(cfg, app)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := &runningRouter{router: router, done: make(chan struct{})}
+	go func() {
+		defer close(rr.done)
+		if err := router.Start(); err != nil {
+			log.Error(n, "DV router failed", "err", err)
+		}
+	}()
+
+	n.mutex.Lock()
+	n.routers = append(n.routers, rr)
+	n.mutex.Unlock()
+
+	// Prefixes registered before the router are not readvertised to it
+	deadline := time.After(routerStartTimeout)
+	for len(n.NextHops(cfg.MgmtPrefix())) == 0 {
+		select {
+		case <-rr.done:
+			return nil, fmt.Errorf("dv router on %s stopped", n.name)
+		case <-deadline:
+			return nil, fmt.Errorf("dv router on %s did not register its prefix", n.name)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	return router, nil
+}
+
+// Stops the routers, engines and forwarder of the node.
+func (n *Node) stop() {
+	n.mutex.Lock()
+	routers, engines := n.routers, n.engines
+	n.routers, n.engines = nil, nil
+	n.mutex.Unlock()
+
+	for _, rr := range routers {
+		rr.router.Stop()
+		<-rr.done
+	}
+	for _, app := range engines {
+		app.Stop()
+	}
+	n.fw.StopForwarder()
+
+	// Wait for the faces to quit, so that they do not outlive the network
+	deadline := time.Now().Add(time.Second)
+	for len(n.fw.Faces().GetAll()) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
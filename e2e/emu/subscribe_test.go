@@ -0,0 +1,268 @@
+package emu_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/e2e/emu"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	rdr "github.com/named-data/ndnd/std/ndn/rdr_2024"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// subProducer produces versions of an object and counts the
+// metadata Interests used for version discovery.
+type subProducer struct {
+	client    ndn.Client
+	prefix    enc.Name
+	discovery atomic.Int32
+	times     []time.Time
+	mutex     sync.Mutex
+}
+
+// Starts a producer for prefix on a node. Metadata Interests are answered by a
+// more specific handler on the same engine, so that discovery can be observed.
+func startSubProducer(t *testing.T, node *emu.Node, prefix enc.Name) *subProducer {
+	p := &subProducer{client: newClient(t, node), prefix: prefix}
+
+	metaPrefix := prefix.Append(enc.NewKeywordComponent(rdr.MetadataKeyword))
+	require.NoError(t, p.client.Engine().AttachHandler(metaPrefix, func(args ndn.InterestHandlerArgs) {
+		p.discovery.Add(1)
+		p.mutex.Lock()
+		p.times = append(p.times, time.Now())
+		p.mutex.Unlock()
+
+		wire, err := p.client.Store().Get(args.Interest.Name(), args.Interest.CanBePrefix())
+		if err == nil && wire != nil {
+			args.Reply(enc.Wire{wire})
+		}
+	}))
+
+	p.client.AnnouncePrefix(ndn.Announcement{Name: prefix})
+	require.Eventually(t, func() bool {
+		return len(node.NextHops(prefix)) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return p
+}
+
+// Produces a version of the object.
+func (p *subProducer) produce(t *testing.T, version uint64, content string) enc.Name {
+	return tu.NoErr(p.client.Produce(ndn.ProduceArgs{
+		Name:    p.prefix.WithVersion(version),
+		Content: enc.Wire{[]byte(content)},
+	}))
+}
+
+// Returns the gaps between the last n discovery Interests.
+func (p *subProducer) gaps(n int) []time.Duration {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	gaps := make([]time.Duration, 0, n)
+	for i := max(1, len(p.times)-n); i < len(p.times); i++ {
+		gaps = append(gaps, p.times[i].Sub(p.times[i-1]))
+	}
+	return gaps
+}
+
+// delivery is a version delivered to a subscription callback.
+type delivery struct {
+	version uint64
+	content string
+}
+
+// Subscribes to an object and sends every delivered version to a channel.
+func subscribe(client ndn.Client, args ndn.SubscribeArgs) (ndn.Subscription, chan delivery) {
+	ch := make(chan delivery, 16)
+	args.Callback = func(version enc.Name, state ndn.ConsumeState) {
+		d := delivery{version: version.At(-1).NumberVal()}
+		if state != nil {
+			d.content = string(state.Content().Join())
+		}
+		ch <- d
+	}
+	return client.Subscribe(args), ch
+}
+
+// Waits for the next delivery on a subscription.
+func nextDelivery(t *testing.T, ch chan delivery) delivery {
+	select {
+	case d := <-ch:
+		return d
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no version delivered")
+		return delivery{}
+	}
+}
+
+func newSubNetwork(t *testing.T) *emu.Node {
+	// Discovery must reach the producer every time
+	config := emu.DefaultConfig()
+	config.Tables.ContentStore.Serve = false
+	network := emu.NewNetwork(config)
+	t.Cleanup(network.Stop)
+	return network.AddNode("a")
+}
+
+func TestSubscribeDiscovery(t *testing.T) {
+	tu.SetT(t)
+
+	a := newSubNetwork(t)
+	prefix := tu.NoErr(enc.NameFromStr("/emu/sub/disc"))
+	producer := startSubProducer(t, a, prefix)
+	producer.produce(t, 1, "one")
+
+	consumer := newClient(t, a)
+	sub, ch := subscribe(consumer, ndn.SubscribeArgs{
+		Name:         prefix,
+		FetchContent: true,
+		MinInterval:  50 * time.Millisecond,
+		MaxInterval:  400 * time.Millisecond,
+	})
+	t.Cleanup(sub.Cancel)
+
+	require.Equal(t, delivery{1, "one"}, nextDelivery(t, ch))
+	require.Equal(t, prefix.WithVersion(1), sub.Latest())
+
+	// Without new versions the interval doubles up to the maximum
+	time.Sleep(2 * time.Second)
+	gaps := producer.gaps(4)
+	require.Len(t, gaps, 4)
+	for i, gap := range gaps {
+		require.Less(t, gap, 700*time.Millisecond)
+		if i > 0 {
+			require.GreaterOrEqual(t, gap, gaps[i-1]-50*time.Millisecond)
+		}
+	}
+	require.GreaterOrEqual(t, gaps[3], 350*time.Millisecond)
+
+	// A new version is found and resets the interval
+	producer.produce(t, 2, "two")
+	require.Equal(t, delivery{2, "two"}, nextDelivery(t, ch))
+	count := producer.discovery.Load()
+	time.Sleep(300 * time.Millisecond)
+	require.GreaterOrEqual(t, producer.discovery.Load()-count, int32(2))
+
+	// Intermediate versions may be skipped, but never delivered out of order
+	producer.produce(t, 3, "three")
+	producer.produce(t, 4, "four")
+	last := nextDelivery(t, ch)
+	if last.version == 3 {
+		last = nextDelivery(t, ch)
+	}
+	require.Equal(t, delivery{4, "four"}, last)
+}
+
+func TestSubscribeNotify(t *testing.T) {
+	tu.SetT(t)
+
+	a := newSubNetwork(t)
+	prefix := tu.NoErr(enc.NameFromStr("/emu/sub/notify"))
+	producer := startSubProducer(t, a, prefix)
+	for v := uint64(1); v <= 5; v++ {
+		producer.produce(t, v, string(rune('0'+v)))
+	}
+
+	// Passive subscriptions only discover on notifications
+	consumer := newClient(t, a)
+	sub, ch := subscribe(consumer, ndn.SubscribeArgs{
+		Name:         prefix,
+		FetchContent: true,
+		Passive:      true,
+	})
+	t.Cleanup(sub.Cancel)
+
+	// Versioned notifications (e.g. from SVS) are fetched directly, in order
+	sub.Notify(prefix.WithVersion(2))
+	sub.Notify(prefix.WithVersion(3))
+	sub.Notify(prefix.WithVersion(1))
+	delivered := []delivery{nextDelivery(t, ch)}
+	if delivered[0].version == 2 {
+		delivered = append(delivered, nextDelivery(t, ch))
+	}
+	require.Equal(t, delivery{3, "3"}, delivered[len(delivered)-1])
+	require.Equal(t, int32(0), producer.discovery.Load())
+
+	// Notifications for other objects are ignored
+	sub.Notify(prefix.Append(enc.NewGenericComponent("other")).WithVersion(9))
+	require.Equal(t, prefix.WithVersion(3), sub.Latest())
+
+	// An unversioned notification triggers discovery
+	sub.Notify(prefix)
+	require.Equal(t, delivery{5, "5"}, nextDelivery(t, ch))
+	require.Equal(t, int32(1), producer.discovery.Load())
+
+	// Cancelled subscriptions deliver nothing
+	sub.Cancel()
+	sub.Notify(prefix.WithVersion(6))
+	sub.Notify(prefix)
+	producer.produce(t, 6, "6")
+	time.Sleep(200 * time.Millisecond)
+	require.Empty(t, ch)
+	require.Equal(t, int32(1), producer.discovery.Load())
+	require.Equal(t, prefix.WithVersion(5), sub.Latest())
+}
+
+func TestSubscribeCancel(t *testing.T) {
+	tu.SetT(t)
+
+	a := newSubNetwork(t)
+	prefix := tu.NoErr(enc.NameFromStr("/emu/sub/cancel"))
+	producer := startSubProducer(t, a, prefix)
+	producer.produce(t, 1, "one")
+
+	// Only version names are delivered without FetchContent
+	consumer := newClient(t, a)
+	sub, ch := subscribe(consumer, ndn.SubscribeArgs{
+		Name:        prefix,
+		MinInterval: 50 * time.Millisecond,
+		MaxInterval: 50 * time.Millisecond,
+	})
+	require.Equal(t, delivery{1, ""}, nextDelivery(t, ch))
+
+	// Discovery stops after the subscription is cancelled
+	sub.Cancel()
+	time.Sleep(100 * time.Millisecond)
+	count := producer.discovery.Load()
+	producer.produce(t, 2, "two")
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, count, producer.discovery.Load())
+	require.Empty(t, ch)
+}
+
+func TestSubscribeRetry(t *testing.T) {
+	tu.SetT(t)
+
+	a := newSubNetwork(t)
+	prefix := tu.NoErr(enc.NameFromStr("/emu/sub/retry"))
+
+	consumer := newClient(t, a)
+	errs := make(chan error, 16)
+	sub, ch := subscribe(consumer, ndn.SubscribeArgs{
+		Name:         prefix,
+		FetchContent: true,
+		Passive:      true,
+		MinInterval:  50 * time.Millisecond,
+		MaxInterval:  200 * time.Millisecond,
+		OnError:      func(err error) { errs <- err },
+	})
+	t.Cleanup(sub.Cancel)
+
+	// The version cannot be fetched before it is produced
+	sub.Notify(prefix.WithVersion(1))
+	select {
+	case <-errs:
+	case <-time.After(20 * time.Second):
+		require.FailNow(t, "fetch did not fail")
+	}
+	require.Empty(t, ch)
+
+	// The failed version is fetched again once available
+	producer := startSubProducer(t, a, prefix)
+	producer.produce(t, 1, "one")
+	require.Equal(t, delivery{1, "one"}, nextDelivery(t, ch))
+}
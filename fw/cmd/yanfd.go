@@ -18,11 +18,18 @@ import (
 )
 
 // YaNFD is the wrapper class for the NDN Forwarding Daemon.
-// Note: only one instance of this class should be created.
+// The configuration and logger are global, so all instances
+// in a process must share the same configuration.
 type YaNFD struct {
 	config   *core.Config
 	profiler *Profiler
 
+	faces   *face.Table
+	fib     table.FibStrategy
+	rib     *table.RibTable
+	threads []*fw.Thread
+	fwDisp  dispatch.FWDispatch
+
 	unixListener *face.UnixStreamListener
 	wsListener   *face.WebSocketListener
 	h3Listener   *face.HTTP3Listener
@@ -30,21 +37,53 @@ type YaNFD struct {
 	udpListeners []*face.UDPListener
 }
 
-// NewYaNFD creates a YaNFD. Don't call this function twice.
+// NewYaNFD creates a YaNFD and sets up the global configuration and logger.
 func NewYaNFD(config *core.Config) *YaNFD {
+	Configure(config)
+	return NewForwarder()
+}
+
+// Configure sets up the global configuration and logger of the process.
+func Configure(config *core.Config) {
 	// Provide global configuration.
 	core.C = config
 	core.StartTimestamp = time.Now()
 
 	// Initialize all modules here
 	core.OpenLogger()
-	face.Initialize()
 	table.Initialize()
+}
 
-	return &YaNFD{
-		config:   config,
-		profiler: NewProfiler(config),
+// NewForwarder creates a YaNFD that uses the global configuration and logger.
+// Configure must have been called before. All forwarders in the process share
+// the configuration, but have their own faces, tables and forwarding threads.
+func NewForwarder() *YaNFD {
+	y := &YaNFD{
+		config:   core.C,
+		profiler: NewProfiler(core.C),
+	}
+
+	// Create forwarding tables
+	y.fib = table.NewFibStrategyTable()
+	y.rib = table.NewRib(y.fib)
+
+	// Create forwarding threads
+	if fw.CfgNumThreads() < 1 || fw.CfgNumThreads() > fw.MaxFwThreads {
+		core.Log.Fatal(y, "Number of forwarding threads out of range", "range", fmt.Sprintf("[1, %d]", fw.MaxFwThreads))
+		os.Exit(2)
+	}
+	faceDispatch := &dispatch.FaceDispatch{}
+	y.fwDisp = make(dispatch.FWDispatch, fw.CfgNumThreads())
+	y.threads = make([]*fw.Thread, fw.CfgNumThreads())
+	for i := range y.threads {
+		y.threads[i] = fw.NewThread(i, y.fib, faceDispatch)
+		y.fwDisp[i] = y.threads[i]
 	}
+
+	// Create face table
+	y.faces = face.NewTable(faceDispatch, y.fwDisp, y.rib)
+
+	return y
 }
 
 // **Function:** `String() string`  
@@ -53,34 +92,66 @@ func (y *YaNFD) String() string {
 	return "yanfd"
 }
 
-// Start runs YaNFD. Note: this function may exit the program when there is error.
-// This function is non-blocking.
-func (y *YaNFD) Start() {
-	core.Log.Info(y, "Starting NDN forwarder", "version", utils.NDNdVersion)
+// Faces returns the face table of the forwarder.
+func (y *YaNFD) Faces() *face.Table {
+	return y.faces
+}
 
-	// Start profiler
-	y.profiler.Start()
+// Fib returns the FIB and strategy table of the forwarder.
+func (y *YaNFD) Fib() table.FibStrategy {
+	return y.fib
+}
+
+// Rib returns the RIB of the forwarder.
+func (y *YaNFD) Rib() *table.RibTable {
+	return y.rib
+}
 
+// StartForwarder starts the forwarding and management threads without
+// creating any listeners. Faces must be added to the face table by the caller.
+// This function is non-blocking.
+func (y *YaNFD) StartForwarder() {
 	// Create null face
-	face.MakeNullLinkService(face.MakeNullTransport()).Run(nil)
+	face.MakeNullLinkService(y.faces, face.MakeNullTransport()).Run(nil)
+
+	// Start forwarding threads
+	for _, thread := range y.threads {
+		go thread.Run()
+	}
 
 	// Start management thread
-	go mgmt.MakeMgmtThread().Run()
+	go mgmt.MakeMgmtThread(y.faces, y.fib, y.rib, y.fwDisp).Run()
+}
 
-	// Create forwarding threads
-	if fw.CfgNumThreads() < 1 || fw.CfgNumThreads() > fw.MaxFwThreads {
-		core.Log.Fatal(y, "Number of forwarding threads out of range", "range", fmt.Sprintf("[1, %d]", fw.MaxFwThreads))
-		os.Exit(2)
+// StopForwarder closes all faces and stops the forwarding threads.
+// Unlike Stop, this does not affect process-wide state such as the logger.
+func (y *YaNFD) StopForwarder() {
+	// Tell all faces to quit
+	for _, face := range y.faces.GetAll() {
+		face.Close()
+	}
+
+	// Tell all forwarding threads to quit
+	for _, fw := range y.threads {
+		fw.TellToQuit()
 	}
-	fw.Threads = make([]*fw.Thread, fw.CfgNumThreads())
-	var fwForDispatch []dispatch.FWThread
-	for i := range fw.CfgNumThreads() {
-		newThread := fw.NewThread(i)
-		fw.Threads[i] = newThread
-		fwForDispatch = append(fwForDispatch, newThread)
-		go fw.Threads[i].Run()
+
+	// Wait for all forwarding threads to have quit
+	for _, fw := range y.threads {
+		<-fw.HasQuit
 	}
-	dispatch.InitializeFWThreads(fwForDispatch)
+}
+
+// Start runs YaNFD. Note: this function may exit the program when there is error.
+// This function is non-blocking.
+func (y *YaNFD) Start() {
+	core.Log.Info(y, "Starting NDN forwarder", "version", utils.NDNdVersion)
+
+	// Start profiler
+	y.profiler.Start()
+
+	// Start forwarding and management threads
+	y.StartForwarder()
 
 	// Set up listeners for faces
 	listenerCount := 0
@@ -97,7 +168,7 @@ func (y *YaNFD) Start() {
 
 		for _, tcpAddr := range tcpAddrs {
 			uri := fmt.Sprintf("tcp://%s", tcpAddr)
-			tcpListener, err := face.MakeTCPListener(defn.DecodeURIString(uri))
+			tcpListener, err := face.MakeTCPListener(y.faces, defn.DecodeURIString(uri))
 			if err != nil {
 				core.Log.Error(y, "Unable to create TCP listener", "uri", uri, "err", err)
 			} else {
@@ -117,7 +188,7 @@ func (y *YaNFD) Start() {
 			Zone: zone,
 		})
 
-		udpListener, err := face.MakeUDPListener(defn.DecodeURIString(uri))
+		udpListener, err := face.MakeUDPListener(y.faces, defn.DecodeURIString(uri))
 		if err != nil {
 			core.Log.Error(y, "Unable to create UDP listener", "uri", uri, "err", err)
 		} else {
@@ -178,7 +249,7 @@ func (y *YaNFD) Start() {
 							core.Log.Error(y, "Unable to create MulticastUDPTransport", "uri", uri, "err", err)
 							continue
 						}
-						face.MakeNDNLPLinkService(multicastUDPTransport, face.MakeNDNLPLinkServiceOptions()).Run(nil)
+						face.MakeNDNLPLinkService(y.faces, multicastUDPTransport, face.MakeNDNLPLinkServiceOptions()).Run(nil)
 
 						listenerCount++
 						core.Log.Info(y, "Created multicast UDP face", "uri", uri)
@@ -191,7 +262,7 @@ func (y *YaNFD) Start() {
 	// Set up Unix stream listener
 	if core.C.Faces.Unix.Enabled {
 		uri := defn.MakeUnixFaceURI(face.CfgUnixSocketPath())
-		unixListener, err := face.MakeUnixStreamListener(uri)
+		unixListener, err := face.MakeUnixStreamListener(y.faces, uri)
 		if err != nil {
 			core.Log.Error(y, "Unable to create Unix stream listener", "path", face.CfgUnixSocketPath(), "err", err)
 		} else {
@@ -212,7 +283,7 @@ func (y *YaNFD) Start() {
 			TLSKey:     core.C.ResolveRelPath(core.C.Faces.WebSocket.TlsKey),
 		}
 
-		wsListener, err := face.NewWebSocketListener(cfg)
+		wsListener, err := face.NewWebSocketListener(y.faces, cfg)
 		if err != nil {
 			core.Log.Error(y, "Unable to create WebSocket Listener", "cfg", cfg, "err", err)
 		} else {
@@ -232,7 +303,7 @@ func (y *YaNFD) Start() {
 			TLSKey:  c.TlsKey,
 		}
 
-		h3Listener, err := face.NewHTTP3Listener(cfg)
+		h3Listener, err := face.NewHTTP3Listener(y.faces, cfg)
 		if err != nil {
 			core.Log.Error(y, "Unable to create HTTP/3 WebTransport Listener", "cfg", cfg, "err", err)
 		} else {
@@ -283,18 +354,6 @@ func (y *YaNFD) Stop() {
 		tcpListener.Close()
 	}
 
-	// Tell all faces to quit
-	for _, face := range face.FaceTable.GetAll() {
-		face.Close()
-	}
-
-	// Tell all forwarding threads to quit
-	for _, fw := range fw.Threads {
-		fw.TellToQuit()
-	}
-
-	// Wait for all forwarding threads to have quit
-	for _, fw := range fw.Threads {
-		<-fw.HasQuit
-	}
+	// Stop faces and forwarding threads
+	y.StopForwarder()
 }
@@ -37,16 +37,19 @@ type OutPkt struct {
 }
 
 // FaceDispatch is used to allow forwarding to interact with faces without a circular dependency issue.
-var FaceDispatch sync.Map
+// Each forwarder has its own FaceDispatch.
+type FaceDispatch struct {
+	faces sync.Map
+}
 
 // AddFace adds the specified face to the dispatch list.
-func AddFace(id uint64, face Face) {
-	FaceDispatch.Store(id, face)
+func (d *FaceDispatch) AddFace(id uint64, face Face) {
+	d.faces.Store(id, face)
 }
 
 // GetFace returns the specified face or nil if it does not exist.
-func GetFace(id uint64) Face {
-	face, ok := FaceDispatch.Load(id)
+func (d *FaceDispatch) GetFace(id uint64) Face {
+	face, ok := d.faces.Load(id)
 	if !ok {
 		return nil
 	}
@@ -54,6 +57,6 @@ func GetFace(id uint64) Face {
 }
 
 // RemoveFace removes the specified face from the dispatch map.
-func RemoveFace(id uint64) {
-	FaceDispatch.Delete(id)
+func (d *FaceDispatch) RemoveFace(id uint64) {
+	d.faces.Delete(id)
 }
@@ -23,18 +23,13 @@ type FWThread interface {
 }
 
 // FWDispatch is used to allow faces to interact with forwarding without a circular dependency issue.
-var FWDispatch []FWThread
-
-// InitializeFWThreads sets up the forwarding thread dispatch slice.
-func InitializeFWThreads(faces []FWThread) {
-	FWDispatch = make([]FWThread, len(faces))
-	copy(FWDispatch, faces)
-}
+// It contains the forwarding threads of a forwarder, indexed by thread ID.
+type FWDispatch []FWThread
 
 // GetFWThread returns the specified forwarding thread or nil if it does not exist.
-func GetFWThread(id int) FWThread {
-	if id < 0 || id > len(FWDispatch) {
+func (d FWDispatch) GetFWThread(id int) FWThread {
+	if id < 0 || id >= len(d) {
 		return nil
 	}
-	return FWDispatch[id]
+	return d[id]
 }
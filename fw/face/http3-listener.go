@@ -48,13 +48,14 @@ func (cfg HTTP3ListenerConfig) String() string {
 
 // HTTP3Listener listens for incoming HTTP/3 WebTransport sessions.
 type HTTP3Listener struct {
+	faces  *Table
 	mux    *http.ServeMux
 	server *webtransport.Server
 }
 
 // Constructs an HTTP/3 WebTransport listener configured with TLS certificates, QUIC settings, and an NDN endpoint handler for "/ndn".
-func NewHTTP3Listener(cfg HTTP3ListenerConfig) (*HTTP3Listener, error) {
-	l := &HTTP3Listener{}
+func NewHTTP3Listener(faces *Table, cfg HTTP3ListenerConfig) (*HTTP3Listener, error) {
+	l := &HTTP3Listener{faces: faces}
 
 	cert, e := tls.LoadX509KeyPair(cfg.TLSCert, cfg.TLSKey)
 	if e != nil {
@@ -120,5 +121,5 @@ func (l *HTTP3Listener) handler(rw http.ResponseWriter, r *http.Request) {
 
 	options := MakeNDNLPLinkServiceOptions()
 	options.IsFragmentationEnabled = true
-	MakeNDNLPLinkService(newTransport, options).Run(nil)
+	MakeNDNLPLinkService(l.faces, newTransport, options).Run(nil)
 }
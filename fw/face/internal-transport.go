@@ -26,9 +26,14 @@ type InternalTransport struct {
 
 // MakeInternalTransport makes an InternalTransport.
 func MakeInternalTransport() *InternalTransport {
+	return makeInternalTransport(defn.MakeInternalFaceURI())
+}
+
+// Makes an InternalTransport with the given remote URI.
+func makeInternalTransport(remoteURI *defn.URI) *InternalTransport {
 	t := new(InternalTransport)
 	t.makeTransportBase(
-		defn.MakeInternalFaceURI(),
+		remoteURI,
 		defn.MakeInternalFaceURI(),
 		spec_mgmt.PersistencyPersistent,
 		defn.Local,
@@ -40,14 +45,24 @@ func MakeInternalTransport() *InternalTransport {
 	return t
 }
 
-// RegisterInternalTransport creates, registers, and starts an InternalTransport.
-func RegisterInternalTransport() (LinkService, *InternalTransport) {
-	transport := MakeInternalTransport()
+// RegisterInternalTransport creates, registers, and starts an InternalTransport in the given face table.
+func RegisterInternalTransport(faces *Table) (LinkService, *InternalTransport) {
+	return registerInternalTransport(faces, MakeInternalTransport())
+}
+
+// RegisterAppTransport creates, registers, and starts an InternalTransport for an
+// application running in the same process. Unlike internal faces, the face has
+// the given remote URI and can be updated via management like an application face.
+func RegisterAppTransport(faces *Table, remoteURI *defn.URI) (LinkService, *InternalTransport) {
+	return registerInternalTransport(faces, makeInternalTransport(remoteURI))
+}
 
+// Registers and starts an InternalTransport in the given face table.
+func registerInternalTransport(faces *Table, transport *InternalTransport) (LinkService, *InternalTransport) {
 	options := MakeNDNLPLinkServiceOptions()
 	options.IsIncomingFaceIndicationEnabled = true
 	options.IsConsumerControlledForwardingEnabled = true
-	link := MakeNDNLPLinkService(transport, options)
+	link := MakeNDNLPLinkService(faces, transport, options)
 	link.Run(nil)
 
 	return link, transport
@@ -46,6 +46,8 @@ type LinkService interface {
 	SendPacket(out dispatch.OutPkt)
 	// Synchronously handle an incoming frame and dispatch to fw
 	handleIncomingFrame(frame []byte)
+	// Handle a change of the state of the transport
+	handleStateChange(state defn.State)
 
 	// Close the face
 	Close()
@@ -62,6 +64,7 @@ type LinkService interface {
 // linkServiceBase is the type upon which all link service implementations should be built
 type linkServiceBase struct {
 	faceID    uint64
+	faces     *Table
 	transport transport
 	stopped   chan bool
 	sendQueue chan dispatch.OutPkt
@@ -94,8 +97,9 @@ func (l *linkServiceBase) SetFaceID(faceID uint64) {
 // "Constructors" and threading
 //
 
-// Initializes the link service base by creating a stopped signal channel and a buffered send queue for outgoing packets.
-func (l *linkServiceBase) makeLinkServiceBase() {
+// Initializes the link service base of a face in the given face table, by creating a stopped signal channel and a buffered send queue for outgoing packets.
+func (l *linkServiceBase) makeLinkServiceBase(faces *Table) {
+	l.faces = faces
 	l.stopped = make(chan bool)
 	l.sendQueue = make(chan dispatch.OutPkt, CfgFaceQueueSize())
 }
@@ -167,6 +171,11 @@ func (l *linkServiceBase) State() defn.State {
 	return defn.Down
 }
 
+// handleStateChange notifies the face table that the transport went up or down.
+func (l *linkServiceBase) handleStateChange(state defn.State) {
+	l.faces.notifyState(l.faceID, state)
+}
+
 //
 // Counters
 //
@@ -236,7 +245,7 @@ func (l *linkServiceBase) dispatchInterest(pkt *defn.Pkt) {
 	// Hash name to thread
 	thread := fw.HashNameToFwThread(pkt.Name)
 	core.Log.Trace(l, "Dispatched Interest", "thread", thread)
-	dispatch.GetFWThread(thread).QueueInterest(pkt)
+	l.faces.fw.GetFWThread(thread).QueueInterest(pkt)
 }
 
 // Dispatches incoming Data packets to the appropriate forwarding threads by either using the attached PIT token, hashing the packet name for exact matches, or using prefix-based hashing for locally generated packets without PIT tokens.
@@ -251,7 +260,7 @@ func (l *linkServiceBase) dispatchData(pkt *defn.Pkt) {
 	// Decode PitToken. If it's for us, it's a uint16 + uint32.
 	if len(pkt.PitToken) == 6 {
 		thread := binary.BigEndian.Uint16(pkt.PitToken)
-		fwThread := dispatch.GetFWThread(int(thread))
+		fwThread := l.faces.fw.GetFWThread(int(thread))
 		if fwThread == nil {
 			core.Log.Error(l, "Invalid PIT token attached to Data packet")
 			return
@@ -269,7 +278,7 @@ func (l *linkServiceBase) dispatchData(pkt *defn.Pkt) {
 		for i, match := range fw.HashNameToAllPrefixFwThreads(pkt.Name) {
 			if match {
 				core.Log.Trace(l, "Prefix dispatched local-origin Data", "thread", i)
-				dispatch.GetFWThread(i).QueueData(pkt)
+				l.faces.fw.GetFWThread(i).QueueData(pkt)
 			}
 		}
 		return
@@ -278,5 +287,5 @@ func (l *linkServiceBase) dispatchData(pkt *defn.Pkt) {
 	// Only exact-match for now (no CanBePrefix)
 	thread := fw.HashNameToFwThread(pkt.Name)
 	core.Log.Trace(l, "Dispatched Data", "thread", thread)
-	dispatch.GetFWThread(thread).QueueData(pkt)
+	l.faces.fw.GetFWThread(thread).QueueData(pkt)
 }
@@ -77,10 +77,10 @@ type NDNLPLinkService struct {
 	outFrame                 []byte
 }
 
-// MakeNDNLPLinkService creates a new NDNLPv2 link service
-func MakeNDNLPLinkService(transport transport, options NDNLPLinkServiceOptions) *NDNLPLinkService {
+// MakeNDNLPLinkService creates a new NDNLPv2 link service for a face in the given face table
+func MakeNDNLPLinkService(faces *Table, transport transport, options NDNLPLinkServiceOptions) *NDNLPLinkService {
 	l := new(NDNLPLinkService)
-	l.makeLinkServiceBase()
+	l.makeLinkServiceBase(faces)
 	l.transport = transport
 	l.transport.setLinkService(l)
 	l.options = options
@@ -129,7 +129,7 @@ func (l *NDNLPLinkService) Run(initial []byte) {
 	}
 
 	// Add self to face table. Removed in runSend.
-	FaceTable.Add(l)
+	l.faces.Add(l)
 
 	// Process initial incoming frame
 	if initial != nil {
@@ -162,7 +162,7 @@ func (l *NDNLPLinkService) runSend() {
 		case pkt := <-l.sendQueue:
 			sendPacket(l, pkt)
 		case <-l.stopped:
-			FaceTable.Remove(l.transport.FaceID())
+			l.faces.Remove(l.transport.FaceID())
 			return
 		}
 	}
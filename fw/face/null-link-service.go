@@ -16,10 +16,10 @@ type NullLinkService struct {
 	linkServiceBase
 }
 
-// MakeNullLinkService makes a NullLinkService.
-func MakeNullLinkService(transport transport) *NullLinkService {
+// MakeNullLinkService makes a NullLinkService in the given face table.
+func MakeNullLinkService(faces *Table, transport transport) *NullLinkService {
 	l := new(NullLinkService)
-	l.makeLinkServiceBase()
+	l.makeLinkServiceBase(faces)
 	l.transport = transport
 	l.transport.setLinkService(l)
 	return l
@@ -27,10 +27,10 @@ func MakeNullLinkService(transport transport) *NullLinkService {
 
 // Run runs the NullLinkService.
 func (l *NullLinkService) Run(initial []byte) {
-	FaceTable.Add(l)
+	l.faces.Add(l)
 	go func() {
 		l.transport.runReceive()
-		FaceTable.Remove(l.transport.FaceID())
+		l.faces.Remove(l.transport.FaceID())
 	}()
 }
 
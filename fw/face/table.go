@@ -16,15 +16,41 @@ import (
 	defn "github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/table"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
 
-// FaceTable is the global face table for this forwarder
-var FaceTable Table
-
 // Table hold all faces used by the forwarder.
 type Table struct {
 	faces      sync.Map
 	nextFaceID atomic.Uint64 // starts at 1
+
+	// faces of the forwarding threads
+	dispatch *dispatch.FaceDispatch
+	// forwarding threads of the forwarder
+	fw dispatch.FWDispatch
+	// RIB cleaned up when a face is removed
+	rib *table.RibTable
+
+	eventsMutex   sync.Mutex
+	eventHandlers []FaceEventHandler
+}
+
+// FaceEventHandler is called when a face is added to or removed from the face table,
+// and when the transport of a face in the table goes up or down.
+// The kind is one of the face event kinds of the management protocol.
+type FaceEventHandler func(kind uint64, face LinkService)
+
+// NewTable creates the face table of a forwarder, which dispatches the packets
+// received on its faces to the given forwarding threads.
+func NewTable(faces *dispatch.FaceDispatch, fw dispatch.FWDispatch, rib *table.RibTable) *Table {
+	t := &Table{
+		dispatch: faces,
+		fw:       fw,
+		rib:      rib,
+	}
+	t.nextFaceID.Store(1)
+	go t.expirationHandler()
+	return t
 }
 
 // Returns a string representation of the Table, which is 'face-table'.
@@ -37,8 +63,9 @@ func (t *Table) Add(face LinkService) {
 	faceID := t.nextFaceID.Add(1) - 1
 	face.SetFaceID(faceID)
 	t.faces.Store(faceID, face)
-	dispatch.AddFace(faceID, face)
+	t.dispatch.AddFace(faceID, face)
 	core.Log.Debug(t, "Registered face", "faceid", faceID)
+	t.notify(spec_mgmt.FaceEventCreated, face)
 }
 
 // Get gets the face with the specified ID (if any) from the face table.
@@ -76,14 +103,51 @@ func (t *Table) GetAll() []LinkService {
 
 // Remove removes a face from the face table.
 func (t *Table) Remove(id uint64) {
+	face := t.Get(id)
 	t.faces.Delete(id)
-	dispatch.RemoveFace(id)
-	table.Rib.CleanUpFace(id)
+	t.dispatch.RemoveFace(id)
+	t.rib.CleanUpFace(id)
 	core.Log.Info(t, "Unregistered face", "faceid", id)
+	if face != nil {
+		t.notify(spec_mgmt.FaceEventDestroyed, face)
+	}
+}
+
+// notifyState notifies the face event handlers that the transport of a face
+// went up or down. Faces that are not in the table are ignored.
+func (t *Table) notifyState(id uint64, state defn.State) {
+	face := t.Get(id)
+	if face == nil {
+		return
+	}
+	core.Log.Info(t, "Face state changed", "faceid", id, "state", state)
+	if state == defn.Up {
+		t.notify(spec_mgmt.FaceEventUp, face)
+	} else {
+		t.notify(spec_mgmt.FaceEventDown, face)
+	}
+}
+
+// OnEvent registers a handler for face events.
+func (t *Table) OnEvent(handler FaceEventHandler) {
+	t.eventsMutex.Lock()
+	defer t.eventsMutex.Unlock()
+	t.eventHandlers = append(t.eventHandlers, handler)
+}
+
+// notify calls all face event handlers.
+func (t *Table) notify(kind uint64, face LinkService) {
+	t.eventsMutex.Lock()
+	handlers := t.eventHandlers
+	t.eventsMutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(kind, face)
+	}
 }
 
 // expirationHandler stops the faces that have expired
-// Runs in a separate goroutine called from Initialize()
+// Runs in a separate goroutine called from NewTable()
 func (t *Table) expirationHandler() {
 	for !core.ShouldQuit {
 		// Check for expired faces every 10 seconds
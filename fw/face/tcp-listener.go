@@ -21,19 +21,21 @@ import (
 
 // TCPListener listens for incoming TCP unicast connections.
 type TCPListener struct {
+	faces    *Table
 	conn     net.Listener
 	localURI *defn.URI
 	stopped  chan bool
 }
 
-// MakeTCPListener constructs a TCPListener.
-func MakeTCPListener(localURI *defn.URI) (*TCPListener, error) {
+// MakeTCPListener constructs a TCPListener that adds its faces to the given face table.
+func MakeTCPListener(faces *Table, localURI *defn.URI) (*TCPListener, error) {
 	localURI.Canonize()
 	if !localURI.IsCanonical() || (localURI.Scheme() != "tcp4" && localURI.Scheme() != "tcp6") {
 		return nil, defn.ErrNotCanonical
 	}
 
 	l := new(TCPListener)
+	l.faces = faces
 	l.localURI = localURI
 	l.stopped = make(chan bool, 1)
 	return l, nil
@@ -87,7 +89,7 @@ func (l *TCPListener) Run() {
 		core.Log.Info(l, "Accepting new TCP face", "uri", newTransport.RemoteURI())
 		options := MakeNDNLPLinkServiceOptions()
 		options.IsFragmentationEnabled = false // reliable stream
-		MakeNDNLPLinkService(newTransport, options).Run(nil)
+		MakeNDNLPLinkService(l.faces, newTransport, options).Run(nil)
 	}
 }
 
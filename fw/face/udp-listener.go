@@ -21,19 +21,21 @@ import (
 
 // UDPListener listens for incoming UDP unicast connections.
 type UDPListener struct {
+	faces    *Table
 	conn     net.PacketConn
 	localURI *defn.URI
 	stopped  chan bool
 }
 
-// MakeUDPListener constructs a UDPListener.
-func MakeUDPListener(localURI *defn.URI) (*UDPListener, error) {
+// MakeUDPListener constructs a UDPListener that adds its faces to the given face table.
+func MakeUDPListener(faces *Table, localURI *defn.URI) (*UDPListener, error) {
 	localURI.Canonize()
 	if !localURI.IsCanonical() || (localURI.Scheme() != "udp4" && localURI.Scheme() != "udp6") {
 		return nil, defn.ErrNotCanonical
 	}
 
 	l := new(UDPListener)
+	l.faces = faces
 	l.localURI = localURI
 	l.stopped = make(chan bool, 1)
 	return l, nil
@@ -90,7 +92,7 @@ func (l *UDPListener) Run() {
 		// This is probably because it was received too fast.
 		// For now just drop the frame, ideally we should pass it to face.
 		// If you call handleIncomingFrame() here, it will cause a race condition.
-		if face := FaceTable.GetByURI(remoteURI); face != nil {
+		if face := l.faces.GetByURI(remoteURI); face != nil {
 			core.Log.Trace(l, "Received frame for existing", "face", face)
 			continue
 		}
@@ -103,7 +105,7 @@ func (l *UDPListener) Run() {
 		}
 
 		core.Log.Info(l, "Accepting new UDP face", "uri", newTransport.RemoteURI())
-		MakeNDNLPLinkService(newTransport, MakeNDNLPLinkServiceOptions()).Run(recvBuf[:readSize])
+		MakeNDNLPLinkService(l.faces, newTransport, MakeNDNLPLinkServiceOptions()).Run(recvBuf[:readSize])
 	}
 }
 
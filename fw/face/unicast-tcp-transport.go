@@ -256,6 +256,7 @@ func (t *UnicastTCPTransport) runReceive() {
 			}
 
 			core.Log.Warn(t, "Unable to read from socket - Face DOWN", "err", err)
+			t.CloseConn()
 		}
 
 		// Persistent faces will reconnect, otherwise close
@@ -265,14 +266,20 @@ func (t *UnicastTCPTransport) runReceive() {
 		}
 
 		core.Log.Info(t, "Connected socket - Face UP")
-		t.running.Store(true)
+		if !t.running.Swap(true) {
+			t.linkService.handleStateChange(defn.Up)
+		}
 	}
 }
 
 // Close the inner connection if running without closing the transport.
+// The face goes down unless the transport is closed permanently.
 func (t *UnicastTCPTransport) CloseConn() {
 	if t.running.Swap(false) {
 		t.conn.Close()
+		if !t.closed {
+			t.linkService.handleStateChange(defn.Down)
+		}
 	}
 }
 
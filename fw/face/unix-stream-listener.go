@@ -20,20 +20,22 @@ import (
 
 // UnixStreamListener listens for incoming Unix stream connections.
 type UnixStreamListener struct {
+	faces    *Table
 	conn     net.Listener
 	localURI *defn.URI
 	nextFD   int // We can't (at least easily) access the actual FD through net.Conn, so we'll make our own
 	stopped  chan bool
 }
 
-// MakeUnixStreamListener constructs a UnixStreamListener.
-func MakeUnixStreamListener(localURI *defn.URI) (*UnixStreamListener, error) {
+// MakeUnixStreamListener constructs a UnixStreamListener that adds its faces to the given face table.
+func MakeUnixStreamListener(faces *Table, localURI *defn.URI) (*UnixStreamListener, error) {
 	localURI.Canonize()
 	if !localURI.IsCanonical() || localURI.Scheme() != "unix" {
 		return nil, defn.ErrNotCanonical
 	}
 
 	return &UnixStreamListener{
+		faces:    faces,
 		localURI: localURI,
 		nextFD:   1,
 		stopped:  make(chan bool, 1),
@@ -97,7 +99,7 @@ func (l *UnixStreamListener) Run() {
 		core.Log.Info(l, "Accepting new unix stream face", "uri", remoteURI)
 		options := MakeNDNLPLinkServiceOptions()
 		options.IsFragmentationEnabled = false // reliable stream
-		MakeNDNLPLinkService(newTransport, options).Run(nil)
+		MakeNDNLPLinkService(l.faces, newTransport, options).Run(nil)
 	}
 }
 
@@ -0,0 +1,205 @@
+package face
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+)
+
+// VirtualLinkConfig contains the properties of one direction of a virtual link.
+type VirtualLinkConfig struct {
+	// Delay is the one-way propagation delay.
+	Delay time.Duration
+	// Loss is the probability in [0, 1] that a frame is dropped.
+	Loss float64
+	// Bandwidth is the link rate in bits per second (0 for unlimited).
+	Bandwidth uint64
+	// QueueSize is the number of frames that can be in flight.
+	// Frames sent when the queue is full are dropped (0 for the face queue size).
+	QueueSize int
+}
+
+// virtualFrame is a frame in flight on a virtual link.
+type virtualFrame struct {
+	frame   []byte
+	arrival time.Time
+}
+
+// VirtualTransport is one end of an emulated point-to-point link between
+// two face tables in the same process, used to test multiple forwarders.
+type VirtualTransport struct {
+	transportBase
+
+	peer   *VirtualTransport
+	config atomic.Pointer[VirtualLinkConfig]
+	up     atomic.Bool
+
+	// Frames in flight towards this end
+	inbox chan virtualFrame
+	close chan bool
+	// Time at which the sender is done serializing the last frame
+	busyUntil time.Time
+}
+
+// MakeVirtualLink makes a pair of connected VirtualTransports with the given URIs.
+// The first transport sends to the second one and vice versa.
+// Both directions of the link are initially up and use the same configuration.
+func MakeVirtualLink(uriA *defn.URI, uriB *defn.URI, config VirtualLinkConfig) (*VirtualTransport, *VirtualTransport) {
+	a := makeVirtualTransport(uriB, uriA, config)
+	b := makeVirtualTransport(uriA, uriB, config)
+	a.peer, b.peer = b, a
+	return a, b
+}
+
+// Creates one end of a virtual link.
+func makeVirtualTransport(remoteURI *defn.URI, localURI *defn.URI, config VirtualLinkConfig) *VirtualTransport {
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = CfgFaceQueueSize()
+	}
+
+	t := &VirtualTransport{
+		inbox: make(chan virtualFrame, queueSize),
+		close: make(chan bool, 1),
+	}
+	t.makeTransportBase(
+		remoteURI,
+		localURI,
+		spec_mgmt.PersistencyPermanent,
+		defn.NonLocal,
+		defn.PointToPoint,
+		defn.MaxNDNPacketSize)
+	t.config.Store(&config)
+	t.up.Store(true)
+	t.running.Store(true)
+	return t
+}
+
+// Returns a string representation of the virtual transport, including its face ID, remote URI, and local URI.
+func (t *VirtualTransport) String() string {
+	return fmt.Sprintf("virtual-transport (faceid=%d remote=%s local=%s)", t.faceID, t.remoteURI, t.localURI)
+}
+
+// SetPersistency changes the persistency of the face.
+func (t *VirtualTransport) SetPersistency(persistency spec_mgmt.Persistency) bool {
+	if persistency == t.persistency {
+		return true
+	}
+
+	if persistency == spec_mgmt.PersistencyPermanent {
+		t.persistency = persistency
+		return true
+	}
+
+	return false
+}
+
+// GetSendQueueSize returns the number of frames in flight from this end.
+func (t *VirtualTransport) GetSendQueueSize() uint64 {
+	return uint64(len(t.peer.inbox))
+}
+
+// Config returns the configuration of the direction sending from this end.
+func (t *VirtualTransport) Config() VirtualLinkConfig {
+	return *t.config.Load()
+}
+
+// SetConfig changes the configuration of the direction sending from this end.
+// Frames already in flight are not affected. The queue size cannot be changed.
+func (t *VirtualTransport) SetConfig(config VirtualLinkConfig) {
+	t.config.Store(&config)
+}
+
+// SetUp brings the direction sending from this end up or down.
+// Frames sent while the link is down are dropped, but the face stays up.
+func (t *VirtualTransport) SetUp(up bool) {
+	t.up.Store(up)
+}
+
+// IsUp returns whether the direction sending from this end is up.
+func (t *VirtualTransport) IsUp() bool {
+	return t.up.Load()
+}
+
+// Puts a frame on the link towards the peer, applying loss, serialization and propagation delay.
+// Frames are only sent from the link service send goroutine, so busyUntil is not locked.
+func (t *VirtualTransport) sendFrame(frame []byte) {
+	if len(frame) > t.MTU() {
+		core.Log.Warn(t, "Attempted to send frame larger than MTU")
+		return
+	}
+
+	if !t.running.Load() || !t.up.Load() {
+		return
+	}
+
+	t.nOutBytes += uint64(len(frame))
+
+	config := t.config.Load()
+	if config.Loss > 0 && rand.Float64() < config.Loss {
+		return
+	}
+
+	// Serialization delay
+	now := time.Now()
+	if t.busyUntil.Before(now) {
+		t.busyUntil = now
+	}
+	if config.Bandwidth > 0 {
+		bits := uint64(len(frame)) * 8
+		t.busyUntil = t.busyUntil.Add(time.Duration(bits * uint64(time.Second) / config.Bandwidth))
+	}
+
+	frameCopy := make([]byte, len(frame))
+	copy(frameCopy, frame)
+
+	select {
+	case t.peer.inbox <- virtualFrame{frame: frameCopy, arrival: t.busyUntil.Add(config.Delay)}:
+	default:
+		// tail drop
+		core.Log.Trace(t, "Virtual link queue full - DROP")
+	}
+}
+
+// Delivers frames arriving from the peer to the link service after their arrival time.
+func (t *VirtualTransport) runReceive() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		var vf virtualFrame
+		select {
+		case vf = <-t.inbox:
+		case <-t.close:
+			return
+		}
+
+		if wait := time.Until(vf.arrival); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-t.close:
+				return
+			}
+		}
+
+		if !t.running.Load() {
+			return
+		}
+
+		t.nInBytes += uint64(len(vf.frame))
+		t.linkService.handleIncomingFrame(vf.frame)
+	}
+}
+
+// Closes this end of the virtual link. The peer end is not closed.
+func (t *VirtualTransport) Close() {
+	if t.running.Swap(false) {
+		t.close <- true
+	}
+}
@@ -29,6 +29,7 @@ type WebSocketListenerConfig struct {
 
 // WebSocketListener listens for incoming WebSockets connections.
 type WebSocketListener struct {
+	faces    *Table
 	server   http.Server
 	upgrader websocket.Upgrader
 	localURI *defn.URI
@@ -53,9 +54,10 @@ func (cfg WebSocketListenerConfig) String() string {
 }
 
 // Constructs a WebSocket listener configured with the provided settings, including TLS support if enabled, and initializes the server with the appropriate URI, upgrader, and security parameters.
-func NewWebSocketListener(cfg WebSocketListenerConfig) (*WebSocketListener, error) {
+func NewWebSocketListener(faces *Table, cfg WebSocketListenerConfig) (*WebSocketListener, error) {
 	localURI := cfg.URL()
 	ret := &WebSocketListener{
+		faces:  faces,
 		server: http.Server{Addr: localURI.Host},
 		upgrader: websocket.Upgrader{
 			WriteBufferPool: &sync.Pool{},
@@ -109,7 +111,7 @@ func (l *WebSocketListener) handler(w http.ResponseWriter, r *http.Request) {
 
 	options := MakeNDNLPLinkServiceOptions()
 	options.IsFragmentationEnabled = false // reliable stream
-	MakeNDNLPLinkService(newTransport, options).Run(nil)
+	MakeNDNLPLinkService(l.faces, newTransport, options).Run(nil)
 }
 
 // Closes the WebSocket listener by initiating a graceful shutdown of the underlying server.
@@ -25,9 +25,6 @@ import (
 // MaxFwThreads Maximum number of forwarding threads
 const MaxFwThreads = 32
 
-// Threads contains all forwarding threads
-var Threads []*Thread
-
 // HashNameToFwThread hashes an NDN name to a forwarding thread.
 func HashNameToFwThread(name enc.Name) int {
 	// Dispatch all management requests to thread 0
@@ -37,13 +34,13 @@ func HashNameToFwThread(name enc.Name) int {
 		return 0
 	}
 	// to prevent negative modulos because we converted from uint to int
-	return int(name.Hash() % uint64(len(Threads)))
+	return int(name.Hash() % uint64(CfgNumThreads()))
 }
 
 // HashNameToAllPrefixFwThreads hashes an NDN name to all forwarding threads for all prefixes of the name.
 // The return value is a boolean map of which threads match the name
 func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
-	threads := make([]bool, len(Threads))
+	threads := make([]bool, CfgNumThreads())
 
 	// Dispatch all management requests to thread 0
 	if len(name) > 0 && name[0].Equal(enc.LOCALHOST) {
@@ -53,7 +50,7 @@ func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
 
 	prefixHash := name.PrefixHash()
 	for i := 1; i < len(prefixHash); i++ {
-		thread := int(prefixHash[i] % uint64(len(Threads)))
+		thread := int(prefixHash[i] % uint64(CfgNumThreads()))
 		threads[thread] = true
 	}
 	return threads
@@ -62,6 +59,8 @@ func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
 // Thread Represents a forwarding thread
 type Thread struct {
 	threadID      int
+	fib           table.FibStrategy
+	faces         *dispatch.FaceDispatch
 	pending       chan *defn.Pkt
 	pitCS         table.PitCsTable
 	strategies    map[uint64]Strategy
@@ -80,10 +79,13 @@ type Thread struct {
 	nCsMisses             atomic.Uint64
 }
 
-// NewThread creates a new forwarding thread
-func NewThread(id int) *Thread {
+// NewThread creates a new forwarding thread of a forwarder,
+// with the FIB and faces of the forwarder.
+func NewThread(id int, fib table.FibStrategy, faces *dispatch.FaceDispatch) *Thread {
 	t := new(Thread)
 	t.threadID = id
+	t.fib = fib
+	t.faces = faces
 	t.pending = make(chan *defn.Pkt, CfgFwQueueSize())
 	t.pitCS = table.NewPitCS(t.finalizeInterest)
 	t.strategies = InstantiateStrategies(t)
@@ -131,6 +133,7 @@ func (t *Thread) Run() {
 		runtime.LockOSThread()
 	}
 
+loop:
 	for !core.ShouldQuit {
 		select {
 		case pkt := <-t.pending:
@@ -144,7 +147,7 @@ func (t *Thread) Run() {
 		case <-t.pitCS.UpdateTicker():
 			t.pitCS.Update()
 		case <-t.shouldQuit:
-			continue
+			break loop
 		}
 	}
 
@@ -181,7 +184,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 
 	// Already asserted that this is an Interest in link service
 	// Get incoming face
-	incomingFace := dispatch.GetFace(packet.IncomingFaceID)
+	incomingFace := t.faces.GetFace(packet.IncomingFaceID)
 	if incomingFace == nil {
 		core.Log.Error(t, "Interest has non-existent incoming face", "faceid", packet.IncomingFaceID, "name", packet.Name)
 		return
@@ -250,7 +253,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	}
 
 	// Get strategy for name
-	strategyName := table.FibStrategyTable.FindStrategyEnc(interest.Name())
+	strategyName := t.fib.FindStrategyEnc(interest.Name())
 	strategy := t.strategies[strategyName.Hash()]
 
 	// Add in-record and determine if already pending
@@ -308,7 +311,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 
 	// If NextHopFaceId set, forward to that face (if it exists) or drop
 	if hop, ok := packet.NextHopFaceID.Get(); ok {
-		if face := dispatch.GetFace(hop); face != nil {
+		if face := t.faces.GetFace(hop); face != nil {
 			core.Log.Trace(t, "NextHopFaceId is set for Interest", "name", packet.Name)
 			t.processOutgoingInterest(packet, pitEntry, hop, incomingFace.FaceID())
 		} else {
@@ -325,7 +328,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	}
 
 	// Query the FIB for all possible nexthops
-	nexthops := table.FibStrategyTable.FindNextHopsEnc(lookupName)
+	nexthops := t.fib.FindNextHopsEnc(lookupName)
 
 	// If the first component is /localhop, we do not forward interests received
 	// on non-local faces to non-local faces
@@ -341,7 +344,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 
 		// Exclude non-local faces for localhop enforcement
 		if localFacesOnly {
-			if face := dispatch.GetFace(nexthop.Nexthop); face != nil && face.Scope() != defn.Local {
+			if face := t.faces.GetFace(nexthop.Nexthop); face != nil && face.Scope() != defn.Local {
 				continue
 			}
 		}
@@ -372,7 +375,7 @@ func (t *Thread) processOutgoingInterest(
 	core.Log.Trace(t, "OnOutgoingInterest", "name", packet.Name, "faceid", nexthop)
 
 	// Get outgoing face
-	outgoingFace := dispatch.GetFace(nexthop)
+	outgoingFace := t.faces.GetFace(nexthop)
 	if outgoingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop", "name", packet.Name, "faceid", nexthop)
 		return false
@@ -438,7 +441,7 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 	}
 
 	// Get incoming face
-	incomingFace := dispatch.GetFace(packet.IncomingFaceID)
+	incomingFace := t.faces.GetFace(packet.IncomingFaceID)
 	if incomingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop for Data", "name", packet.Name, "faceid", packet.IncomingFaceID)
 		return
@@ -467,7 +470,7 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 	}
 
 	// Get strategy for name
-	strategyName := table.FibStrategyTable.FindStrategyEnc(data.NameV)
+	strategyName := t.fib.FindStrategyEnc(data.NameV)
 	strategy := t.strategies[strategyName.Hash()]
 
 	if len(pitEntries) == 1 {
@@ -551,7 +554,7 @@ func (t *Thread) processOutgoingData(
 	core.Log.Trace(t, "OnOutgoingData", "name", packet.Name, "faceid", nexthop)
 
 	// Get outgoing face
-	outgoingFace := dispatch.GetFace(nexthop)
+	outgoingFace := t.faces.GetFace(nexthop)
 	if outgoingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop for Data", "name", packet.Name, "faceid", nexthop)
 		return
@@ -9,7 +9,6 @@ package mgmt
 
 import (
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/fw"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -127,7 +126,7 @@ func (c *ContentStoreModule) info(interest *Interest) {
 		},
 	}
 	for threadID := 0; threadID < fw.CfgNumThreads(); threadID++ {
-		thread := dispatch.GetFWThread(threadID)
+		thread := c.manager.fw.GetFWThread(threadID)
 		counters := thread.Counters()
 
 		status.CsInfo.NCsEntries += uint64(counters.NCsEntries)
@@ -24,6 +24,8 @@ import (
 // FaceModule is the module that handles Face Management.
 type FaceModule struct {
 	manager *Thread
+	// face event notification stream
+	events *notificationStream
 }
 
 // Returns a string representation of the FaceModule, which is "mgmt-face", typically used for logging or debugging.
@@ -34,6 +36,10 @@ func (f *FaceModule) String() string {
 // Registers the provided Thread as the manager for the FaceModule, associating it with the face's operational context.
 func (f *FaceModule) registerManager(manager *Thread) {
 	f.manager = manager
+	f.events = newNotificationStream(manager, LOCAL_PREFIX.
+		Append(enc.NewGenericComponent("faces")).
+		Append(enc.NewGenericComponent("events")))
+	f.manager.faces.OnEvent(f.onFaceEvent)
 }
 
 // Returns the manager thread associated with this FaceModule.
@@ -62,6 +68,8 @@ func (f *FaceModule) handleIncomingInterest(interest *Interest) {
 		f.list(interest)
 	case "query":
 		f.query(interest)
+	case "events":
+		f.events.handleInterest(interest)
 	default:
 		core.Log.Warn(f, "Received Interest for non-existent verb", "verb", verb)
 		f.manager.sendCtrlResp(interest, 501, "Unknown verb", nil)
@@ -99,7 +107,7 @@ func (f *FaceModule) create(interest *Interest) {
 	}
 
 	// Ensure does not conflict with existing face
-	existingFace := face.FaceTable.GetByURI(URI)
+	existingFace := f.manager.faces.GetByURI(URI)
 	if existingFace != nil {
 		core.Log.Warn(f, "Cannot create face, conflicts with existing face",
 			"faceid", existingFace.FaceID(), "uri", existingFace.RemoteURI())
@@ -186,7 +194,7 @@ func (f *FaceModule) create(interest *Interest) {
 			options.DefaultCongestionThresholdBytes = defaultCongestionThresholdBytes
 		}
 
-		linkService = face.MakeNDNLPLinkService(transport, options)
+		linkService = face.MakeNDNLPLinkService(f.manager.faces, transport, options)
 		linkService.Run(nil)
 	} else if URI.Scheme() == "tcp4" || URI.Scheme() == "tcp6" {
 		// Validate that remote endpoint is an IP address
@@ -264,7 +272,7 @@ func (f *FaceModule) create(interest *Interest) {
 			options.DefaultCongestionThresholdBytes = defaultCongestionThresholdBytes
 		}
 
-		linkService = face.MakeNDNLPLinkService(transport, options)
+		linkService = face.MakeNDNLPLinkService(f.manager.faces, transport, options)
 		linkService.Run(nil)
 	} else {
 		f.manager.sendCtrlResp(interest, 406, "Unsupported scheme "+URI.Scheme(), nil)
@@ -306,7 +314,7 @@ func (f *FaceModule) update(interest *Interest) {
 	responseParams := &mgmt.ControlArgs{}
 	areParamsValid := true
 
-	selectedFace := face.FaceTable.Get(faceID)
+	selectedFace := f.manager.faces.Get(faceID)
 	if selectedFace == nil {
 		core.Log.Warn(f, "Cannot update specified (or implicit) face because it does not exist", "faceid", faceID)
 		f.manager.sendCtrlResp(interest, 404, "Face does not exist", &mgmt.ControlArgs{FaceId: optional.Some(faceID)})
@@ -417,7 +425,7 @@ func (f *FaceModule) update(interest *Interest) {
 	f.manager.sendCtrlResp(interest, 200, "OK", responseParams)
 }
 
-// Destroys a face by ID using a control command, validating the request parameters and removing the corresponding face from the FaceTable if it exists.
+// Destroys a face by ID using a control command, validating the request parameters and removing the corresponding face from the face table if it exists.
 func (f *FaceModule) destroy(interest *Interest) {
 	if len(interest.Name()) < len(LOCAL_PREFIX)+3 {
 		f.manager.sendCtrlResp(interest, 400, "ControlParameters is incorrect", nil)
@@ -435,7 +443,7 @@ func (f *FaceModule) destroy(interest *Interest) {
 		return
 	}
 
-	if link := face.FaceTable.Get(params.FaceId.Unwrap()); link != nil {
+	if link := f.manager.faces.Get(params.FaceId.Unwrap()); link != nil {
 		link.Close()
 		core.Log.Info(f, "Destroyed face", "faceid", params.FaceId.Unwrap())
 	} else {
@@ -455,7 +463,7 @@ func (f *FaceModule) list(interest *Interest) {
 	// Generate new dataset
 	faces := make(map[uint64]face.LinkService)
 	faceIDs := make([]uint64, 0)
-	for _, face := range face.FaceTable.GetAll() {
+	for _, face := range f.manager.faces.GetAll() {
 		faces[face.FaceID()] = face
 		faceIDs = append(faceIDs, face.FaceID())
 	}
@@ -501,7 +509,7 @@ func (f *FaceModule) query(interest *Interest) {
 	}
 
 	// filter all faces to match filter
-	faces := face.FaceTable.GetAll()
+	faces := f.manager.faces.GetAll()
 	matchingFaces := make([]int, 0)
 	for pos, face := range faces {
 		if fid, ok := filter.FaceId.Get(); ok && fid != face.FaceID() {
@@ -589,6 +597,24 @@ func (f *FaceModule) createDataset(selectedFace face.LinkService) *mgmt.FaceStat
 	return faceDataset
 }
 
+// Publishes a face event notification when a face is created or destroyed.
+func (f *FaceModule) onFaceEvent(kind uint64, selectedFace face.LinkService) {
+	status := f.createDataset(selectedFace)
+	notification := &mgmt.FaceEventNotification{
+		Val: &mgmt.FaceEventNotificationValue{
+			FaceEventKind:   kind,
+			FaceId:          status.FaceId,
+			Uri:             status.Uri,
+			LocalUri:        status.LocalUri,
+			FaceScope:       status.FaceScope,
+			FacePersistency: status.FacePersistency,
+			LinkType:        status.LinkType,
+			Flags:           status.Flags,
+		},
+	}
+	f.events.publish(notification.Encode())
+}
+
 // Fills the provided ControlArgs with properties of the selected face, including common attributes like FaceID and MTU, as well as NDNLP-specific congestion control parameters if applicable.
 func (f *FaceModule) fillFaceProperties(params *mgmt.ControlArgs, selectedFace face.LinkService) {
 	params.FaceId = optional.Some(selectedFace.FaceID())
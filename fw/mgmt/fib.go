@@ -9,8 +9,6 @@ package mgmt
 
 import (
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/face"
-	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 	"github.com/named-data/ndnd/std/types/optional"
@@ -80,14 +78,14 @@ func (f *FIBModule) add(interest *Interest) {
 	faceID := interest.inFace.Unwrap()
 	if fid, ok := params.FaceId.Get(); ok && fid != 0 {
 		faceID = fid
-		if face.FaceTable.Get(faceID) == nil {
+		if f.manager.faces.Get(faceID) == nil {
 			f.manager.sendCtrlResp(interest, 410, "Face does not exist", nil)
 			return
 		}
 	}
 
 	cost := params.Cost.GetOr(0)
-	table.FibStrategyTable.InsertNextHopEnc(params.Name, faceID, cost)
+	f.manager.fib.InsertNextHopEnc(params.Name, faceID, cost)
 
 	core.Log.Info(f, "Created nexthop", "name", params.Name, "faceid", faceID, "cost", cost)
 
@@ -120,7 +118,7 @@ func (f *FIBModule) remove(interest *Interest) {
 	if fid, ok := params.FaceId.Get(); ok && fid != 0 {
 		faceID = fid
 	}
-	table.FibStrategyTable.RemoveNextHopEnc(params.Name, faceID)
+	f.manager.fib.RemoveNextHopEnc(params.Name, faceID)
 
 	core.Log.Info(f, "Removed nexthop", "name", params.Name, "faceid", faceID)
 
@@ -139,7 +137,7 @@ func (f *FIBModule) list(interest *Interest) {
 
 	// Generate new dataset
 	// TODO: For thread safety, we should lock the FIB from writes until we are done
-	entries := table.FibStrategyTable.GetAllFIBEntries()
+	entries := f.manager.fib.GetAllFIBEntries()
 	dataset := &mgmt.FibStatus{}
 	for _, fsEntry := range entries {
 		nextHops := fsEntry.GetNextHops()
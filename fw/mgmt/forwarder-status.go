@@ -11,9 +11,7 @@ import (
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/fw"
-	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 	"github.com/named-data/ndnd/std/utils"
@@ -70,11 +68,11 @@ func (f *ForwarderStatusModule) general(interest *Interest) {
 		NfdVersion:       utils.NDNdVersion,
 		StartTimestamp:   time.Duration(core.StartTimestamp.UnixNano()),
 		CurrentTimestamp: time.Duration(time.Now().UnixNano()),
-		NFibEntries:      uint64(table.FibStrategyTable.GetNumFIBEntries()),
+		NFibEntries:      uint64(f.manager.fib.GetNumFIBEntries()),
 	}
 	// Don't set NNameTreeEntries because we don't use a NameTree
 	for threadID := 0; threadID < fw.CfgNumThreads(); threadID++ {
-		thread := dispatch.GetFWThread(threadID)
+		thread := f.manager.fw.GetFWThread(threadID)
 		counters := thread.Counters()
 
 		status.NPitEntries += uint64(counters.NPitEntries)
@@ -0,0 +1,142 @@
+package mgmt
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// Number of recent notifications kept for consumers that fall behind
+const notificationHistory = 16
+
+// notificationStream publishes notifications under a name prefix following the
+// NFD notification stream protocol. Each notification is a Data packet named
+// <prefix>/seq=<seq>. An Interest for the prefix waits for the next notification,
+// and an Interest for a sequence number waits until it is published.
+type notificationStream struct {
+	manager *Thread
+	prefix  enc.Name
+	// replies to an Interest with a notification
+	send func(interest *Interest, wire enc.Wire)
+
+	mutex sync.Mutex
+	// sequence number of the last notification
+	seq uint64
+	// recent notifications by sequence number
+	recent map[uint64]enc.Wire
+	// Interests waiting for a notification
+	pending []pendingNotification
+}
+
+type pendingNotification struct {
+	interest *Interest
+	// requested sequence number (zero for the next notification)
+	seq uint64
+	// expiration time of the Interest
+	expiry time.Time
+}
+
+// newNotificationStream creates a notification stream under a name prefix.
+func newNotificationStream(manager *Thread, prefix enc.Name) *notificationStream {
+	return &notificationStream{
+		manager: manager,
+		prefix:  prefix,
+		send: func(interest *Interest, wire enc.Wire) {
+			manager.transport.Send(&spec.LpPacket{
+				Fragment:      wire,
+				PitToken:      interest.pitToken,
+				NextHopFaceId: interest.inFace,
+			})
+		},
+		recent: make(map[uint64]enc.Wire),
+	}
+}
+
+// Returns the string representation of the stream, for logging.
+func (s *notificationStream) String() string {
+	return "mgmt-notification"
+}
+
+// handleInterest replies with a published notification, or holds the Interest
+// until the requested notification is published.
+func (s *notificationStream) handleInterest(interest *Interest) {
+	name := interest.Name()
+	seq := uint64(0)
+	if len(name) > len(s.prefix) {
+		if !name[len(s.prefix)].IsSequenceNum() {
+			core.Log.Debug(s, "Invalid notification Interest", "name", name)
+			return
+		}
+		seq = name[len(s.prefix)].NumberVal()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Drop expired Interests, which are otherwise kept until the next notification
+	s.prune(time.Now())
+
+	if seq != 0 {
+		if wire, ok := s.recent[seq]; ok {
+			s.send(interest, wire)
+			return
+		}
+		if seq <= s.seq {
+			return // too old, the consumer restarts from the next notification
+		}
+	}
+
+	lifetime := interest.Lifetime().GetOr(4 * time.Second)
+	s.pending = append(s.pending, pendingNotification{
+		interest: interest,
+		seq:      seq,
+		expiry:   time.Now().Add(lifetime),
+	})
+}
+
+// publish sends a new notification to all waiting consumers.
+func (s *notificationStream) publish(content enc.Wire) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.seq++
+	name := s.prefix.Append(enc.NewSequenceNumComponent(s.seq))
+	data, err := spec.Spec{}.MakeData(name,
+		&ndn.DataConfig{
+			ContentType: optional.Some(ndn.ContentTypeBlob),
+			Freshness:   optional.Some(time.Second),
+		},
+		content,
+		s.manager.signer,
+	)
+	if err != nil {
+		core.Log.Warn(s, "Unable to encode notification", "name", name, "err", err)
+		return
+	}
+
+	s.recent[s.seq] = data.Wire
+	delete(s.recent, s.seq-notificationHistory)
+
+	s.prune(time.Now())
+	s.pending = slices.DeleteFunc(s.pending, func(p pendingNotification) bool {
+		if p.seq == 0 || p.seq == s.seq {
+			s.send(p.interest, data.Wire)
+			return true
+		}
+		return false
+	})
+}
+
+// prune drops the pending Interests that have expired.
+// This function must be called with the stream lock held.
+func (s *notificationStream) prune(now time.Time) {
+	s.pending = slices.DeleteFunc(s.pending, func(p pendingNotification) bool {
+		return now.After(p.expiry)
+	})
+}
@@ -0,0 +1,91 @@
+package mgmt
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/security/signer"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates a notification stream that records the names of its replies by Interest.
+func makeTestStream() (*notificationStream, map[*Interest][]enc.Name) {
+	prefix, _ := enc.NameFromStr("/localhost/nfd/faces/events")
+	s := newNotificationStream(&Thread{signer: signer.NewSha256Signer()}, prefix)
+	replies := make(map[*Interest][]enc.Name)
+	s.send = func(interest *Interest, wire enc.Wire) {
+		data, _, err := spec.Spec{}.ReadData(enc.NewWireView(wire))
+		if err == nil {
+			replies[interest] = append(replies[interest], data.Name())
+		}
+	}
+	return s, replies
+}
+
+// Makes an Interest for a notification, or for the next one if seq is zero.
+func makeTestInterest(s *notificationStream, seq uint64, lifetime time.Duration) *Interest {
+	name := s.prefix
+	if seq > 0 {
+		name = name.Append(enc.NewSequenceNumComponent(seq))
+	}
+	return &Interest{Interest: spec.Interest{
+		NameV:             name,
+		InterestLifetimeV: optional.Some(lifetime),
+	}}
+}
+
+func TestNotificationStream(t *testing.T) {
+	s, replies := makeTestStream()
+	seqName := func(seq uint64) enc.Name {
+		return s.prefix.Append(enc.NewSequenceNumComponent(seq))
+	}
+
+	// Interests wait for the next or the requested notification
+	next := makeTestInterest(s, 0, time.Second)
+	second := makeTestInterest(s, 2, time.Second)
+	s.handleInterest(next)
+	s.handleInterest(second)
+	assert.Len(t, s.pending, 2)
+
+	s.publish(enc.Wire{[]byte{1}})
+	assert.Equal(t, []enc.Name{seqName(1)}, replies[next])
+	assert.Empty(t, replies[second])
+	assert.Len(t, s.pending, 1)
+
+	s.publish(enc.Wire{[]byte{2}})
+	assert.Equal(t, []enc.Name{seqName(2)}, replies[second])
+	assert.Empty(t, s.pending)
+
+	// Recent notifications are replied immediately
+	first := makeTestInterest(s, 1, time.Second)
+	s.handleInterest(first)
+	assert.Equal(t, []enc.Name{seqName(1)}, replies[first])
+	assert.Empty(t, s.pending)
+
+	// Invalid names are ignored
+	invalid := makeTestInterest(s, 0, time.Second)
+	invalid.NameV = s.prefix.Append(enc.NewGenericComponent("x"))
+	s.handleInterest(invalid)
+	assert.Empty(t, s.pending)
+
+	// Expired Interests are dropped when the next Interest arrives
+	s.handleInterest(makeTestInterest(s, 0, time.Millisecond))
+	s.pending[0].expiry = time.Now().Add(-time.Second)
+	s.handleInterest(makeTestInterest(s, 5, time.Second))
+	assert.Len(t, s.pending, 1)
+	assert.Equal(t, uint64(5), s.pending[0].seq)
+
+	// Notifications older than the history are not waited for
+	for range notificationHistory {
+		s.publish(enc.Wire{[]byte{3}})
+	}
+	assert.Empty(t, s.pending)
+	old := makeTestInterest(s, 2, time.Second)
+	s.handleInterest(old)
+	assert.Empty(t, replies[old])
+	assert.Empty(t, s.pending)
+	assert.Len(t, s.recent, notificationHistory)
+}
@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/face"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
@@ -80,7 +79,7 @@ func (r *RIBModule) register(interest *Interest) {
 	faceID := interest.inFace.Unwrap()
 	if fid, ok := params.FaceId.Get(); ok && fid != 0 {
 		faceID = fid
-		if face.FaceTable.Get(faceID) == nil {
+		if r.manager.faces.Get(faceID) == nil {
 			r.manager.sendCtrlResp(interest, 410, "Face does not exist", nil)
 			return
 		}
@@ -96,7 +95,7 @@ func (r *RIBModule) register(interest *Interest) {
 		*expirationPeriod = time.Duration(expiry) * time.Millisecond
 	}
 
-	table.Rib.AddEncRoute(params.Name, &table.Route{
+	r.manager.rib.AddEncRoute(params.Name, &table.Route{
 		FaceID:           faceID,
 		Origin:           origin,
 		Cost:             cost,
@@ -147,7 +146,7 @@ func (r *RIBModule) unregister(interest *Interest) {
 	}
 
 	origin := params.Origin.GetOr(uint64(mgmt.RouteOriginApp))
-	table.Rib.RemoveRouteEnc(params.Name, faceID, origin)
+	r.manager.rib.RemoveRouteEnc(params.Name, faceID, origin)
 
 	r.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
 		Name:   params.Name,
@@ -191,7 +190,7 @@ func (r *RIBModule) list(interest *Interest) {
 	}
 
 	// Generate new dataset
-	entries := table.Rib.GetAllEntries()
+	entries := r.manager.rib.GetAllEntries()
 	dataset := &mgmt.RibStatus{}
 	for _, entry := range entries {
 		ribEntry := &mgmt.RibEntry{
@@ -11,7 +11,6 @@ import (
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/fw"
-	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
@@ -131,7 +130,7 @@ func (s *StrategyChoiceModule) set(interest *Interest) {
 		params.Strategy.Name = params.Strategy.Name.
 			Append(enc.NewVersionComponent(strategyVersion))
 	}
-	table.FibStrategyTable.SetStrategyEnc(params.Name, params.Strategy.Name)
+	s.manager.fib.SetStrategyEnc(params.Name, params.Strategy.Name)
 
 	s.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
 		Name:     params.Name,
@@ -164,7 +163,7 @@ func (s *StrategyChoiceModule) unset(interest *Interest) {
 		return
 	}
 
-	table.FibStrategyTable.UnSetStrategyEnc(params.Name)
+	s.manager.fib.UnSetStrategyEnc(params.Name)
 	core.Log.Info(s, "Unset Strategy", "name", params.Name)
 
 	s.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{Name: params.Name})
@@ -179,7 +178,7 @@ func (s *StrategyChoiceModule) list(interest *Interest) {
 
 	// Generate new dataset
 	// TODO: For thread safety, we should lock the Strategy table from writes until we are done
-	entries := table.FibStrategyTable.GetAllForwardingStrategies()
+	entries := s.manager.fib.GetAllForwardingStrategies()
 	choices := []*mgmt.StrategyChoice{}
 	for _, fsEntry := range entries {
 		choices = append(choices, &mgmt.StrategyChoice{
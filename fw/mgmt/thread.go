@@ -13,6 +13,7 @@ import (
 
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/face"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -39,6 +40,11 @@ type Thread struct {
 	store  ndn.Store
 	objDir *storage.MemoryFifoDir
 	signer ndn.Signer
+
+	faces *face.Table
+	fib   table.FibStrategy
+	rib   *table.RibTable
+	fw    dispatch.FWDispatch
 }
 
 // Returns "mgmt" as the string representation of the Thread.
@@ -46,14 +52,25 @@ func (m *Thread) String() string {
 	return "mgmt"
 }
 
-// MakeMgmtThread creates a new management thread.
-func MakeMgmtThread() *Thread {
+// MakeMgmtThread creates a new management thread for the forwarder
+// with the given face table, FIB, RIB and forwarding threads.
+func MakeMgmtThread(
+	faces *face.Table,
+	fib table.FibStrategy,
+	rib *table.RibTable,
+	fw dispatch.FWDispatch,
+) *Thread {
 	m := &Thread{
 		modules: make(map[string]Module),
 		timer:   basic_engine.NewTimer(),
 		store:   storage.NewMemoryStore(),
 		objDir:  storage.NewMemoryFifoDir(32),
 		signer:  signer.NewSha256Signer(),
+
+		faces: faces,
+		fib:   fib,
+		rib:   rib,
+		fw:    fw,
 	}
 
 	m.registerModule("cs", new(ContentStoreModule))
@@ -66,7 +83,7 @@ func MakeMgmtThread() *Thread {
 	// readvertisers run in the management thread for ease of
 	// implementation, since they use the internal transport
 	if core.C.Tables.Rib.ReadvertiseNlsr {
-		table.AddReadvertiser(NewNlsrReadvertiser(m))
+		m.rib.AddReadvertiser(NewNlsrReadvertiser(m))
 	}
 
 	return m
@@ -83,10 +100,10 @@ func (m *Thread) Run() {
 	core.Log.Info(m, "Starting management thread")
 
 	// Create and register Internal transport
-	m.face, m.transport = face.RegisterInternalTransport()
-	table.FibStrategyTable.InsertNextHopEnc(LOCAL_PREFIX, m.face.FaceID(), 0)
+	m.face, m.transport = face.RegisterInternalTransport(m.faces)
+	m.fib.InsertNextHopEnc(LOCAL_PREFIX, m.face.FaceID(), 0)
 	if core.C.Mgmt.AllowLocalhop {
-		table.FibStrategyTable.InsertNextHopEnc(NON_LOCAL_PREFIX, m.face.FaceID(), 0)
+		m.fib.InsertNextHopEnc(NON_LOCAL_PREFIX, m.face.FaceID(), 0)
 	}
 
 	for {
@@ -22,23 +22,13 @@ var mutCfg = struct {
 	csServe    atomic.Bool
 }{}
 
-// Initialize creates tables and configuration.
+// Initialize creates the configuration of the tables.
 func Initialize() {
 	// Content Store
 	mutCfg.csCapacity.Store(int32(core.C.Tables.ContentStore.Capacity))
 	mutCfg.csAdmit.Store(core.C.Tables.ContentStore.Admit)
 	mutCfg.csServe.Store(core.C.Tables.ContentStore.Serve)
 
-	// Create FIB strategy table
-	switch core.C.Tables.Fib.Algorithm {
-	case "hashtable":
-		newFibStrategyTableHashTable(core.C.Tables.Fib.Hashtable.M)
-	case "nametree":
-		newFibStrategyTableTree()
-	default:
-		core.Log.Fatal(nil, "Unknown FIB table algorithm", "algo", core.C.Tables.Fib.Algorithm)
-	}
-
 	// Create Network Region Table
 	for _, region := range core.C.Tables.NetworkRegion.Regions {
 		name, err := enc.NameFromStr(region)
@@ -50,6 +40,20 @@ func Initialize() {
 	}
 }
 
+// NewFibStrategyTable creates a FIB-strategy table with the configured algorithm.
+// Each forwarder has its own table, which is shared across its forwarding threads.
+func NewFibStrategyTable() FibStrategy {
+	switch core.C.Tables.Fib.Algorithm {
+	case "hashtable":
+		return newFibStrategyTableHashTable(core.C.Tables.Fib.Hashtable.M)
+	case "nametree":
+		return newFibStrategyTableTree()
+	default:
+		core.Log.Fatal(nil, "Unknown FIB table algorithm", "algo", core.C.Tables.Fib.Algorithm)
+		return nil
+	}
+}
+
 // CfgCsAdmit returns whether contents will be admitted to the Content Store.
 func CfgCsAdmit() bool {
 	return mutCfg.csAdmit.Load()
@@ -52,9 +52,8 @@ type FibStrategyHashTable struct {
 
 // newFibStrategyTableHashTable creates a new FIB with the hash table algorithm.
 // The argument m determines the virtual name length.
-func newFibStrategyTableHashTable(m uint16) {
-	FibStrategyTable = new(FibStrategyHashTable)
-	fibStrategyTableHashTable := FibStrategyTable.(*FibStrategyHashTable)
+func newFibStrategyTableHashTable(m uint16) FibStrategy {
+	fibStrategyTableHashTable := new(FibStrategyHashTable)
 
 	fibStrategyTableHashTable.m = int(m) // Cast to int so that it's easy to pass to name.Prefix
 	fibStrategyTableHashTable.realTable = make(map[uint64]*baseFibStrategyEntry)
@@ -65,6 +64,8 @@ func newFibStrategyTableHashTable(m uint16) {
 	rtEntry.name = enc.Name{}
 	rtEntry.strategy = defn.DEFAULT_STRATEGY
 	fibStrategyTableHashTable.realTable[enc.Name{}.Hash()] = rtEntry
+
+	return fibStrategyTableHashTable
 }
 
 // findLongestPrefixMatch returns the entry corresponding to the longest
@@ -17,22 +17,22 @@ import (
 
 // Tests the functionality of the FibStrategyTable's FindNextHopsEnc method, including inserting, removing, and querying next hops for different names, verifying longest prefix matching and pruning of entries.
 func TestFindNextHopsEncEnc_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
+	fib := newFibStrategyTableHashTable(1)
 
-	assert.NotNil(t, FibStrategyTable)
+	assert.NotNil(t, fib)
 
 	// Root entry has no hops
 	name1, _ := enc.NameFromStr("/")
-	nexthops1 := FibStrategyTable.FindNextHopsEnc(name1)
+	nexthops1 := fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nexthops1))
 
 	// Next hops need to be explicitly added
 	name2, _ := enc.NameFromStr("/test")
-	nexthops2 := FibStrategyTable.FindNextHopsEnc(name2)
+	nexthops2 := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 0, len(nexthops2))
-	FibStrategyTable.InsertNextHopEnc(name2, 25, 1)
-	FibStrategyTable.InsertNextHopEnc(name2, 101, 10)
-	nexthops2a := FibStrategyTable.FindNextHopsEnc(name2)
+	fib.InsertNextHopEnc(name2, 25, 1)
+	fib.InsertNextHopEnc(name2, 101, 10)
+	nexthops2a := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 2, len(nexthops2a))
 	assert.Equal(t, uint64(25), nexthops2a[0].Nexthop)
 	assert.Equal(t, uint64(1), nexthops2a[0].Cost)
@@ -42,18 +42,18 @@ func TestFindNextHopsEncEnc_HT(t *testing.T) {
 	// Check longest prefix match, should match with /test
 	// and then return its next hops
 	name3, _ := enc.NameFromStr("/test/name/202=abc123")
-	nexthops3 := FibStrategyTable.FindNextHopsEnc(name3)
+	nexthops3 := fib.FindNextHopsEnc(name3)
 	assert.Equal(t, 2, len(nexthops3))
 	assert.Equal(t, uint64(25), nexthops3[0].Nexthop)
 	assert.Equal(t, uint64(1), nexthops3[0].Cost)
 	assert.Equal(t, uint64(101), nexthops3[1].Nexthop)
 	assert.Equal(t, uint64(10), nexthops3[1].Cost)
-	nexthops1a := FibStrategyTable.FindNextHopsEnc(name1)
+	nexthops1a := fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nexthops1a))
 
 	// Next hops should be updated when they're removed
-	FibStrategyTable.RemoveNextHopEnc(name2, 25)
-	nexthops2b := FibStrategyTable.FindNextHopsEnc(name2)
+	fib.RemoveNextHopEnc(name2, 25)
+	nexthops2b := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 1, len(nexthops2b))
 	assert.Equal(t, uint64(101), nexthops2b[0].Nexthop)
 	assert.Equal(t, uint64(10), nexthops2b[0].Cost)
@@ -61,68 +61,68 @@ func TestFindNextHopsEncEnc_HT(t *testing.T) {
 	// Test pruning
 	name4, _ := enc.NameFromStr("/test4")
 	name5, _ := enc.NameFromStr("/test5")
-	FibStrategyTable.InsertNextHopEnc(name4, 25, 1)
-	FibStrategyTable.InsertNextHopEnc(name5, 25, 1)
+	fib.InsertNextHopEnc(name4, 25, 1)
+	fib.InsertNextHopEnc(name5, 25, 1)
 
-	FibStrategyTable.RemoveNextHopEnc(name4, 25)
-	nexthops2c := FibStrategyTable.FindNextHopsEnc(name4)
+	fib.RemoveNextHopEnc(name4, 25)
+	nexthops2c := fib.FindNextHopsEnc(name4)
 	assert.Equal(t, 0, len(nexthops2c))
 }
 
 // This function tests the functionality of the FibStrategyTable's strategy management operations (finding, setting, unsetting) using a hash table, verifying correct strategy inheritance, updates, and pruning behavior for hierarchical names.
 func TestFind_Set_Unset_Strategy_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
+	fib := newFibStrategyTableHashTable(1)
 
-	assert.NotNil(t, FibStrategyTable)
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
 
 	name1, _ := enc.NameFromStr("/")
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
 
 	name2, _ := enc.NameFromStr("/test")
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	FibStrategyTable.SetStrategyEnc(name2, multicast)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name2)))
+	fib.SetStrategyEnc(name2, multicast)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
 
 	name3, _ := enc.NameFromStr("/test/name/202=abc123")
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
-	FibStrategyTable.SetStrategyEnc(name3, bestRoute)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name3)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
+	fib.SetStrategyEnc(name3, bestRoute)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name3)))
 
 	// Test pruning
-	FibStrategyTable.UnSetStrategyEnc(name3)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
-
-	FibStrategyTable.SetStrategyEnc(name1, multicast)
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
+	fib.UnSetStrategyEnc(name3)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
+
+	fib.SetStrategyEnc(name1, multicast)
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
 }
 
 // Tests the insertion and cost update of next-hop entries in the FIB strategy table for a given name, ensuring new nexthops are added and existing ones are updated correctly.
 func TestInsertNextHopEnc_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(1)
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test/name")
 
 	// Insert new hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	fib.InsertNextHopEnc(name, 100, 10)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, uint64(100), nextHops[0].Nexthop)
 	assert.Equal(t, uint64(10), nextHops[0].Cost)
 
 	// Update cost of current hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 20)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.InsertNextHopEnc(name, 100, 20)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, uint64(100), nextHops[0].Nexthop)
 	assert.NotEqual(t, uint64(10), nextHops[0].Cost)
@@ -131,54 +131,54 @@ func TestInsertNextHopEnc_HT(t *testing.T) {
 
 // Tests the `ClearNextHopsEnc` method of the FIB strategy table by verifying it removes all next hops for a given name, leaves unrelated entries untouched, and ensures only exact name matches are cleared without affecting longer prefixes.
 func TestClearNextHops_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(1)
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test/name")
 
 	// Insert new hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
-	FibStrategyTable.InsertNextHopEnc(name, 100, 20)
-	FibStrategyTable.InsertNextHopEnc(name, 200, 10)
-	FibStrategyTable.InsertNextHopEnc(name, 300, 10)
+	fib.InsertNextHopEnc(name, 100, 10)
+	fib.InsertNextHopEnc(name, 100, 20)
+	fib.InsertNextHopEnc(name, 200, 10)
+	fib.InsertNextHopEnc(name, 300, 10)
 
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 3, len(nextHops))
 
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
 	// Should have no effect on a name with no hops
 	// Or an nonexistent name
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
 	nameDoesNotExist, _ := enc.NameFromStr("/asdf")
-	FibStrategyTable.ClearNextHopsEnc(nameDoesNotExist)
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameDoesNotExist)
+	fib.ClearNextHopsEnc(nameDoesNotExist)
+	nextHops = fib.FindNextHopsEnc(nameDoesNotExist)
 	assert.Equal(t, 0, len(nextHops))
 
 	// Should only clear hops for exact match
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
+	fib.InsertNextHopEnc(name, 100, 10)
 	nameLonger, _ := enc.NameFromStr("/test/name/longer")
-	FibStrategyTable.InsertNextHopEnc(nameLonger, 200, 10)
+	fib.InsertNextHopEnc(nameLonger, 200, 10)
 
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameLonger)
+	nextHops = fib.FindNextHopsEnc(nameLonger)
 	assert.Equal(t, 1, len(nextHops))
 }
 
 // This function tests the insertion, removal, and lookup of next-hop entries in the FibStrategyTable for NDN, verifying correct behavior when adding, updating, and removing next-hop routes for specific names, including ensuring removals do not affect other name entries.
 func TestRemoveNextHopEnc_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(1)
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test")
 
@@ -186,41 +186,41 @@ func TestRemoveNextHopEnc_HT(t *testing.T) {
 	hopId1 := uint64(100)
 	hopId2 := uint64(200)
 	hopId3 := uint64(300)
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId2, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId3, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 20) // updates it in place
+	fib.InsertNextHopEnc(name, hopId1, 10)
+	fib.InsertNextHopEnc(name, hopId2, 10)
+	fib.InsertNextHopEnc(name, hopId3, 10)
+	fib.InsertNextHopEnc(name, hopId1, 20) // updates it in place
 
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 3, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId1)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId1)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 2, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId2)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId2)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId3)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId3)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 10)
+	fib.InsertNextHopEnc(name, hopId1, 10)
 	nameLonger, _ := enc.NameFromStr("/test/name/longer")
-	FibStrategyTable.InsertNextHopEnc(nameLonger, hopId2, 10)
+	fib.InsertNextHopEnc(nameLonger, hopId2, 10)
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId1)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId1)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameLonger)
+	nextHops = fib.FindNextHopsEnc(nameLonger)
 	assert.Equal(t, 1, len(nextHops))
 }
 
 // Tests the `GetAllFIBEntries` method by constructing a hash table-based FIB strategy table, populating it with entries having different strategies and next hops, and verifying that the returned entries correctly include strategies, next hops, and exclude entries with neither.
 func TestGetAllFIBEntries_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(1)
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
@@ -230,19 +230,19 @@ func TestGetAllFIBEntries_HT(t *testing.T) {
 
 	// Only strategy, no next hops, so it shouldn't be returned
 	name, _ := enc.NameFromStr("/test")
-	FibStrategyTable.SetStrategyEnc(name, multicast)
+	fib.SetStrategyEnc(name, multicast)
 
 	name2, _ := enc.NameFromStr("/test/name/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name2, bestRoute)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId2, 20)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId3, 30)
+	fib.SetStrategyEnc(name2, bestRoute)
+	fib.InsertNextHopEnc(name2, hopId2, 20)
+	fib.InsertNextHopEnc(name2, hopId3, 30)
 
 	// name3 has no strategy
 	name3, _ := enc.NameFromStr("/test/name_second/202=abc123")
-	FibStrategyTable.InsertNextHopEnc(name3, hopId3, 40)
-	FibStrategyTable.InsertNextHopEnc(name3, hopId3, 50)
+	fib.InsertNextHopEnc(name3, hopId3, 40)
+	fib.InsertNextHopEnc(name3, hopId3, 50)
 
-	fse := FibStrategyTable.GetAllFIBEntries()
+	fse := fib.GetAllFIBEntries()
 	assert.Equal(t, 2, len(fse))
 
 	sort.Slice(fse, func(i, j int) bool {
@@ -270,8 +270,8 @@ func TestGetAllFIBEntries_HT(t *testing.T) {
 
 // Constructs a test scenario to verify that `GetAllForwardingStrategies` correctly collects all names with assigned forwarding strategies (including the root default strategy) along with their associated next-hop information, excluding names without explicitly set strategies.
 func TestGetAllForwardingStrategies_HT(t *testing.T) {
-	newFibStrategyTableHashTable(1)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(1)
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
@@ -281,17 +281,17 @@ func TestGetAllForwardingStrategies_HT(t *testing.T) {
 
 	// No strategy, so it shouldn't be included
 	name, _ := enc.NameFromStr("/test")
-	FibStrategyTable.InsertNextHopEnc(name, hopId2, 20)
+	fib.InsertNextHopEnc(name, hopId2, 20)
 
 	name2, _ := enc.NameFromStr("/test/name/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name2, bestRoute)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId2, 20)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId3, 30)
+	fib.SetStrategyEnc(name2, bestRoute)
+	fib.InsertNextHopEnc(name2, hopId2, 20)
+	fib.InsertNextHopEnc(name2, hopId3, 30)
 
 	name3, _ := enc.NameFromStr("/test/name_second/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name3, multicast)
+	fib.SetStrategyEnc(name3, multicast)
 
-	fse := FibStrategyTable.GetAllForwardingStrategies()
+	fse := fib.GetAllForwardingStrategies()
 	// Here, the "/" has a default strategy, bestRoute in this case
 	assert.Equal(t, 3, len(fse))
 
@@ -322,8 +322,8 @@ func TestGetAllForwardingStrategies_HT(t *testing.T) {
 // This function tests the hash table-based Forwarding Information Base (FIB) implementation by inserting, querying, and removing entries with various names and next-hops, verifying correct longest-prefix matching, multiple next-hop handling, and pruning behavior.
 func testFIB_HT_Details(t *testing.T, m uint16) {
 	// A test suite specific to the hash table approach
-	newFibStrategyTableHashTable(m)
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableHashTable(m)
+	assert.NotNil(t, fib)
 
 	name1, _ := enc.NameFromStr("/a")
 	name2, _ := enc.NameFromStr("/a/b/d")
@@ -341,31 +341,31 @@ func testFIB_HT_Details(t *testing.T, m uint16) {
 
 	cost := uint64(1)
 
-	FibStrategyTable.InsertNextHopEnc(name1, hopId1, cost)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId2, cost)
-	FibStrategyTable.InsertNextHopEnc(name3, hopId3, cost)
-	FibStrategyTable.InsertNextHopEnc(name4, hopId4a, cost)
-	FibStrategyTable.InsertNextHopEnc(name4, hopId4b, cost)
-	FibStrategyTable.InsertNextHopEnc(name5, hopId5, cost)
+	fib.InsertNextHopEnc(name1, hopId1, cost)
+	fib.InsertNextHopEnc(name2, hopId2, cost)
+	fib.InsertNextHopEnc(name3, hopId3, cost)
+	fib.InsertNextHopEnc(name4, hopId4a, cost)
+	fib.InsertNextHopEnc(name4, hopId4b, cost)
+	fib.InsertNextHopEnc(name5, hopId5, cost)
 
-	nextHopsEmpty := FibStrategyTable.FindNextHopsEnc(nameNonexistent)
+	nextHopsEmpty := fib.FindNextHopsEnc(nameNonexistent)
 	assert.Equal(t, 0, len(nextHopsEmpty))
 
 	nameSearch1, _ := enc.NameFromStr("/a/b/c/d/e/f")
 	// match /a/b/c
-	nextHops1 := FibStrategyTable.FindNextHopsEnc(nameSearch1)
+	nextHops1 := fib.FindNextHopsEnc(nameSearch1)
 	assert.Equal(t, 1, len(nextHops1))
 	assert.Equal(t, hopId3, nextHops1[0].Nexthop)
 	assert.Equal(t, cost, nextHops1[0].Cost)
 
 	nameSearch2, _ := enc.NameFromStr("/a/c/d/e/f/g")
 	// match /a
-	nextHops2 := FibStrategyTable.FindNextHopsEnc(nameSearch2)
+	nextHops2 := fib.FindNextHopsEnc(nameSearch2)
 	assert.Equal(t, 1, len(nextHops2))
 	assert.Equal(t, hopId1, nextHops2[0].Nexthop)
 	assert.Equal(t, cost, nextHops2[0].Cost)
 
-	nextHops3 := FibStrategyTable.FindNextHopsEnc(name1)
+	nextHops3 := fib.FindNextHopsEnc(name1)
 	// match /a
 	assert.Equal(t, 1, len(nextHops3))
 	assert.Equal(t, hopId1, nextHops3[0].Nexthop)
@@ -373,7 +373,7 @@ func testFIB_HT_Details(t *testing.T, m uint16) {
 
 	nameSearch3, _ := enc.NameFromStr("/a/b/d/e/zzz")
 	// match /a/b/d/e
-	nextHops4 := FibStrategyTable.FindNextHopsEnc(nameSearch3)
+	nextHops4 := fib.FindNextHopsEnc(nameSearch3)
 	assert.Equal(t, 2, len(nextHops4))
 	assert.Equal(t, hopId4a, nextHops4[0].Nexthop)
 	assert.Equal(t, cost, nextHops4[0].Cost)
@@ -382,92 +382,92 @@ func testFIB_HT_Details(t *testing.T, m uint16) {
 
 	nameSearch4, _ := enc.NameFromStr("/a/b/d/e/f/g/h")
 	// match /a/b/d/e/f
-	nextHops5 := FibStrategyTable.FindNextHopsEnc(nameSearch4)
+	nextHops5 := fib.FindNextHopsEnc(nameSearch4)
 	assert.Equal(t, 1, len(nextHops5))
 	assert.Equal(t, hopId5, nextHops5[0].Nexthop)
 	assert.Equal(t, cost, nextHops5[0].Cost)
 
 	nameSearch5, _ := enc.NameFromStr("/b")
 	// match nothing
-	nextHops6 := FibStrategyTable.FindNextHopsEnc(nameSearch5)
+	nextHops6 := fib.FindNextHopsEnc(nameSearch5)
 	assert.Equal(t, 0, len(nextHops6))
 
 	nameSearch6, _ := enc.NameFromStr("/z/y/x/w/v/u/t")
 	// match nothing
-	nextHops7 := FibStrategyTable.FindNextHopsEnc(nameSearch6)
+	nextHops7 := fib.FindNextHopsEnc(nameSearch6)
 	assert.Equal(t, 0, len(nextHops7))
 
 	// Deletions
 	// Delete nonexistent name or face
-	FibStrategyTable.RemoveNextHopEnc(nameNonexistent, uint64(0))
-	FibStrategyTable.RemoveNextHopEnc(name1, uint64(0))
+	fib.RemoveNextHopEnc(nameNonexistent, uint64(0))
+	fib.RemoveNextHopEnc(name1, uint64(0))
 
 	// Delete with name and outFace that exists
-	FibStrategyTable.RemoveNextHopEnc(name4, hopId4b)
-	nextHops := FibStrategyTable.FindNextHopsEnc(name4)
+	fib.RemoveNextHopEnc(name4, hopId4b)
+	nextHops := fib.FindNextHopsEnc(name4)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, hopId4a, nextHops4[0].Nexthop)
 	assert.Equal(t, cost, nextHops4[0].Cost)
 
-	FibStrategyTable.RemoveNextHopEnc(name4, hopId4a)
+	fib.RemoveNextHopEnc(name4, hopId4a)
 	// This should only match name2 now: /a/b/d
-	nextHops = FibStrategyTable.FindNextHopsEnc(name4)
+	nextHops = fib.FindNextHopsEnc(name4)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, hopId2, nextHops[0].Nexthop)
 	assert.Equal(t, cost, nextHops[0].Cost)
 
-	FibStrategyTable.RemoveNextHopEnc(name3, hopId3)
+	fib.RemoveNextHopEnc(name3, hopId3)
 	// This should only match name1 now: /a
-	nextHops = FibStrategyTable.FindNextHopsEnc(name3)
+	nextHops = fib.FindNextHopsEnc(name3)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, hopId1, nextHops[0].Nexthop)
 	assert.Equal(t, cost, nextHops[0].Cost)
 
 	// This should trigger pruning
-	FibStrategyTable.RemoveNextHopEnc(name5, hopId5)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name5)
+	fib.RemoveNextHopEnc(name5, hopId5)
+	nextHops = fib.FindNextHopsEnc(name5)
 	// This should only match name2 now: /a/b/d
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, hopId2, nextHops[0].Nexthop)
 	assert.Equal(t, cost, nextHops[0].Cost)
 
-	FibStrategyTable.RemoveNextHopEnc(name1, hopId1)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name1)
+	fib.RemoveNextHopEnc(name1, hopId1)
+	nextHops = fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nextHops))
 
-	nextHops = FibStrategyTable.FindNextHopsEnc(name2)
+	nextHops = fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, hopId2, nextHops[0].Nexthop)
 	assert.Equal(t, cost, nextHops[0].Cost)
 
-	FibStrategyTable.RemoveNextHopEnc(name2, hopId2)
+	fib.RemoveNextHopEnc(name2, hopId2)
 
 	// No entries left in FIB, everything should be removed
-	nextHops = FibStrategyTable.FindNextHopsEnc(name1)
+	nextHops = fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(name2)
+	nextHops = fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(name3)
+	nextHops = fib.FindNextHopsEnc(name3)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(name4)
+	nextHops = fib.FindNextHopsEnc(name4)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(name5)
+	nextHops = fib.FindNextHopsEnc(name5)
 	assert.Equal(t, 0, len(nextHops))
 
 	// Eliminate the root name from tree too
 	// This results in an empty hash table
 	rootName, _ := enc.NameFromStr("/")
-	FibStrategyTable.UnSetStrategyEnc(rootName)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name1)
+	fib.UnSetStrategyEnc(rootName)
+	nextHops = fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(name5)
+	nextHops = fib.FindNextHopsEnc(name5)
 	assert.Equal(t, 0, len(nextHops))
-	strategy := FibStrategyTable.FindStrategyEnc(name1)
+	strategy := fib.FindStrategyEnc(name1)
 	assert.Nil(t, strategy)
 
 	// Nexthop entry exists but not root strategy
-	FibStrategyTable.InsertNextHopEnc(name1, hopId1, cost)
-	strategy = FibStrategyTable.FindStrategyEnc(name1)
+	fib.InsertNextHopEnc(name1, hopId1, cost)
+	strategy = fib.FindStrategyEnc(name1)
 	assert.Nil(t, strategy)
 }
 // This function tests the Forwarding Information Base (FIB) hashtable implementation by iterating through different `m` values (1 to 7), verifying correct behavior for real and virtual nodes under varying hashtable configurations.
@@ -33,15 +33,16 @@ type FibStrategyTree struct {
 }
 
 // Initializes a FibStrategyTree with a root entry having an empty component, default strategy, and empty name, forming the basis for hierarchical name-based strategy lookups.
-func newFibStrategyTableTree() {
-	FibStrategyTable = new(FibStrategyTree)
-	tree := FibStrategyTable.(*FibStrategyTree)
+func newFibStrategyTableTree() FibStrategy {
+	tree := new(FibStrategyTree)
 
 	// Root component will be empty
 	tree.root = new(fibStrategyTreeEntry)
 	tree.root.component = enc.Component{}
 	tree.root.strategy = defn.DEFAULT_STRATEGY
 	tree.root.name = enc.Name{}
+
+	return tree
 }
 
 // findExactMatchEntry returns the entry corresponding to the exact match of
@@ -47,9 +47,6 @@ type FibStrategy interface {
 	GetAllForwardingStrategies() []FibStrategyEntry
 }
 
-// FibStrategy is a table containing FIB and Strategy entries for given prefixes.
-var FibStrategyTable FibStrategy
-
 // Name returns the name associated with the baseFibStrategyEntry.
 func (e *baseFibStrategyEntry) Name() enc.Name {
 	return e.name
@@ -18,22 +18,22 @@ import (
 
 // Tests the functionality of finding next hops in the FIB strategy table by verifying insertion, longest prefix matching, removal, and pruning of next-hop entries for various NDN names.
 func TestNdnFindNextHops(t *testing.T) {
-	newFibStrategyTableTree()
+	fib := newFibStrategyTableTree()
 
-	assert.NotNil(t, FibStrategyTable)
+	assert.NotNil(t, fib)
 
 	// Root entry has no hops
 	name1, _ := enc.NameFromStr("/")
-	nexthops1 := FibStrategyTable.FindNextHopsEnc(name1)
+	nexthops1 := fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nexthops1))
 
 	// Next hops need to be explicitly added
 	name2, _ := enc.NameFromStr("/test")
-	nexthops2 := FibStrategyTable.FindNextHopsEnc(name2)
+	nexthops2 := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 0, len(nexthops2))
-	FibStrategyTable.InsertNextHopEnc(name2, 25, 1)
-	FibStrategyTable.InsertNextHopEnc(name2, 101, 10)
-	nexthops2a := FibStrategyTable.FindNextHopsEnc(name2)
+	fib.InsertNextHopEnc(name2, 25, 1)
+	fib.InsertNextHopEnc(name2, 101, 10)
+	nexthops2a := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 2, len(nexthops2a))
 	assert.Equal(t, uint64(25), nexthops2a[0].Nexthop)
 	assert.Equal(t, uint64(1), nexthops2a[0].Cost)
@@ -43,18 +43,18 @@ func TestNdnFindNextHops(t *testing.T) {
 	// Check longest prefix match, should match with /test
 	// and then return its next hops
 	name3, _ := enc.NameFromStr("/test/name/202=abc123")
-	nexthops3 := FibStrategyTable.FindNextHopsEnc(name3)
+	nexthops3 := fib.FindNextHopsEnc(name3)
 	assert.Equal(t, 2, len(nexthops3))
 	assert.Equal(t, uint64(25), nexthops3[0].Nexthop)
 	assert.Equal(t, uint64(1), nexthops3[0].Cost)
 	assert.Equal(t, uint64(101), nexthops3[1].Nexthop)
 	assert.Equal(t, uint64(10), nexthops3[1].Cost)
-	nexthops1a := FibStrategyTable.FindNextHopsEnc(name1)
+	nexthops1a := fib.FindNextHopsEnc(name1)
 	assert.Equal(t, 0, len(nexthops1a))
 
 	// Next hops should be updated when they're removed
-	FibStrategyTable.RemoveNextHopEnc(name2, 25)
-	nexthops2b := FibStrategyTable.FindNextHopsEnc(name2)
+	fib.RemoveNextHopEnc(name2, 25)
+	nexthops2b := fib.FindNextHopsEnc(name2)
 	assert.Equal(t, 1, len(nexthops2b))
 	assert.Equal(t, uint64(101), nexthops2b[0].Nexthop)
 	assert.Equal(t, uint64(10), nexthops2b[0].Cost)
@@ -62,68 +62,68 @@ func TestNdnFindNextHops(t *testing.T) {
 	// Test pruning
 	name4, _ := enc.NameFromStr("/test4")
 	name5, _ := enc.NameFromStr("/test5")
-	FibStrategyTable.InsertNextHopEnc(name4, 25, 1)
-	FibStrategyTable.InsertNextHopEnc(name5, 25, 1)
+	fib.InsertNextHopEnc(name4, 25, 1)
+	fib.InsertNextHopEnc(name5, 25, 1)
 
-	FibStrategyTable.RemoveNextHopEnc(name4, 25)
-	nexthops2c := FibStrategyTable.FindNextHopsEnc(name4)
+	fib.RemoveNextHopEnc(name4, 25)
+	nexthops2c := fib.FindNextHopsEnc(name4)
 	assert.Equal(t, 0, len(nexthops2c))
 }
 
 // This function tests the correctness of setting, unsetting, and retrieving naming strategies in the FIB strategy table, including inheritance from parent names and pruning of explicit strategy entries.
 func TestNdnFind_Set_Unset_Strategy(t *testing.T) {
-	newFibStrategyTableTree()
+	fib := newFibStrategyTableTree()
 
-	assert.NotNil(t, FibStrategyTable)
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
 
 	name1, _ := enc.NameFromStr("/")
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
 
 	name2, _ := enc.NameFromStr("/test")
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	FibStrategyTable.SetStrategyEnc(name2, multicast)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name2)))
+	fib.SetStrategyEnc(name2, multicast)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
 
 	name3, _ := enc.NameFromStr("/test/name/202=abc123")
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
-	FibStrategyTable.SetStrategyEnc(name3, bestRoute)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name3)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
+	fib.SetStrategyEnc(name3, bestRoute)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name3)))
 
 	// Test pruning
-	FibStrategyTable.UnSetStrategyEnc(name3)
-	assert.True(t, bestRoute.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
-
-	FibStrategyTable.SetStrategyEnc(name1, multicast)
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name1)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name2)))
-	assert.True(t, multicast.Equal(FibStrategyTable.FindStrategyEnc(name3)))
+	fib.UnSetStrategyEnc(name3)
+	assert.True(t, bestRoute.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
+
+	fib.SetStrategyEnc(name1, multicast)
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name1)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name2)))
+	assert.True(t, multicast.Equal(fib.FindStrategyEnc(name3)))
 }
 
 // Tests the insertion and cost update of next hop entries for a given name in the FIB strategy table.
 func TestNdnInsertNextHop(t *testing.T) {
-	newFibStrategyTableTree()
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableTree()
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test/name")
 
 	// Insert new hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	fib.InsertNextHopEnc(name, 100, 10)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, uint64(100), nextHops[0].Nexthop)
 	assert.Equal(t, uint64(10), nextHops[0].Cost)
 
 	// Update cost of current hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 20)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.InsertNextHopEnc(name, 100, 20)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 	assert.Equal(t, uint64(100), nextHops[0].Nexthop)
 	assert.NotEqual(t, uint64(10), nextHops[0].Cost)
@@ -132,54 +132,54 @@ func TestNdnInsertNextHop(t *testing.T) {
 
 // This function tests the `ClearNextHopsEnc` method of the FIB strategy table, verifying that it removes all next-hop entries for a specified name while leaving unrelated entries intact and handling edge cases like empty or non-existent names.
 func TestNdnClearNextHops(t *testing.T) {
-	newFibStrategyTableTree()
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableTree()
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test/name")
 
 	// Insert new hop
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
-	FibStrategyTable.InsertNextHopEnc(name, 100, 20)
-	FibStrategyTable.InsertNextHopEnc(name, 200, 10)
-	FibStrategyTable.InsertNextHopEnc(name, 300, 10)
+	fib.InsertNextHopEnc(name, 100, 10)
+	fib.InsertNextHopEnc(name, 100, 20)
+	fib.InsertNextHopEnc(name, 200, 10)
+	fib.InsertNextHopEnc(name, 300, 10)
 
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 3, len(nextHops))
 
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
 	// Should have no effect on a name with no hops
 	// Or an nonexistent name
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
 	nameDoesNotExist, _ := enc.NameFromStr("/asdf")
-	FibStrategyTable.ClearNextHopsEnc(nameDoesNotExist)
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameDoesNotExist)
+	fib.ClearNextHopsEnc(nameDoesNotExist)
+	nextHops = fib.FindNextHopsEnc(nameDoesNotExist)
 	assert.Equal(t, 0, len(nextHops))
 
 	// Should only clear hops for exact match
-	FibStrategyTable.InsertNextHopEnc(name, 100, 10)
+	fib.InsertNextHopEnc(name, 100, 10)
 	nameLonger, _ := enc.NameFromStr("/test/name/longer")
-	FibStrategyTable.InsertNextHopEnc(nameLonger, 200, 10)
+	fib.InsertNextHopEnc(nameLonger, 200, 10)
 
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
-	FibStrategyTable.ClearNextHopsEnc(name)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.ClearNextHopsEnc(name)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameLonger)
+	nextHops = fib.FindNextHopsEnc(nameLonger)
 	assert.Equal(t, 1, len(nextHops))
 }
 
 // Tests the removal of next-hop entries from the FIB strategy table, verifying correct deletion of specific next hops, handling of duplicate entries with different costs, and ensuring removal operations are scoped to the exact name without affecting other entries.
 func TestNdnRemoveNextHop(t *testing.T) {
-	newFibStrategyTableTree()
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableTree()
+	assert.NotNil(t, fib)
 
 	name, _ := enc.NameFromStr("/test")
 
@@ -187,41 +187,41 @@ func TestNdnRemoveNextHop(t *testing.T) {
 	hopId1 := uint64(100)
 	hopId2 := uint64(200)
 	hopId3 := uint64(300)
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId2, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId3, 10)
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 20) // updates it in place
+	fib.InsertNextHopEnc(name, hopId1, 10)
+	fib.InsertNextHopEnc(name, hopId2, 10)
+	fib.InsertNextHopEnc(name, hopId3, 10)
+	fib.InsertNextHopEnc(name, hopId1, 20) // updates it in place
 
-	nextHops := FibStrategyTable.FindNextHopsEnc(name)
+	nextHops := fib.FindNextHopsEnc(name)
 	assert.Equal(t, 3, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId1)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId1)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 2, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId2)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId2)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 1, len(nextHops))
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId3)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId3)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
 
-	FibStrategyTable.InsertNextHopEnc(name, hopId1, 10)
+	fib.InsertNextHopEnc(name, hopId1, 10)
 	nameLonger, _ := enc.NameFromStr("/test/name/longer")
-	FibStrategyTable.InsertNextHopEnc(nameLonger, hopId2, 10)
+	fib.InsertNextHopEnc(nameLonger, hopId2, 10)
 
-	FibStrategyTable.RemoveNextHopEnc(name, hopId1)
-	nextHops = FibStrategyTable.FindNextHopsEnc(name)
+	fib.RemoveNextHopEnc(name, hopId1)
+	nextHops = fib.FindNextHopsEnc(name)
 	assert.Equal(t, 0, len(nextHops))
-	nextHops = FibStrategyTable.FindNextHopsEnc(nameLonger)
+	nextHops = fib.FindNextHopsEnc(nameLonger)
 	assert.Equal(t, 1, len(nextHops))
 }
 
 // Retrieves all FIB entries with next hop information, including their associated strategies and next hop details, and verifies that entries with strategies and next hops, as well as those with next hops alone, are correctly included and ordered by name.
 func TestNdnGetAllFIBEntries(t *testing.T) {
-	newFibStrategyTableTree()
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableTree()
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
@@ -231,19 +231,19 @@ func TestNdnGetAllFIBEntries(t *testing.T) {
 
 	// Only strategy, no next hops, so it shouldn't be returned
 	name, _ := enc.NameFromStr("/test")
-	FibStrategyTable.SetStrategyEnc(name, multicast)
+	fib.SetStrategyEnc(name, multicast)
 
 	name2, _ := enc.NameFromStr("/test/name/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name2, bestRoute)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId2, 20)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId3, 30)
+	fib.SetStrategyEnc(name2, bestRoute)
+	fib.InsertNextHopEnc(name2, hopId2, 20)
+	fib.InsertNextHopEnc(name2, hopId3, 30)
 
 	// name3 has no strategy
 	name3, _ := enc.NameFromStr("/test/name_second/202=abc123")
-	FibStrategyTable.InsertNextHopEnc(name3, hopId3, 40)
-	FibStrategyTable.InsertNextHopEnc(name3, hopId3, 50)
+	fib.InsertNextHopEnc(name3, hopId3, 40)
+	fib.InsertNextHopEnc(name3, hopId3, 50)
 
-	fse := FibStrategyTable.GetAllFIBEntries()
+	fse := fib.GetAllFIBEntries()
 	assert.Equal(t, 2, len(fse))
 
 	sort.Slice(fse, func(i, j int) bool {
@@ -271,8 +271,8 @@ func TestNdnGetAllFIBEntries(t *testing.T) {
 
 // This function tests the `GetAllForwardingStrategies` method by constructing a FibStrategyTable with multiple entries (including default and custom strategies) and verifying that it correctly returns all registered forwarding strategies along with their associated names and next-hop information.
 func TestNdnGetAllForwardingStrategies(t *testing.T) {
-	newFibStrategyTableTree()
-	assert.NotNil(t, FibStrategyTable)
+	fib := newFibStrategyTableTree()
+	assert.NotNil(t, fib)
 
 	bestRoute, _ := enc.NameFromStr("/localhost/nfd/strategy/best-route/v=1")
 	multicast, _ := enc.NameFromStr("/localhost/nfd/strategy/multicast/v=1")
@@ -282,17 +282,17 @@ func TestNdnGetAllForwardingStrategies(t *testing.T) {
 
 	// No strategy, so it shouldn't be included
 	name, _ := enc.NameFromStr("/test")
-	FibStrategyTable.InsertNextHopEnc(name, hopId2, 20)
+	fib.InsertNextHopEnc(name, hopId2, 20)
 
 	name2, _ := enc.NameFromStr("/test/name/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name2, bestRoute)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId2, 20)
-	FibStrategyTable.InsertNextHopEnc(name2, hopId3, 30)
+	fib.SetStrategyEnc(name2, bestRoute)
+	fib.InsertNextHopEnc(name2, hopId2, 20)
+	fib.InsertNextHopEnc(name2, hopId3, 30)
 
 	name3, _ := enc.NameFromStr("/test/name_second/202=abc123")
-	FibStrategyTable.SetStrategyEnc(name3, multicast)
+	fib.SetStrategyEnc(name3, multicast)
 
-	fse := FibStrategyTable.GetAllForwardingStrategies()
+	fse := fib.GetAllForwardingStrategies()
 	// Here, the "/" has a default strategy, bestRoute in this case
 	assert.Equal(t, 3, len(fse))
 
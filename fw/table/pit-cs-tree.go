@@ -323,9 +323,12 @@ func (p *pitCsTreeNode) getChildrenCount() int {
 // Prunes empty nodes from the tree structure by removing them from their parent and returning them to a pool, starting from the current node and traversing upward until a non-empty node is encountered.
 func (p *pitCsTreeNode) pruneIfEmpty() {
 	for curNode := p; curNode.parent != nil && curNode.getChildrenCount() == 0 &&
-		len(curNode.pitEntries) == 0 && curNode.csEntry == nil; curNode = curNode.parent {
-		delete(curNode.parent.children, curNode.component.Hash())
+		len(curNode.pitEntries) == 0 && curNode.csEntry == nil; {
+		// the node may be reused as soon as it is back in the pool
+		parent := curNode.parent
+		delete(parent.children, curNode.component.Hash())
 		PitCsPools.PitCsTreeNode.Put(curNode)
+		curNode = parent
 	}
 }
 
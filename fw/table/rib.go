@@ -22,6 +22,11 @@ import (
 type RibTable struct {
 	root  RibEntry
 	mutex sync.RWMutex
+
+	// FIB updated with the nexthops of the RIB
+	fib FibStrategy
+	// readvertising instances
+	readvertisers []RibReadvertise
 }
 
 // RibEntry represents an entry in the RIB table.
@@ -45,11 +50,14 @@ type Route struct {
 	ExpirationPeriod *time.Duration
 }
 
-// Rib is the Routing Information Base.
-var Rib = RibTable{
-	root: RibEntry{
-		children: make(map[uint64]*RibEntry),
-	},
+// NewRib creates a Routing Information Base that installs its routes in the given FIB.
+func NewRib(fib FibStrategy) *RibTable {
+	return &RibTable{
+		root: RibEntry{
+			children: make(map[uint64]*RibEntry),
+		},
+		fib: fib,
+	}
 }
 
 // Constructs a path in the RIB tree by creating missing RibEntries for each component of the provided name, returning the deepest entry corresponding to the full name.
@@ -103,8 +111,8 @@ func (r *RibEntry) pruneIfEmpty() {
 }
 
 // updateNexthopsEnc recursively updates the FIB nexthops under this entry.
-func (r *RibEntry) updateNexthopsEnc() {
-	FibStrategyTable.ClearNextHopsEnc(r.Name)
+func (r *RibEntry) updateNexthopsEnc(fib FibStrategy) {
+	fib.ClearNextHopsEnc(r.Name)
 
 	if len(r.routes) > 0 {
 		// All routes including parents if needed
@@ -136,13 +144,13 @@ func (r *RibEntry) updateNexthopsEnc() {
 
 		// Add "flattened" set of nexthops
 		for nexthop, cost := range minCostRoutes {
-			FibStrategyTable.InsertNextHopEnc(r.Name, nexthop, cost)
+			fib.InsertNextHopEnc(r.Name, nexthop, cost)
 		}
 	}
 
 	// Trigger update for all children for inheritance
 	for _, child := range r.children {
-		child.updateNexthopsEnc()
+		child.updateNexthopsEnc(fib)
 	}
 }
 
@@ -153,8 +161,8 @@ func (r *RibTable) AddEncRoute(name enc.Name, route *Route) {
 
 	node := r.root.fillTreeToPrefixEnc(name)
 
-	defer node.updateNexthopsEnc()
-	defer readvertiseAnnounce(name, route)
+	defer node.updateNexthopsEnc(r.fib)
+	defer r.readvertiseAnnounce(name, route)
 
 	for _, existingRoute := range node.routes {
 		if existingRoute.FaceID == route.FaceID && existingRoute.Origin == route.Origin {
@@ -209,13 +217,13 @@ func (r *RibTable) RemoveRouteEnc(name enc.Name, faceID uint64, origin uint64) {
 				copy(entry.routes[i:], entry.routes[i+1:])
 			}
 			entry.routes = entry.routes[:len(entry.routes)-1]
-			readvertiseWithdraw(name, route)
+			r.readvertiseWithdraw(name, route)
 			break
 		}
 	}
 
 	entry.pruneIfEmpty()
-	entry.updateNexthopsEnc() // recursive
+	entry.updateNexthopsEnc(r.fib) // recursive
 }
 
 // Removes all entries associated with the specified face ID from the Routing Information Base (RIB) table, ensuring thread-safe traversal and cleanup of the routing data structure.
@@ -224,7 +232,7 @@ func (r *RibTable) CleanUpFace(faceId uint64) {
 	defer r.mutex.Unlock()
 
 	// This currently walks the entire tree, but this can be optimized if needed
-	r.root.cleanUpFace(faceId)
+	r.root.cleanUpFace(r, faceId)
 }
 
 // GetRoutes returns all routes in the RIB entry.
@@ -234,9 +242,9 @@ func (r *RibEntry) GetRoutes() []*Route {
 
 // CleanUpFace removes the specified face from all entries.
 // Used for clean-up after a face is destroyed.
-func (r *RibEntry) cleanUpFace(faceId uint64) {
+func (r *RibEntry) cleanUpFace(rib *RibTable, faceId uint64) {
 	for _, child := range r.children {
-		child.cleanUpFace(faceId)
+		child.cleanUpFace(rib, faceId)
 	}
 
 	for i, route := range r.routes {
@@ -245,11 +253,11 @@ func (r *RibEntry) cleanUpFace(faceId uint64) {
 				copy(r.routes[i:], r.routes[i+1:])
 			}
 			r.routes = r.routes[:len(r.routes)-1]
-			readvertiseWithdraw(r.Name, route)
+			rib.readvertiseWithdraw(r.Name, route)
 
 			// entry changed, check and update FIB
 			r.pruneIfEmpty()
-			r.updateNexthopsEnc() // recursive
+			r.updateNexthopsEnc(rib.fib) // recursive
 			return
 		}
 	}
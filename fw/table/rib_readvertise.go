@@ -2,9 +2,6 @@ package table
 
 import enc "github.com/named-data/ndnd/std/encoding"
 
-// Readvertising instances
-var readvertisers = make([]RibReadvertise, 0)
-
 type RibReadvertise interface {
 	// Advertise a route in the RIB
 	Announce(name enc.Name, route *Route)
@@ -13,20 +10,20 @@ type RibReadvertise interface {
 }
 
 // Registers a new route readvertiser to the list for propagating route updates.
-func AddReadvertiser(r RibReadvertise) {
-	readvertisers = append(readvertisers, r)
+func (r *RibTable) AddReadvertiser(readvertiser RibReadvertise) {
+	r.readvertisers = append(r.readvertisers, readvertiser)
 }
 
 // Announces the specified route for the given name to all registered readvertisers.
-func readvertiseAnnounce(name enc.Name, route *Route) {
-	for _, r := range readvertisers {
-		r.Announce(name, route)
+func (r *RibTable) readvertiseAnnounce(name enc.Name, route *Route) {
+	for _, readvertiser := range r.readvertisers {
+		readvertiser.Announce(name, route)
 	}
 }
 
 // Withdraws the specified route advertisement under the given name by notifying all registered readvertisers to remove the route.
-func readvertiseWithdraw(name enc.Name, route *Route) {
-	for _, r := range readvertisers {
-		r.Withdraw(name, route)
+func (r *RibTable) readvertiseWithdraw(name enc.Name, route *Route) {
+	for _, readvertiser := range r.readvertisers {
+		readvertiser.Withdraw(name, route)
 	}
 }
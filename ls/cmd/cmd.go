@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/named-data/ndnd/ls/config"
+	"github.com/named-data/ndnd/std/utils"
+	"github.com/named-data/ndnd/std/utils/toolutils"
+	"github.com/spf13/cobra"
+)
+
+var CmdLs = &cobra.Command{
+	Use:     "ndn-ls CONFIG-FILE",
+	Short:   "NDN Link State Routing Daemon",
+	GroupID: "run",
+	Version: utils.NDNdVersion,
+	Args:    cobra.ExactArgs(1),
+	Run:     run,
+}
+
+// Initializes an LsExecutor with a configuration loaded from a YAML file, starts it, and handles termination signals to ensure graceful shutdown.
+func run(cmd *cobra.Command, args []string) {
+	configfile := args[0]
+
+	config := struct {
+		Config *config.Config `json:"ls"`
+	}{
+		Config: config.DefaultConfig(),
+	}
+	toolutils.ReadYaml(&config, configfile)
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, os.Interrupt, syscall.SIGTERM)
+
+	lse, err := NewLsExecutor(config.Config)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		lse.Start()
+		sigchan <- syscall.SIGTERM
+	}()
+
+	// wait for interrupt
+	<-sigchan
+	lse.Stop()
+	<-sigchan
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/named-data/ndnd/ls/config"
+	"github.com/named-data/ndnd/ls/ls"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/ndn"
+)
+
+type LsExecutor struct {
+	engine ndn.Engine
+	router *ls.Router
+}
+
+// Constructs an LsExecutor instance by validating configuration, initializing an NDN engine with a default face, and creating a link-state router.
+func NewLsExecutor(config *config.Config) (*LsExecutor, error) {
+	lse := new(LsExecutor)
+
+	// Validate configuration sanity
+	err := config.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate ls config: %w", err)
+	}
+
+	// Start NDN engine
+	lse.engine = engine.NewBasicEngine(engine.NewDefaultFace())
+
+	// Create the LS router
+	lse.router, err = ls.NewRouter(config, lse.engine)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ls router: %w", err)
+	}
+
+	return lse, nil
+}
+
+// Starts the engine and the router, blocking until the router is stopped.
+func (lse *LsExecutor) Start() {
+	err := lse.engine.Start()
+	if err != nil {
+		panic(fmt.Errorf("failed to start ls engine: %w", err))
+	}
+	defer lse.engine.Stop()
+
+	err = lse.router.Start() // blocks forever
+	if err != nil {
+		panic(fmt.Errorf("failed to start ls router: %w", err))
+	}
+}
+
+// Stops the router associated with the LsExecutor by invoking its Stop method.
+func (lse *LsExecutor) Stop() {
+	lse.router.Stop()
+}
+
+// Returns the router instance associated with this LsExecutor.
+func (lse *LsExecutor) Router() *ls.Router {
+	return lse.router
+}
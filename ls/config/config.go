@@ -0,0 +1,331 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+)
+
+// DefaultLinkCost is the cost of a link without a configured cost.
+const DefaultLinkCost = float64(10)
+
+// NlsrOrigin is the origin to use for route registration.
+const NlsrOrigin = uint64(mgmt.RouteOriginNLSR)
+
+// SyncVersion is the version of the NLSR sync group.
+const SyncVersion = uint64(12)
+
+// LsaGracePeriod is added to the refresh time to get the lifetime of an LSA.
+const LsaGracePeriod = 10 * time.Second
+
+// Types of LSAs, as used in names.
+const (
+	LsaTypeName       = "NAME"
+	LsaTypeAdjacency  = "ADJACENCY"
+	LsaTypeCoordinate = "COORDINATE"
+)
+
+// RouterTag is the first component of a router name.
+var RouterTag = enc.NewGenericBytesComponent([]byte("\xc1.Router"))
+
+// OperatorTag is the first component of an operator name.
+var OperatorTag = enc.NewGenericBytesComponent([]byte("\xc1.Operator"))
+
+var MulticastStrategy = enc.LOCALHOST.
+	Append(enc.NewGenericComponent("nfd")).
+	Append(enc.NewGenericComponent("strategy")).
+	Append(enc.NewGenericComponent("multicast"))
+
+type Config struct {
+	// Network should be the same for all routers in the network.
+	Network string `json:"network"`
+	// Site of this router, under the network.
+	Site string `json:"site"`
+	// Router name under the site, starting with %C1.Router.
+	Router string `json:"router"`
+	// Period of hello Interests to each neighbor.
+	HelloInterval_ms uint64 `json:"hello_interval"`
+	// Lifetime of hello Interests.
+	HelloTimeout_ms uint64 `json:"hello_timeout"`
+	// Number of consecutive hello timeouts after which a neighbor is down.
+	HelloRetries uint64 `json:"hello_retries"`
+	// Delay before the adjacency LSA is built after a neighbor changes state.
+	AdjLsaBuildInterval_ms uint64 `json:"adj_lsa_build_interval"`
+	// Delay before the routing table is calculated after the LSDB changes.
+	RoutingCalcInterval_ms uint64 `json:"routing_calc_interval"`
+	// Period of refreshing the LSAs of this router.
+	LsaRefreshTime_ms uint64 `json:"lsa_refresh_time"`
+	// Maximum number of next hops installed for a destination (zero for all).
+	MaxFacesPerPrefix int `json:"max_faces_per_prefix"`
+	// URI specifying KeyChain location.
+	KeyChainUri string `json:"keychain"`
+	// List of trust anchor full names.
+	TrustAnchors []string `json:"trust_anchors"`
+	// List of neighbors.
+	Neighbors []Neighbor `json:"neighbors"`
+	// Name prefixes advertised by this router.
+	Advertising []string `json:"advertising"`
+	// Hyperbolic coordinates of this router.
+	Hyperbolic HyperbolicConfig `json:"hyperbolic"`
+
+	// Parsed network name
+	networkN enc.Name
+	// Parsed site name
+	siteN enc.Name
+	// Parsed router name under the site
+	routerN enc.Name
+	// Full name of the router
+	routerPrefixN enc.Name
+	// LSA prefix of the network
+	lsaPrefixN enc.Name
+	// Sync group prefix of the network
+	syncPrefixN enc.Name
+	// NLSR management prefix
+	mgmtPrefixN enc.Name
+	// Parsed advertised prefixes
+	advertisingN []enc.Name
+	// Trust anchor names
+	trustAnchorsN []enc.Name
+}
+
+type Neighbor struct {
+	// Full name of the neighbor router.
+	Name string `json:"name"`
+	// Remote URI of the neighbor.
+	Uri string `json:"uri"`
+	// Cost of the link (default 10).
+	Cost float64 `json:"cost"`
+	// MTU of the link face.
+	Mtu uint64 `json:"mtu"`
+
+	// FaceId of the neighbor.
+	FaceId uint64 `json:"-"`
+	// Whether this instance created this face
+	Created bool `json:"-"`
+
+	// Parsed name of the neighbor
+	nameN enc.Name
+}
+
+type HyperbolicConfig struct {
+	// Hyperbolic radius of the router.
+	Radius float64 `json:"radius"`
+	// Hyperbolic angles of the router. The coordinate LSA is published if set.
+	Angles []float64 `json:"angles"`
+}
+
+// Returns a default configuration with NLSR's default timers, and invalid network,
+// site and router names that must be set before use.
+func DefaultConfig() *Config {
+	return &Config{
+		Network:                "", // invalid
+		Site:                   "", // invalid
+		Router:                 "", // invalid
+		HelloInterval_ms:       60000,
+		HelloTimeout_ms:        1000,
+		HelloRetries:           3,
+		AdjLsaBuildInterval_ms: 10000,
+		RoutingCalcInterval_ms: 15000,
+		LsaRefreshTime_ms:      1800000,
+		MaxFacesPerPrefix:      0,
+		KeyChainUri:            "undefined",
+	}
+}
+
+// Parses and validates the configuration, and constructs the names of the
+// router, its LSAs and its sync group.
+func (c *Config) Parse() (err error) {
+	// Validate prefixes not empty
+	if c.Network == "" || c.Site == "" || c.Router == "" {
+		return fmt.Errorf("network, site and router must be set")
+	}
+
+	// Parse prefixes
+	c.networkN, err = enc.NameFromStr(c.Network)
+	if err != nil {
+		return err
+	}
+	c.siteN, err = enc.NameFromStr(c.Site)
+	if err != nil {
+		return err
+	}
+	c.routerN, err = enc.NameFromStr(c.Router)
+	if err != nil {
+		return err
+	}
+
+	// The trust schema finds the router by the %C1.Router component
+	if len(c.networkN) == 0 || len(c.siteN) == 0 {
+		return fmt.Errorf("network and site must not be empty")
+	}
+	if len(c.routerN) < 2 || !c.routerN[0].Equal(RouterTag) {
+		return fmt.Errorf("router name must start with %s", RouterTag)
+	}
+
+	// Validate intervals
+	if c.HelloInterval() < 1*time.Second {
+		return fmt.Errorf("HelloInterval must be at least 1 second")
+	}
+	if c.HelloTimeout() < 100*time.Millisecond || c.HelloTimeout() >= c.HelloInterval() {
+		return fmt.Errorf("HelloTimeout must be at least 100ms and less than HelloInterval")
+	}
+	if c.HelloRetries < 1 {
+		return fmt.Errorf("HelloRetries must be at least 1")
+	}
+	if c.LsaRefreshTime() < 10*time.Second {
+		return fmt.Errorf("LsaRefreshTime must be at least 10 seconds")
+	}
+	if c.MaxFacesPerPrefix < 0 {
+		return fmt.Errorf("MaxFacesPerPrefix must not be negative")
+	}
+
+	// Full name of the router
+	c.routerPrefixN = c.networkN.
+		Append(c.siteN...).
+		Append(c.routerN...)
+
+	// Validate neighbors
+	for i := range c.Neighbors {
+		n := &c.Neighbors[i]
+		n.nameN, err = enc.NameFromStr(n.Name)
+		if err != nil {
+			return err
+		}
+		if !c.networkN.IsPrefix(n.nameN) || n.nameN.Equal(c.routerPrefixN) {
+			return fmt.Errorf("neighbor %s must be another router in the network", n.Name)
+		}
+		if n.Uri == "" {
+			return fmt.Errorf("uri of neighbor %s must be set", n.Name)
+		}
+		if n.Cost == 0 {
+			n.Cost = DefaultLinkCost
+		}
+		if n.Cost < 0 {
+			return fmt.Errorf("cost of neighbor %s must be positive", n.Name)
+		}
+	}
+
+	// Validate advertised prefixes
+	c.advertisingN = make([]enc.Name, 0, len(c.Advertising))
+	for _, prefix := range c.Advertising {
+		name, err := enc.NameFromStr(prefix)
+		if err != nil {
+			return err
+		}
+		c.advertisingN = append(c.advertisingN, name)
+	}
+
+	// Validate trust anchors
+	c.trustAnchorsN = make([]enc.Name, 0, len(c.TrustAnchors))
+	for _, anchor := range c.TrustAnchors {
+		name, err := enc.NameFromStr(anchor)
+		if err != nil {
+			return err
+		}
+		c.trustAnchorsN = append(c.trustAnchorsN, name)
+	}
+
+	// LSA prefix of the network
+	c.lsaPrefixN = enc.LOCALHOP.
+		Append(c.networkN...).
+		Append(enc.NewGenericComponent("nlsr")).
+		Append(enc.NewGenericComponent("LSA"))
+
+	// Sync group prefix of the network
+	c.syncPrefixN = enc.LOCALHOP.
+		Append(c.networkN...).
+		Append(enc.NewGenericComponent("nlsr")).
+		Append(enc.NewGenericComponent("sync")).
+		Append(enc.NewVersionComponent(SyncVersion))
+
+	// Local prefix for readvertise and datasets
+	c.mgmtPrefixN = enc.LOCALHOST.
+		Append(enc.NewGenericComponent("nlsr"))
+
+	return nil
+}
+
+// Returns the network name stored in the configuration as an `enc.Name`.
+func (c *Config) NetworkName() enc.Name {
+	return c.networkN
+}
+
+// Returns the full name of the router, i.e. network, site and router.
+func (c *Config) RouterName() enc.Name {
+	return c.routerPrefixN
+}
+
+// Returns the prefix of the LSAs of all routers in the network.
+func (c *Config) LsaPrefix() enc.Name {
+	return c.lsaPrefixN
+}
+
+// Returns the sync prefix of the LSAs of this router, for an LSA type.
+func (c *Config) LsaSyncPrefix(typ string) enc.Name {
+	return c.LsaRouterPrefix(c.routerPrefixN).
+		Append(enc.NewGenericComponent(typ))
+}
+
+// Returns the prefix of the LSAs of a router in the network.
+func (c *Config) LsaRouterPrefix(router enc.Name) enc.Name {
+	return c.lsaPrefixN.Append(router[len(c.networkN):]...)
+}
+
+// Returns the prefix of the sync group of the network.
+func (c *Config) SyncPrefix() enc.Name {
+	return c.syncPrefixN
+}
+
+// Returns the prefix of hello Interests to a router.
+func (c *Config) HelloPrefix(router enc.Name) enc.Name {
+	return router.
+		Append(enc.NewGenericComponent("nlsr")).
+		Append(enc.NewGenericComponent("INFO"))
+}
+
+// Returns the management prefix configured for this instance.
+func (c *Config) MgmtPrefix() enc.Name {
+	return c.mgmtPrefixN
+}
+
+// Returns the name prefixes advertised by this router in the configuration.
+func (c *Config) AdvertisingNames() []enc.Name {
+	return c.advertisingN
+}
+
+// Returns the names of the trust anchors configured in this configuration.
+func (c *Config) TrustAnchorNames() []enc.Name {
+	return c.trustAnchorsN
+}
+
+// Returns the hello interval as a `time.Duration`.
+func (c *Config) HelloInterval() time.Duration {
+	return time.Duration(c.HelloInterval_ms) * time.Millisecond
+}
+
+// Returns the lifetime of hello Interests as a `time.Duration`.
+func (c *Config) HelloTimeout() time.Duration {
+	return time.Duration(c.HelloTimeout_ms) * time.Millisecond
+}
+
+// Returns the delay of building the adjacency LSA as a `time.Duration`.
+func (c *Config) AdjLsaBuildInterval() time.Duration {
+	return time.Duration(c.AdjLsaBuildInterval_ms) * time.Millisecond
+}
+
+// Returns the delay of the routing calculation as a `time.Duration`.
+func (c *Config) RoutingCalcInterval() time.Duration {
+	return time.Duration(c.RoutingCalcInterval_ms) * time.Millisecond
+}
+
+// Returns the LSA refresh period as a `time.Duration`.
+func (c *Config) LsaRefreshTime() time.Duration {
+	return time.Duration(c.LsaRefreshTime_ms) * time.Millisecond
+}
+
+// Returns the parsed name of the neighbor router.
+func (n *Neighbor) RouterName() enc.Name {
+	return n.nameN
+}
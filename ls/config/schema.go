@@ -0,0 +1,124 @@
+package config
+
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	sec "github.com/named-data/ndnd/std/security"
+	sig "github.com/named-data/ndnd/std/security/signer"
+)
+
+// Schema is the NLSR trust schema.
+//
+// LSAs and hello replies are signed by the NLSR key of the origin router,
+// /<network>/<site>/%C1.Router/<router>/nlsr. The NLSR key is signed by the
+// router key, the router key by an operator key of the same site
+// (/<network>/<site>/%C1.Operator/<operator>), the operator key by the site
+// key, and the site key by the network key, which is the trust anchor.
+type Schema struct {
+	config *Config
+}
+
+// Returns the NLSR trust schema for the network of the configuration.
+func (c *Config) TrustSchema() *Schema {
+	return &Schema{config: c}
+}
+
+// Checks if a packet can be signed with the provided certificate.
+func (s *Schema) Check(pkt enc.Name, cert enc.Name) bool {
+	signer, err := sec.GetIdentityFromCertName(cert)
+	if err != nil {
+		return false
+	}
+
+	required, exact := s.signerOf(pkt)
+	if required == nil {
+		return false
+	}
+	if exact {
+		return signer.Equal(required)
+	}
+	return len(signer) > len(required) && required.IsPrefix(signer)
+}
+
+// Suggests the certificate in the keychain to sign a packet with.
+func (s *Schema) Suggest(pkt enc.Name, keychain ndn.KeyChain) ndn.Signer {
+	for _, id := range keychain.Identities() {
+		for _, key := range id.Keys() {
+			for _, cert := range key.UniqueCerts() {
+				if s.Check(pkt, cert) {
+					return &sig.ContextSigner{
+						Signer:         key.Signer(),
+						KeyLocatorName: cert[:len(cert)-1], // remove version
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the identity that must sign a packet. If exact is false,
+// the signer can be any identity under the returned prefix.
+func (s *Schema) signerOf(pkt enc.Name) (required enc.Name, exact bool) {
+	network := s.config.NetworkName()
+	nlsr := enc.NewGenericComponent("nlsr")
+
+	// LSA: /localhop/<network>/nlsr/LSA/<site>/%C1.Router/<router>/<type>/<seq>
+	if lsaPrefix := s.config.LsaPrefix(); lsaPrefix.IsPrefix(pkt) {
+		rest := pkt[len(lsaPrefix):]
+		for i := len(rest) - 1; i > 0; i-- {
+			switch rest[i].String() {
+			case LsaTypeName, LsaTypeAdjacency, LsaTypeCoordinate:
+				router := network.Append(rest[:i]...)
+				if tagIndex(router, RouterTag) <= len(network) {
+					return nil, false
+				}
+				return router.Append(nlsr), true
+			}
+		}
+		return nil, false
+	}
+
+	if !network.IsPrefix(pkt) {
+		return nil, false
+	}
+
+	// Certificate: each key is signed by the key above it in the hierarchy
+	if id, err := sec.GetIdentityFromCertName(pkt); err == nil {
+		if i := tagIndex(id, RouterTag); i > len(network) {
+			if id[len(id)-1].Equal(nlsr) && i < len(id)-2 {
+				return id.Prefix(-1), true // NLSR key by router key
+			}
+			return id[:i].Append(OperatorTag), false // router key by operator key
+		}
+		if i := tagIndex(id, OperatorTag); i > len(network) {
+			return id[:i], true // operator key by site key
+		}
+		if len(id) > len(network) {
+			return network, true // site key by network key
+		}
+		return nil, false
+	}
+
+	// Hello reply: /<network>/<site>/%C1.Router/<router>/nlsr/INFO/...
+	for i := len(network); i < len(pkt)-1; i++ {
+		if pkt[i].Equal(nlsr) && pkt[i+1].String() == "INFO" {
+			if tagIndex(pkt[:i], RouterTag) <= len(network) {
+				return nil, false
+			}
+			return pkt[:i+1], true
+		}
+	}
+
+	return nil, false
+}
+
+// Returns the index of the tag component in a name, or -1 if not found.
+func tagIndex(name enc.Name, tag enc.Component) int {
+	for i, c := range name {
+		if c.Equal(tag) {
+			return i
+		}
+	}
+	return -1
+}
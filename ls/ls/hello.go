@@ -0,0 +1,202 @@
+package ls
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/ls/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// helloModule checks that neighbors are up with NLSR hello Interests.
+// A hello to a neighbor is /<neighbor>/nlsr/INFO/<router>, where the last
+// component is the TLV encoding of the name of the sending router.
+type helloModule struct {
+	// parent router
+	ls *Router
+	// neighbors by router name hash
+	neighbors map[uint64]*neighborState
+	// pending build of the adjacency LSA
+	build *time.Timer
+}
+
+// neighborState is the state of a configured neighbor.
+type neighborState struct {
+	// configuration of the neighbor
+	config *config.Neighbor
+	// whether the neighbor answers hellos
+	active bool
+	// number of consecutive hello timeouts
+	timeouts uint64
+}
+
+// Log identifier for the hello module.
+func (h *helloModule) String() string {
+	return "ls-hello"
+}
+
+// Sends a hello to each neighbor.
+func (h *helloModule) sendHellos() {
+	h.ls.mutex.Lock()
+	names := make([]enc.Name, 0, len(h.neighbors))
+	for _, ns := range h.neighbors {
+		names = append(names, ns.config.RouterName())
+	}
+	h.ls.mutex.Unlock()
+
+	for _, name := range names {
+		h.sendHello(name)
+	}
+}
+
+// Sends a hello to a neighbor, and updates its state with the result.
+func (h *helloModule) sendHello(neighbor enc.Name) {
+	name := h.ls.config.HelloPrefix(neighbor).
+		Append(enc.NewGenericBytesComponent(h.ls.config.RouterName().Bytes()))
+
+	h.ls.client.ExpressR(ndn.ExpressRArgs{
+		Name: name,
+		Config: &ndn.InterestConfig{
+			Lifetime:    optional.Some(h.ls.config.HelloTimeout()),
+			Nonce:       utils.ConvertNonce(h.ls.engine.Timer().Nonce()),
+			MustBeFresh: true,
+		},
+		Retries: 0,
+		Callback: func(args ndn.ExpressCallbackArgs) {
+			if args.Result != ndn.InterestResultData {
+				go h.onHelloResult(neighbor, false)
+				return
+			}
+
+			h.ls.client.Validate(args.Data, args.SigCovered, func(valid bool, err error) {
+				if !valid || err != nil {
+					log.Warn(h, "Failed to validate hello reply", "name", args.Data.Name(), "valid", valid, "err", err)
+					return
+				}
+				go h.onHelloResult(neighbor, true)
+			})
+		},
+	})
+}
+
+// Updates the state of a neighbor with the result of a hello.
+func (h *helloModule) onHelloResult(neighbor enc.Name, ok bool) {
+	h.ls.mutex.Lock()
+	defer h.ls.mutex.Unlock()
+
+	ns := h.neighbors[neighbor.Hash()]
+	if ns == nil {
+		return
+	}
+
+	if ok {
+		ns.timeouts = 0
+		if !ns.active {
+			log.Info(h, "Neighbor is up", "name", neighbor)
+			ns.active = true
+			h.scheduleBuild()
+		}
+		return
+	}
+
+	ns.timeouts++
+	log.Debug(h, "Hello timeout", "name", neighbor, "timeouts", ns.timeouts)
+	if ns.active && ns.timeouts >= h.ls.config.HelloRetries {
+		log.Info(h, "Neighbor is down", "name", neighbor)
+		ns.active = false
+		h.scheduleBuild()
+	}
+
+	// Retry before the next hello interval
+	if ns.timeouts < h.ls.config.HelloRetries {
+		go h.sendHello(neighbor)
+	}
+}
+
+// Replies to a hello from a neighbor.
+// If the neighbor is not up, a hello is sent back to speed up the adjacency.
+func (h *helloModule) onHello(args ndn.InterestHandlerArgs) {
+	iname := args.Interest.Name()
+	prefix := h.ls.config.HelloPrefix(h.ls.config.RouterName())
+	if len(iname) != len(prefix)+1 {
+		log.Warn(h, "Invalid hello Interest", "name", iname)
+		return
+	}
+
+	neighbor, err := enc.NameFromBytes(iname[len(prefix)].Val)
+	if err != nil {
+		log.Warn(h, "Invalid router name in hello", "name", iname, "err", err)
+		return
+	}
+
+	h.ls.mutex.Lock()
+	ns := h.neighbors[neighbor.Hash()]
+	active := ns != nil && ns.active
+	h.ls.mutex.Unlock()
+
+	if ns == nil {
+		log.Debug(h, "Hello from unknown neighbor", "name", neighbor)
+		return
+	}
+
+	signer := h.ls.client.SuggestSigner(iname)
+	if signer == nil {
+		log.Warn(h, "No signer found for hello reply", "name", iname)
+		return
+	}
+
+	cfg := &ndn.DataConfig{
+		ContentType: optional.Some(ndn.ContentTypeBlob),
+		Freshness:   optional.Some(1 * time.Second),
+	}
+	data, err := h.ls.engine.Spec().MakeData(iname, cfg, enc.Wire{[]byte("info")}, signer)
+	if err != nil {
+		log.Warn(h, "Failed to make hello reply", "err", err)
+		return
+	}
+	args.Reply(data.Wire)
+
+	if !active {
+		h.sendHello(neighbor)
+	}
+}
+
+// Schedules a build of the adjacency LSA after the build interval,
+// so that changes of several neighbors result in one LSA.
+// This function must be called with the router lock held.
+func (h *helloModule) scheduleBuild() {
+	if h.build != nil {
+		return
+	}
+	h.build = time.AfterFunc(h.ls.config.AdjLsaBuildInterval(), func() {
+		h.ls.mutex.Lock()
+		h.build = nil
+		h.ls.mutex.Unlock()
+
+		h.ls.lsa.publishAdjacency()
+	})
+}
+
+// Returns the neighbors that are up.
+// This function must be called with the router lock held.
+func (h *helloModule) activeNeighbors() []*config.Neighbor {
+	active := make([]*config.Neighbor, 0, len(h.neighbors))
+	for _, ns := range h.neighbors {
+		if ns.active {
+			active = append(active, ns.config)
+		}
+	}
+	return active
+}
+
+// Returns the face to a neighbor, or zero if the neighbor is unknown.
+// This function must be called with the router lock held.
+func (h *helloModule) faceId(neighbor enc.Name) uint64 {
+	if ns := h.neighbors[neighbor.Hash()]; ns != nil {
+		return ns.config.FaceId
+	}
+	return 0
+}
@@ -0,0 +1,285 @@
+package ls
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/ls/config"
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object/storage"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+)
+
+// Number of retries to fetch an LSA. A later sync update fetches it again.
+const lsaFetchRetries = 10
+
+// lsaModule publishes the LSAs of this router and fetches the LSAs of other routers.
+// The LSA of a router is /localhop/<network>/nlsr/LSA/<site>/<router>/<type>/<seq>,
+// and the sync group carries the latest sequence number of each router and type.
+type lsaModule struct {
+	// parent router
+	ls *Router
+	// sequence number of the LSAs of this router by type
+	seq map[string]uint64
+	// recently published LSAs
+	objDir *storage.MemoryFifoDir
+}
+
+// Log identifier for the LSA module.
+func (m *lsaModule) String() string {
+	return "ls-lsa"
+}
+
+// Returns the next sequence number of an LSA type.
+// Sequence numbers start from the current time in seconds, so that they keep
+// increasing across restarts of the router.
+// This function must be called with the router lock held.
+func (m *lsaModule) nextSeq(typ string) uint64 {
+	seq := max(m.seq[typ]+1, uint64(time.Now().Unix()))
+	m.seq[typ] = seq
+	return seq
+}
+
+// Returns a new LSA info of this router for an LSA type.
+// This function must be called with the router lock held.
+func (m *lsaModule) info(typ string) *tlv.Lsa {
+	expires := time.Now().Add(m.ls.config.LsaRefreshTime() + config.LsaGracePeriod)
+	return &tlv.Lsa{
+		OriginRouter:   m.ls.config.RouterName(),
+		SequenceNumber: m.nextSeq(typ),
+		ExpirationTime: tlv.FormatExpirationTime(expires),
+	}
+}
+
+// Builds and publishes the adjacency LSA with the neighbors that are up.
+func (m *lsaModule) publishAdjacency() {
+	m.ls.mutex.Lock()
+	lsa := &tlv.AdjacencyLsa{Lsa: m.info(config.LsaTypeAdjacency)}
+	for _, neighbor := range m.ls.hello.activeNeighbors() {
+		lsa.Adjacencies = append(lsa.Adjacencies, &tlv.Adjacency{
+			Name: neighbor.RouterName(),
+			Uri:  neighbor.Uri,
+			Cost: tlv.Double(neighbor.Cost),
+		})
+	}
+	if _, err := m.ls.lsdb.InstallAdjacency(lsa); err != nil {
+		log.Error(m, "Failed to install adjacency LSA", "err", err)
+	}
+	m.ls.scheduleCalc()
+	m.ls.mutex.Unlock()
+
+	log.Info(m, "Publish adjacency LSA", "seq", lsa.Lsa.SequenceNumber, "adjacencies", len(lsa.Adjacencies))
+	m.publish(config.LsaTypeAdjacency, lsa.Lsa.SequenceNumber, &tlv.LsdbStatus{AdjacencyLsas: []*tlv.AdjacencyLsa{lsa}})
+}
+
+// Builds and publishes the name LSA with the configured and readvertised prefixes.
+// Configured prefixes have no cost.
+func (m *lsaModule) publishName() {
+	m.ls.mutex.Lock()
+	lsa := &tlv.NameLsa{Lsa: m.info(config.LsaTypeName)}
+	for _, name := range m.ls.config.AdvertisingNames() {
+		lsa.Prefixes = append(lsa.Prefixes, &tlv.PrefixInfo{Name: name, Cost: tlv.Double(0)})
+	}
+	for _, prefix := range m.ls.prefixes {
+		lsa.Prefixes = append(lsa.Prefixes, &tlv.PrefixInfo{Name: prefix.name, Cost: tlv.Double(prefix.cost)})
+	}
+	if _, err := m.ls.lsdb.InstallName(lsa); err != nil {
+		log.Error(m, "Failed to install name LSA", "err", err)
+	}
+	m.ls.mutex.Unlock()
+
+	log.Info(m, "Publish name LSA", "seq", lsa.Lsa.SequenceNumber, "prefixes", len(lsa.Prefixes))
+	m.publish(config.LsaTypeName, lsa.Lsa.SequenceNumber, &tlv.LsdbStatus{NameLsas: []*tlv.NameLsa{lsa}})
+}
+
+// Builds and publishes the coordinate LSA, if the router has hyperbolic coordinates.
+func (m *lsaModule) publishCoordinate() {
+	hyperbolic := m.ls.config.Hyperbolic
+	if len(hyperbolic.Angles) == 0 {
+		return
+	}
+
+	m.ls.mutex.Lock()
+	lsa := &tlv.CoordinateLsa{
+		Lsa:              m.info(config.LsaTypeCoordinate),
+		HyperbolicRadius: tlv.Double(hyperbolic.Radius),
+	}
+	for _, angle := range hyperbolic.Angles {
+		lsa.HyperbolicAngles = append(lsa.HyperbolicAngles, tlv.Double(angle))
+	}
+	if _, err := m.ls.lsdb.InstallCoordinate(lsa); err != nil {
+		log.Error(m, "Failed to install coordinate LSA", "err", err)
+	}
+	m.ls.mutex.Unlock()
+
+	log.Info(m, "Publish coordinate LSA", "seq", lsa.Lsa.SequenceNumber)
+	m.publish(config.LsaTypeCoordinate, lsa.Lsa.SequenceNumber, &tlv.LsdbStatus{CoordinateLsas: []*tlv.CoordinateLsa{lsa}})
+}
+
+// Publishes all LSAs of this router with new sequence numbers, before they expire.
+func (m *lsaModule) refresh() {
+	m.publishAdjacency()
+	m.publishName()
+	m.publishCoordinate()
+}
+
+// Produces an LSA of this router and announces it in the sync group.
+// The content of LSA Data is the LSA with its type, as in NLSR,
+// which is the encoding of an LSDB dataset with only this LSA.
+func (m *lsaModule) publish(typ string, seq uint64, lsa *tlv.LsdbStatus) {
+	prefix := m.ls.config.LsaSyncPrefix(typ)
+	name, err := m.ls.client.Produce(ndn.ProduceArgs{
+		Name: prefix.
+			Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, seq)).
+			WithVersion(enc.VersionUnixMicro),
+		Content:         lsa.Encode(),
+		FreshnessPeriod: 10 * time.Second,
+		NoMetadata:      true,
+	})
+	if err != nil {
+		log.Error(m, "Failed to produce LSA", "err", err)
+		return
+	}
+
+	m.ls.mutex.Lock()
+	m.objDir.Push(name)
+	m.objDir.Evict(m.ls.client)
+	m.ls.mutex.Unlock()
+
+	if err := m.ls.sync.SetSeqNo(prefix, seq); err != nil {
+		log.Error(m, "Failed to announce LSA", "name", name, "err", err)
+	}
+}
+
+// Handles an update of the sync group, and fetches the LSA if it is newer.
+func (m *lsaModule) onSyncUpdate(su ndn_sync.SvSyncUpdate) {
+	// /localhop/<network>/nlsr/LSA/<site>/<router>/<type>
+	lsaPrefix := m.ls.config.LsaPrefix()
+	if len(su.Name) < len(lsaPrefix)+2 || !lsaPrefix.IsPrefix(su.Name) {
+		log.Debug(m, "Ignoring sync update", "name", su.Name)
+		return
+	}
+	typ := su.Name[len(su.Name)-1].String()
+	router := m.ls.config.NetworkName().Append(su.Name[len(lsaPrefix) : len(su.Name)-1]...)
+	if router.Equal(m.ls.config.RouterName()) {
+		return
+	}
+
+	m.fetch(router, typ, su.High, 0)
+}
+
+// Returns the sequence number of the installed LSA of a router.
+// This function must be called with the router lock held.
+func (m *lsaModule) installedSeq(router enc.Name, typ string) (uint64, bool) {
+	var lsa interface{ Info() *tlv.Lsa }
+	switch typ {
+	case config.LsaTypeAdjacency:
+		if l := m.ls.lsdb.Adjacency(router); l != nil {
+			lsa = l
+		}
+	case config.LsaTypeName:
+		if l := m.ls.lsdb.Name(router); l != nil {
+			lsa = l
+		}
+	case config.LsaTypeCoordinate:
+		if l := m.ls.lsdb.Coordinate(router); l != nil {
+			lsa = l
+		}
+	default:
+		return 0, false
+	}
+	if lsa == nil {
+		return 0, true
+	}
+	return lsa.Info().SequenceNumber, true
+}
+
+// Fetches an LSA of a router if it is newer than the installed LSA.
+// The fetch is retried while the LSA is the latest in the sync group.
+func (m *lsaModule) fetch(router enc.Name, typ string, seq uint64, attempt int) {
+	m.ls.mutex.Lock()
+	installed, ok := m.installedSeq(router, typ)
+	m.ls.mutex.Unlock()
+	if !ok {
+		log.Debug(m, "Unknown LSA type", "router", router, "type", typ)
+		return
+	}
+	if installed >= seq {
+		return
+	}
+
+	prefix := m.ls.config.LsaRouterPrefix(router).Append(enc.NewGenericComponent(typ))
+	if m.ls.sync.GetSeqNo(prefix) > seq {
+		return // a newer LSA is fetched instead
+	}
+
+	name := prefix.Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, seq))
+
+	m.ls.client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:       name,
+		NoMetadata: true,
+		Callback: func(state ndn.ConsumeState) {
+			if err := state.Error(); err != nil {
+				log.Warn(m, "Failed to fetch LSA", "name", name, "attempt", attempt, "err", err)
+				if attempt < lsaFetchRetries {
+					time.AfterFunc(1*time.Second, func() {
+						m.fetch(router, typ, seq, attempt+1)
+					})
+				}
+				return
+			}
+
+			go m.onLsa(router, typ, seq, state.Content())
+		},
+	})
+}
+
+// Installs a fetched LSA, and schedules a routing calculation if the routes may change.
+func (m *lsaModule) onLsa(router enc.Name, typ string, seq uint64, content enc.Wire) {
+	lsas, err := tlv.ParseLsdbStatus(enc.NewWireView(content), false)
+	if err != nil {
+		log.Warn(m, "Failed to parse LSA", "router", router, "type", typ, "err", err)
+		return
+	}
+
+	var info *tlv.Lsa
+	var install func() (bool, error)
+
+	switch {
+	case typ == config.LsaTypeAdjacency && len(lsas.AdjacencyLsas) == 1:
+		lsa := lsas.AdjacencyLsas[0]
+		info = lsa.Lsa
+		install = func() (bool, error) { return m.ls.lsdb.InstallAdjacency(lsa) }
+	case typ == config.LsaTypeName && len(lsas.NameLsas) == 1:
+		lsa := lsas.NameLsas[0]
+		info = lsa.Lsa
+		install = func() (bool, error) { return m.ls.lsdb.InstallName(lsa) }
+	case typ == config.LsaTypeCoordinate && len(lsas.CoordinateLsas) == 1:
+		lsa := lsas.CoordinateLsas[0]
+		info = lsa.Lsa
+		install = func() (bool, error) { return m.ls.lsdb.InstallCoordinate(lsa) }
+	}
+
+	// The LSA must match its name, which is covered by the signature
+	if info == nil || !info.OriginRouter.Equal(router) || info.SequenceNumber != seq {
+		log.Warn(m, "LSA does not match its name", "router", router, "type", typ, "seq", seq)
+		return
+	}
+
+	m.ls.mutex.Lock()
+	defer m.ls.mutex.Unlock()
+
+	installed, err := install()
+	if err != nil {
+		log.Warn(m, "Failed to install LSA", "router", router, "type", typ, "err", err)
+		return
+	}
+	if installed {
+		log.Info(m, "Installed LSA", "router", router, "type", typ, "seq", seq)
+		if typ != config.LsaTypeCoordinate {
+			m.ls.scheduleCalc()
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package ls
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/object"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// Handles management Interests under /localhost/nlsr, dispatching on the next
+// name component to the readvertise commands and the datasets.
+func (ls *Router) mgmtOnInterest(args ndn.InterestHandlerArgs) {
+	pfxLen := len(ls.config.MgmtPrefix())
+	name := args.Interest.Name()
+	if len(name) < pfxLen+1 {
+		log.Warn(ls, "Invalid management Interest", "name", name)
+		return
+	}
+
+	log.Trace(ls, "Received management Interest", "name", name)
+
+	// Segments of a dataset that was already produced
+	if segment, _ := ls.mgmtStore.Get(name, false); segment != nil {
+		args.Reply(enc.Wire{segment})
+		return
+	}
+
+	switch name[pfxLen].String() {
+	case "rib", "prefix-update":
+		ls.mgmtOnPrefix(args)
+	case "lsdb":
+		ls.mgmtOnLsdb(args)
+	case "routing-table":
+		ls.mgmtOnRoutingTable(args)
+	default:
+		log.Warn(ls, "Unknown management command", "name", name)
+	}
+}
+
+// Handles a readvertise command from the forwarder (rib/register and rib/unregister),
+// or a prefix command from an operator (prefix-update/advertise and prefix-update/withdraw).
+// Readvertised prefixes are published in the name LSA of this router, with the cost of the command.
+func (ls *Router) mgmtOnPrefix(args ndn.InterestHandlerArgs) {
+	res := &mgmt.ControlResponse{
+		Val: &mgmt.ControlResponseVal{
+			StatusCode: 400,
+			StatusText: "Failed to execute command",
+			Params:     nil,
+		},
+	}
+
+	defer func() {
+		signer := sig.NewSha256Signer()
+		data, err := ls.engine.Spec().MakeData(
+			args.Interest.Name(),
+			&ndn.DataConfig{
+				ContentType: optional.Some(ndn.ContentTypeBlob),
+				Freshness:   optional.Some(1 * time.Second),
+			},
+			res.Encode(),
+			signer)
+		if err != nil {
+			log.Warn(ls, "Failed to make prefix command response Data", "err", err)
+			return
+		}
+		args.Reply(data.Wire)
+	}()
+
+	// /localhost/nlsr/rib/register/<params>/params-sha256=...
+	// /localhost/nlsr/prefix-update/advertise/<params>/params-sha256=...
+	iname := args.Interest.Name()
+	if len(iname) < 5 {
+		log.Warn(ls, "Invalid prefix command Interest", "name", iname)
+		return
+	}
+
+	cmd, advC := iname[3], iname[4]
+	params, err := mgmt.ParseControlParameters(enc.NewBufferView(advC.Val), false)
+	if err != nil || params.Val == nil || params.Val.Name == nil {
+		log.Warn(ls, "Failed to parse prefix command name", "err", err)
+		return
+	}
+
+	name := params.Val.Name
+	face := params.Val.FaceId.GetOr(0)
+	cost := float64(params.Val.Cost.GetOr(0))
+
+	log.Debug(ls, "Received prefix command", "cmd", cmd, "name", name)
+
+	var changed bool
+	switch cmd.String() {
+	case "register", "advertise":
+		changed = ls.announcePrefix(name, face, cost)
+	case "unregister", "withdraw":
+		changed = ls.withdrawPrefix(name, face)
+	default:
+		log.Warn(ls, "Unknown prefix command", "cmd", cmd)
+		return
+	}
+	if changed {
+		go ls.lsa.publishName()
+	}
+
+	res.Val.StatusCode = 200
+	res.Val.StatusText = "Prefix command successful"
+	res.Val.Params = &mgmt.ControlArgs{
+		Name:   params.Val.Name,
+		FaceId: optional.Some(uint64(1)), // NFD compatibility
+		Origin: optional.Some(uint64(65)),
+	}
+}
+
+// Adds a readvertised prefix on a face, with the latest cost of the prefix.
+// Returns true if the name LSA changes.
+func (ls *Router) announcePrefix(name enc.Name, face uint64, cost float64) bool {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+
+	hash := name.Hash()
+	prefix := ls.prefixes[hash]
+	if prefix == nil {
+		prefix = &localPrefix{name: name.Clone(), cost: cost, faces: make(map[uint64]bool)}
+		ls.prefixes[hash] = prefix
+	}
+	prefix.faces[face] = true
+	if prefix.cost != cost {
+		prefix.cost = cost
+		return true
+	}
+	return len(prefix.faces) == 1
+}
+
+// Removes a readvertised prefix from a face. Returns true if the name LSA changes.
+func (ls *Router) withdrawPrefix(name enc.Name, face uint64) bool {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+
+	hash := name.Hash()
+	prefix := ls.prefixes[hash]
+	if prefix == nil {
+		return false
+	}
+	delete(prefix.faces, face)
+	if len(prefix.faces) > 0 {
+		return false
+	}
+	delete(ls.prefixes, hash)
+	return true
+}
+
+// Replies to a dataset Interest with the first segment of a new version of the dataset.
+// Other segments are served from the management store.
+func (ls *Router) mgmtSendDataset(args ndn.InterestHandlerArgs, name enc.Name, dataset enc.Wire) {
+	objName, err := object.Produce(ndn.ProduceArgs{
+		Name:            name.WithVersion(enc.VersionUnixMicro),
+		Content:         dataset,
+		FreshnessPeriod: time.Millisecond,
+		NoMetadata:      true,
+	}, ls.mgmtStore, sig.NewSha256Signer())
+	if err != nil {
+		log.Warn(ls, "Unable to produce dataset", "err", err)
+		return
+	}
+	ls.mgmtDir.Push(objName)
+
+	// Evict oldest object if we have too many
+	if old := ls.mgmtDir.Pop(); old != nil {
+		if err := ls.mgmtStore.RemovePrefix(old); err != nil {
+			log.Warn(ls, "Unable to clean up old dataset", "err", err)
+		}
+	}
+
+	segment, err := ls.mgmtStore.Get(objName.Append(enc.NewSegmentComponent(0)), false)
+	if err != nil || segment == nil {
+		log.Warn(ls, "Unable to get first segment of dataset", "err", err)
+		return
+	}
+	args.Reply(enc.Wire{segment})
+}
+
+// Serves the LSDB dataset. The LSAs of one type are served
+// under /lsdb/adjacencies, /lsdb/names and /lsdb/coordinates.
+func (ls *Router) mgmtOnLsdb(args ndn.InterestHandlerArgs) {
+	pfxLen := len(ls.config.MgmtPrefix())
+	name := args.Interest.Name()
+
+	ls.mutex.Lock()
+	status := ls.lsdb.Status()
+	ls.mutex.Unlock()
+
+	dsName := name[:pfxLen+1]
+	if len(name) > pfxLen+1 && name[pfxLen+1].Typ == enc.TypeGenericNameComponent {
+		dsName = name[:pfxLen+2]
+		switch name[pfxLen+1].String() {
+		case "adjacencies":
+			status = &tlv.LsdbStatus{AdjacencyLsas: status.AdjacencyLsas}
+		case "names":
+			status = &tlv.LsdbStatus{NameLsas: status.NameLsas}
+		case "coordinates":
+			status = &tlv.LsdbStatus{CoordinateLsas: status.CoordinateLsas}
+		default:
+			log.Warn(ls, "Unknown LSDB dataset", "name", name)
+			return
+		}
+	}
+
+	ls.mgmtSendDataset(args, dsName, status.Encode())
+}
+
+// Serves the routing table dataset, with the next hops to each reachable router.
+func (ls *Router) mgmtOnRoutingTable(args ndn.InterestHandlerArgs) {
+	pfxLen := len(ls.config.MgmtPrefix())
+
+	dataset := func() *tlv.RoutingTableStatus {
+		ls.mutex.Lock()
+		defer ls.mutex.Unlock()
+
+		dataset := &tlv.RoutingTableStatus{}
+		for entry := range ls.routes.Entries() {
+			rte := &tlv.RoutingTableEntry{
+				Destination: &tlv.Destination{Name: entry.Router},
+			}
+			for _, hop := range entry.NextHops {
+				uri := hop.Neighbor.String()
+				if ns := ls.hello.neighbors[hop.Neighbor.Hash()]; ns != nil {
+					uri = ns.config.Uri
+				}
+				rte.NextHops = append(rte.NextHops, &tlv.NextHop{
+					Uri:  uri,
+					Cost: tlv.Double(hop.Cost),
+				})
+			}
+			dataset.Entries = append(dataset.Entries, rte)
+		}
+		return dataset
+	}()
+
+	ls.mgmtSendDataset(args, args.Interest.Name()[:pfxLen+1], dataset.Encode())
+}
@@ -0,0 +1,359 @@
+package ls
+
+import (
+	"sync"
+	"time"
+
+	"github.com/named-data/ndnd/dv/nfdc"
+	"github.com/named-data/ndnd/ls/config"
+	"github.com/named-data/ndnd/ls/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/keychain"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+type Router struct {
+	// go-ndn app that this router is attached to
+	engine ndn.Engine
+	// config for this router
+	config *config.Config
+	// trust configuration
+	trust *sec.TrustConfig
+	// object client
+	client ndn.Client
+	// nfd management thread
+	nfdc *nfdc.NfdMgmtThread
+	// single mutex for all operations
+	mutex sync.Mutex
+
+	// channel to stop the router
+	stop chan bool
+
+	// hello protocol module
+	hello helloModule
+	// LSA publishing and fetching module
+	lsa lsaModule
+	// sync group of the LSAs of all routers
+	sync *ndn_sync.PSyncFull
+
+	// link state database
+	lsdb *table.Lsdb
+	// routing table from the last calculation
+	routes *table.RoutingTable
+	// forwarding table
+	fib *table.Fib
+	// pending routing calculation
+	calc *time.Timer
+
+	// name prefixes readvertised by local applications (hash -> prefix)
+	prefixes map[uint64]*localPrefix
+
+	// store for management datasets
+	mgmtStore ndn.Store
+	// recently produced management datasets
+	mgmtDir *storage.MemoryFifoDir
+}
+
+// localPrefix is a name prefix readvertised by local applications.
+type localPrefix struct {
+	// name of the prefix
+	name enc.Name
+	// cost of the prefix in the name LSA
+	cost float64
+	// faces the prefix is registered on
+	faces map[uint64]bool
+}
+
+// Create a new link-state router.
+func NewRouter(config *config.Config, engine ndn.Engine) (*Router, error) {
+	// Validate configuration
+	err := config.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create packet store
+	store := storage.NewMemoryStore()
+
+	// Create security configuration
+	var trust *sec.TrustConfig = nil
+	if config.KeyChainUri == "insecure" {
+		log.Warn(nil, "Security is disabled - insecure mode")
+	} else {
+		kc, err := keychain.NewKeyChain(config.KeyChainUri, store)
+		if err != nil {
+			return nil, err
+		}
+		anchors := config.TrustAnchorNames()
+		trust, err = sec.NewTrustConfig(kc, config.TrustSchema(), anchors)
+		if err != nil {
+			return nil, err
+		}
+
+		// Attach data name as forwarding hint to cert Interests
+		trust.UseDataNameFwHint = true
+	}
+
+	// Create the router
+	ls := &Router{
+		engine: engine,
+		config: config,
+		trust:  trust,
+		client: object.NewClient(engine, store, trust),
+		nfdc:   nfdc.NewNfdMgmtThread(engine),
+		mutex:  sync.Mutex{},
+
+		lsdb:     table.NewLsdb(),
+		routes:   table.NewRoutingTable(),
+		prefixes: make(map[uint64]*localPrefix),
+
+		mgmtStore: storage.NewMemoryStore(),
+		mgmtDir:   storage.NewMemoryFifoDir(16), // keep last few datasets
+	}
+	ls.fib = table.NewFib(ls.nfdc)
+
+	// Initialize hello module
+	ls.hello = helloModule{
+		ls:        ls,
+		neighbors: make(map[uint64]*neighborState),
+	}
+	for i := range config.Neighbors {
+		n := &config.Neighbors[i]
+		ls.hello.neighbors[n.RouterName().Hash()] = &neighborState{config: n}
+	}
+
+	// Initialize LSA module
+	ls.lsa = lsaModule{
+		ls:     ls,
+		seq:    make(map[string]uint64),
+		objDir: storage.NewMemoryFifoDir(32), // keep last few LSAs
+	}
+
+	// Create sync group of the network
+	ls.sync = ndn_sync.NewPSyncFull(ndn_sync.PSyncOpts{
+		Client:      ls.client,
+		GroupPrefix: config.SyncPrefix(),
+		OnUpdate:    func(su ndn_sync.SvSyncUpdate) { go ls.lsa.onSyncUpdate(su) },
+	})
+
+	return ls, nil
+}
+
+// Log identifier for the link-state router.
+func (ls *Router) String() string {
+	return "ls-router"
+}
+
+// Start the link-state router. Blocks until Stop() is called.
+func (ls *Router) Start() (err error) {
+	log.Info(ls, "Starting LS router", "version", utils.NDNdVersion)
+	defer log.Info(ls, "Stopped LS router")
+
+	// Initialize channels
+	ls.stop = make(chan bool, 1)
+
+	// Register neighbor faces
+	ls.createFaces()
+	defer ls.destroyFaces()
+
+	// Start timers
+	hello := time.NewTicker(ls.config.HelloInterval())
+	refresh := time.NewTicker(ls.config.LsaRefreshTime())
+	defer hello.Stop()
+	defer refresh.Stop()
+
+	// Start object client
+	ls.client.Start()
+	defer ls.client.Stop()
+
+	// Start management thread
+	go ls.nfdc.Start()
+	defer ls.nfdc.Stop()
+
+	// Register interest handlers
+	if err = ls.register(); err != nil {
+		return err
+	}
+
+	// Start sync group
+	if err = ls.sync.Start(); err != nil {
+		return err
+	}
+	defer ls.sync.Stop()
+
+	// Publish initial LSAs. The adjacency LSA is built when neighbors are up.
+	ls.lsa.publishName()
+	ls.lsa.publishCoordinate()
+	ls.lsa.publishAdjacency()
+	go ls.hello.sendHellos()
+
+	for {
+		select {
+		case <-hello.C:
+			go ls.hello.sendHellos()
+			ls.expireLsas()
+		case <-refresh.C:
+			ls.lsa.refresh()
+		case <-ls.stop:
+			ls.mutex.Lock()
+			if ls.calc != nil {
+				ls.calc.Stop()
+			}
+			ls.mutex.Unlock()
+			return nil
+		}
+	}
+}
+
+// Stop the link-state router.
+func (ls *Router) Stop() {
+	ls.stop <- true
+}
+
+// Register interest handlers for NLSR prefixes.
+func (ls *Router) register() (err error) {
+	// Enable local fields on face. This includes incoming face indication.
+	ls.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "faces",
+		Cmd:    "update",
+		Args: &mgmt.ControlArgs{
+			Mask:  optional.Some(mgmt.FaceFlagLocalFieldsEnabled),
+			Flags: optional.Some(mgmt.FaceFlagLocalFieldsEnabled),
+		},
+		Retries: -1,
+	})
+
+	// Hellos from neighbors
+	err = ls.engine.AttachHandler(ls.config.HelloPrefix(ls.config.RouterName()),
+		func(args ndn.InterestHandlerArgs) {
+			go ls.hello.onHello(args)
+		})
+	if err != nil {
+		return err
+	}
+
+	// Router management
+	err = ls.engine.AttachHandler(ls.config.MgmtPrefix(),
+		func(args ndn.InterestHandlerArgs) {
+			go ls.mgmtOnInterest(args)
+		})
+	if err != nil {
+		return err
+	}
+
+	// Register routes to forwarder
+	pfxs := []enc.Name{
+		ls.config.HelloPrefix(ls.config.RouterName()),
+		ls.config.LsaRouterPrefix(ls.config.RouterName()),
+		ls.config.SyncPrefix(),
+		ls.config.MgmtPrefix(),
+	}
+	for _, prefix := range pfxs {
+		ls.nfdc.Exec(nfdc.NfdMgmtCmd{
+			Module: "rib",
+			Cmd:    "register",
+			Args: &mgmt.ControlArgs{
+				Name:   prefix,
+				Cost:   optional.Some(uint64(0)),
+				Origin: optional.Some(config.NlsrOrigin),
+			},
+			Retries: -1,
+		})
+	}
+
+	// Set strategy to multicast for the sync prefix
+	ls.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "strategy-choice",
+		Cmd:    "set",
+		Args: &mgmt.ControlArgs{
+			Name: ls.config.SyncPrefix(),
+			Strategy: &mgmt.Strategy{
+				Name: config.MulticastStrategy,
+			},
+		},
+		Retries: -1,
+	})
+
+	return nil
+}
+
+// Returns the prefixes registered on the face of each neighbor.
+func (ls *Router) neighborPrefixes(neighbor *config.Neighbor) []enc.Name {
+	return []enc.Name{
+		ls.config.HelloPrefix(neighbor.RouterName()),
+		ls.config.LsaPrefix(),
+		ls.config.SyncPrefix(),
+	}
+}
+
+// createFaces creates faces to all neighbors.
+func (ls *Router) createFaces() {
+	for i, neighbor := range ls.config.Neighbors {
+		var mtu optional.Optional[uint64]
+		if neighbor.Mtu > 0 {
+			mtu = optional.Some(neighbor.Mtu)
+		}
+
+		faceId, created, err := ls.nfdc.CreateFace(&mgmt.ControlArgs{
+			Uri:             optional.Some(neighbor.Uri),
+			FacePersistency: optional.Some(uint64(mgmt.PersistencyPermanent)),
+			Mtu:             mtu,
+		})
+		if err != nil {
+			log.Error(ls, "Failed to create face to neighbor", "uri", neighbor.Uri, "err", err)
+			continue
+		}
+		log.Info(ls, "Created face to neighbor", "name", neighbor.Name, "uri", neighbor.Uri, "faceId", faceId)
+
+		ls.mutex.Lock()
+		ls.config.Neighbors[i].FaceId = faceId
+		ls.config.Neighbors[i].Created = created
+		ls.mutex.Unlock()
+
+		for _, prefix := range ls.neighborPrefixes(&neighbor) {
+			ls.nfdc.Exec(nfdc.NfdMgmtCmd{
+				Module: "rib",
+				Cmd:    "register",
+				Args: &mgmt.ControlArgs{
+					Name:   prefix,
+					Cost:   optional.Some(routeCost(neighbor.Cost)),
+					Origin: optional.Some(config.NlsrOrigin),
+					FaceId: optional.Some(faceId),
+				},
+				Retries: 3,
+			})
+		}
+	}
+}
+
+// destroyFaces synchronously destroys our faces to neighbors.
+func (ls *Router) destroyFaces() {
+	for _, neighbor := range ls.config.Neighbors {
+		if neighbor.FaceId == 0 {
+			continue
+		}
+
+		for _, prefix := range ls.neighborPrefixes(&neighbor) {
+			ls.engine.ExecMgmtCmd("rib", "unregister", &mgmt.ControlArgs{
+				Name:   prefix,
+				Origin: optional.Some(config.NlsrOrigin),
+				FaceId: optional.Some(neighbor.FaceId),
+			})
+		}
+
+		// only destroy faces that we created
+		if neighbor.Created {
+			ls.engine.ExecMgmtCmd("faces", "destroy", &mgmt.ControlArgs{
+				FaceId: optional.Some(neighbor.FaceId),
+			})
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package ls
+
+import (
+	"math"
+	"time"
+
+	"github.com/named-data/ndnd/ls/table"
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+)
+
+// Converts the cost of a path to the cost of a route in the forwarder.
+// Costs beyond the range of the forwarder saturate.
+func routeCost(cost float64) uint64 {
+	return uint64(math.Round(min(cost, math.MaxInt64)))
+}
+
+// Schedules a routing calculation after the calculation interval,
+// so that several LSDB changes result in one calculation.
+// This function must be called with the router lock held.
+func (ls *Router) scheduleCalc() {
+	if ls.calc != nil {
+		return
+	}
+	ls.calc = time.AfterFunc(ls.config.RoutingCalcInterval(), ls.calculateRoutes)
+}
+
+// Calculates the routing table from the LSDB and updates the FIB.
+func (ls *Router) calculateRoutes() {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+
+	ls.calc = nil
+	ls.routes = table.CalculateRoutes(ls.config.RouterName(), ls.lsdb, ls.config.MaxFacesPerPrefix)
+	log.Info(ls, "Calculated routing table", "routers", ls.routes.Size())
+
+	ls.updateFib()
+}
+
+// Installs the routes to all reachable routers and their advertised names.
+// A name advertised by several routers is routed to all of them,
+// and the cost of the name is added to the cost of the path.
+// This function must be called with the router lock held.
+func (ls *Router) updateFib() {
+	routes := make(map[uint64][]table.FibEntry)
+	names := make(map[uint64]enc.Name)
+	add := func(entry *table.RoutingEntry, name enc.Name, cost float64) {
+		hash := name.Hash()
+		names[hash] = name
+		for _, hop := range entry.NextHops {
+			if faceId := ls.hello.faceId(hop.Neighbor); faceId != 0 {
+				routes[hash] = append(routes[hash], table.FibEntry{
+					FaceId: faceId,
+					Cost:   routeCost(hop.Cost + cost),
+				})
+			}
+		}
+	}
+
+	for entry := range ls.routes.Entries() {
+		add(entry, entry.Router, 0)
+		if lsa := ls.lsdb.Name(entry.Router); lsa != nil {
+			for _, prefix := range lsa.Prefixes {
+				add(entry, prefix.Name, tlv.FromDouble(prefix.Cost))
+			}
+		}
+	}
+
+	ls.fib.UnmarkAll()
+	for hash, entries := range routes {
+		ls.fib.Update(names[hash], entries)
+	}
+	ls.fib.RemoveUnmarked()
+}
+
+// Removes the LSAs of other routers that were not refreshed in time.
+func (ls *Router) expireLsas() {
+	ls.mutex.Lock()
+	defer ls.mutex.Unlock()
+
+	if ls.lsdb.Expire(time.Now(), ls.config.RouterName()) {
+		ls.scheduleCalc()
+	}
+}
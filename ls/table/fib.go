@@ -0,0 +1,131 @@
+package table
+
+import (
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/named-data/ndnd/dv/nfdc"
+	"github.com/named-data/ndnd/ls/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+type FibEntry struct {
+	// next hop face Id
+	FaceId uint64
+	// cost in this entry
+	Cost uint64
+}
+
+// Fib is the set of routes installed in the forwarder.
+type Fib struct {
+	nfdc *nfdc.NfdMgmtThread
+	// name hash -> name
+	names map[uint64]enc.Name
+	// name hash -> face Id -> cost
+	prefixes map[uint64]map[uint64]uint64
+	// name hash -> updated since UnmarkAll
+	mark map[uint64]bool
+}
+
+// Constructs an empty FIB that installs routes with the NFD management thread.
+func NewFib(nfdc *nfdc.NfdMgmtThread) *Fib {
+	return &Fib{
+		nfdc:     nfdc,
+		names:    make(map[uint64]enc.Name),
+		prefixes: make(map[uint64]map[uint64]uint64),
+		mark:     make(map[uint64]bool),
+	}
+}
+
+// Returns the number of entries in the FIB.
+func (fib *Fib) Size() int {
+	return len(fib.prefixes)
+}
+
+// Get all names in the FIB with their next hops, ordered by face Id.
+func (fib *Fib) Entries() iter.Seq2[enc.Name, []FibEntry] {
+	return func(yield func(enc.Name, []FibEntry) bool) {
+		for nameH, hops := range fib.prefixes {
+			entries := make([]FibEntry, 0, len(hops))
+			for _, faceId := range slices.Sorted(maps.Keys(hops)) {
+				entries = append(entries, FibEntry{FaceId: faceId, Cost: hops[faceId]})
+			}
+			if !yield(fib.names[nameH], entries) {
+				return
+			}
+		}
+	}
+}
+
+// Sets the next hops of a name and marks it. Only the changed routes are
+// registered or unregistered in the forwarder. If a face is given more than
+// once, the lowest cost is used.
+func (fib *Fib) Update(name enc.Name, entries []FibEntry) {
+	nameH := name.Hash()
+	fib.mark[nameH] = true
+
+	hops := make(map[uint64]uint64, len(entries))
+	for _, entry := range entries {
+		if cost, ok := hops[entry.FaceId]; !ok || entry.Cost < cost {
+			hops[entry.FaceId] = entry.Cost
+		}
+	}
+
+	old := fib.prefixes[nameH]
+	for faceId := range old {
+		if _, ok := hops[faceId]; !ok {
+			fib.nfdc.Exec(nfdc.NfdMgmtCmd{
+				Module: "rib",
+				Cmd:    "unregister",
+				Args: &mgmt.ControlArgs{
+					Name:   name,
+					FaceId: optional.Some(faceId),
+					Origin: optional.Some(config.NlsrOrigin),
+				},
+				Retries: 3,
+			})
+		}
+	}
+	for faceId, cost := range hops {
+		if prev, ok := old[faceId]; ok && prev == cost {
+			continue
+		}
+		fib.nfdc.Exec(nfdc.NfdMgmtCmd{
+			Module: "rib",
+			Cmd:    "register",
+			Args: &mgmt.ControlArgs{
+				Name:   name,
+				FaceId: optional.Some(faceId),
+				Cost:   optional.Some(cost),
+				Origin: optional.Some(config.NlsrOrigin),
+			},
+			Retries: 3,
+		})
+	}
+
+	if len(hops) > 0 {
+		fib.names[nameH] = name
+		fib.prefixes[nameH] = hops
+	} else {
+		delete(fib.names, nameH)
+		delete(fib.prefixes, nameH)
+	}
+}
+
+// Removes the marks of all entries before a full update.
+func (fib *Fib) UnmarkAll() {
+	clear(fib.mark)
+}
+
+// Removes all entries that were not updated since UnmarkAll.
+func (fib *Fib) RemoveUnmarked() {
+	for nameH, name := range fib.names {
+		if !fib.mark[nameH] {
+			fib.Update(name, nil)
+		}
+	}
+	clear(fib.mark)
+}
@@ -0,0 +1,176 @@
+package table
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"time"
+
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+)
+
+// lsaRecord is any LSA with an LSA info.
+type lsaRecord interface {
+	Info() *tlv.Lsa
+}
+
+// lsaEntry is an LSA installed in the LSDB.
+type lsaEntry[T lsaRecord] struct {
+	lsa     T
+	expires time.Time
+}
+
+// lsaSet is the set of the latest LSAs of one type by origin router hash.
+type lsaSet[T lsaRecord] map[uint64]lsaEntry[T]
+
+// Link State Database (LSDB) with the latest LSAs of each router.
+type Lsdb struct {
+	// adjacency LSAs by origin router hash
+	adjacency lsaSet[*tlv.AdjacencyLsa]
+	// name LSAs by origin router hash
+	names lsaSet[*tlv.NameLsa]
+	// coordinate LSAs by origin router hash
+	coordinates lsaSet[*tlv.CoordinateLsa]
+}
+
+// Constructs an empty LSDB.
+func NewLsdb() *Lsdb {
+	return &Lsdb{
+		adjacency:   make(lsaSet[*tlv.AdjacencyLsa]),
+		names:       make(lsaSet[*tlv.NameLsa]),
+		coordinates: make(lsaSet[*tlv.CoordinateLsa]),
+	}
+}
+
+// Log identifier for the LSDB.
+func (l *Lsdb) String() string {
+	return "ls-lsdb"
+}
+
+// Installs an adjacency LSA if it is newer than the installed one.
+// Returns true if the LSA was installed.
+func (l *Lsdb) InstallAdjacency(lsa *tlv.AdjacencyLsa) (bool, error) {
+	return install(l, l.adjacency, lsa)
+}
+
+// Installs a name LSA if it is newer than the installed one.
+// Returns true if the LSA was installed.
+func (l *Lsdb) InstallName(lsa *tlv.NameLsa) (bool, error) {
+	for _, prefix := range lsa.Prefixes {
+		if len(prefix.Name) == 0 {
+			return false, fmt.Errorf("name LSA prefix has no name")
+		}
+		if cost := tlv.FromDouble(prefix.Cost); !(cost >= 0) || math.IsInf(cost, 1) {
+			return false, fmt.Errorf("invalid name LSA prefix cost: %v", cost)
+		}
+	}
+	return install(l, l.names, lsa)
+}
+
+// Installs a coordinate LSA if it is newer than the installed one.
+// Returns true if the LSA was installed.
+func (l *Lsdb) InstallCoordinate(lsa *tlv.CoordinateLsa) (bool, error) {
+	return install(l, l.coordinates, lsa)
+}
+
+// Get the adjacency LSA of a router.
+func (l *Lsdb) Adjacency(router enc.Name) *tlv.AdjacencyLsa {
+	return l.adjacency[router.Hash()].lsa
+}
+
+// Get the name LSA of a router.
+func (l *Lsdb) Name(router enc.Name) *tlv.NameLsa {
+	return l.names[router.Hash()].lsa
+}
+
+// Get the coordinate LSA of a router.
+func (l *Lsdb) Coordinate(router enc.Name) *tlv.CoordinateLsa {
+	return l.coordinates[router.Hash()].lsa
+}
+
+// Get all adjacency LSAs in the LSDB.
+func (l *Lsdb) AdjacencyLsas() iter.Seq[*tlv.AdjacencyLsa] {
+	return l.adjacency.all()
+}
+
+// Get all name LSAs in the LSDB.
+func (l *Lsdb) NameLsas() iter.Seq[*tlv.NameLsa] {
+	return l.names.all()
+}
+
+// Get all coordinate LSAs in the LSDB.
+func (l *Lsdb) CoordinateLsas() iter.Seq[*tlv.CoordinateLsa] {
+	return l.coordinates.all()
+}
+
+// Removes the LSAs that expired before the given time, except those of the given router.
+// Returns true if an adjacency or name LSA was removed, which changes the routes.
+func (l *Lsdb) Expire(now time.Time, self enc.Name) (dirty bool) {
+	selfH := self.Hash()
+	dirty = l.adjacency.expire(l, now, selfH) || dirty
+	dirty = l.names.expire(l, now, selfH) || dirty
+	l.coordinates.expire(l, now, selfH)
+	return dirty
+}
+
+// Get the LSDB dataset with all LSAs.
+func (l *Lsdb) Status() *tlv.LsdbStatus {
+	status := &tlv.LsdbStatus{}
+	for lsa := range l.AdjacencyLsas() {
+		status.AdjacencyLsas = append(status.AdjacencyLsas, lsa)
+	}
+	for lsa := range l.CoordinateLsas() {
+		status.CoordinateLsas = append(status.CoordinateLsas, lsa)
+	}
+	for lsa := range l.NameLsas() {
+		status.NameLsas = append(status.NameLsas, lsa)
+	}
+	return status
+}
+
+// Installs an LSA in a set if it is newer than the installed one.
+func install[T lsaRecord](l *Lsdb, set lsaSet[T], lsa T) (bool, error) {
+	info := lsa.Info()
+	if info == nil || len(info.OriginRouter) == 0 {
+		return false, fmt.Errorf("LSA has no origin router")
+	}
+	expires, err := tlv.ParseExpirationTime(info.ExpirationTime)
+	if err != nil {
+		return false, fmt.Errorf("invalid LSA expiration time: %w", err)
+	}
+
+	hash := info.OriginRouter.Hash()
+	if old, ok := set[hash]; ok && old.lsa.Info().SequenceNumber >= info.SequenceNumber {
+		return false, nil
+	}
+
+	log.Debug(l, "Install LSA", "router", info.OriginRouter, "seq", info.SequenceNumber)
+	set[hash] = lsaEntry[T]{lsa: lsa, expires: expires}
+	return true, nil
+}
+
+// Get all LSAs in the set.
+func (set lsaSet[T]) all() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range set {
+			if !yield(entry.lsa) {
+				return
+			}
+		}
+	}
+}
+
+// Removes the expired LSAs from the set, except the LSA of self.
+func (set lsaSet[T]) expire(l *Lsdb, now time.Time, self uint64) (dirty bool) {
+	for hash, entry := range set {
+		if hash != self && entry.expires.Before(now) {
+			log.Info(l, "LSA expired", "router", entry.lsa.Info().OriginRouter,
+				"seq", entry.lsa.Info().SequenceNumber)
+			delete(set, hash)
+			dirty = true
+		}
+	}
+	return dirty
+}
@@ -0,0 +1,207 @@
+package table
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// NextHop is a next hop to a destination router.
+type NextHop struct {
+	// name of the neighbor router
+	Neighbor enc.Name
+	// cost of the path through the neighbor
+	Cost float64
+}
+
+// RoutingEntry is the route to a destination router.
+type RoutingEntry struct {
+	// name of the destination router
+	Router enc.Name
+	// next hops ordered by cost
+	NextHops []NextHop
+}
+
+// Routing table calculated from the adjacency LSAs.
+type RoutingTable struct {
+	// destination router hash -> entry
+	entries map[uint64]*RoutingEntry
+}
+
+// linkGraph is the graph of bidirectional links between routers.
+type linkGraph struct {
+	// router names by index
+	names []enc.Name
+	// router name hash -> index
+	index map[uint64]int
+	// index -> neighbor index -> link cost
+	links []map[int]float64
+}
+
+// Calculates the routing table of a router from the adjacency LSAs in the LSDB.
+//
+// A link is used only if both routers advertise it, with the higher of the two costs.
+// For multipath, the shortest path to each destination is calculated through
+// each neighbor, and up to maxFaces next hops are kept (zero for all).
+func CalculateRoutes(self enc.Name, lsdb *Lsdb, maxFaces int) *RoutingTable {
+	rt := NewRoutingTable()
+
+	graph := newLinkGraph(lsdb)
+	src, ok := graph.index[self.Hash()]
+	if !ok {
+		return rt
+	}
+
+	// Shortest paths from each neighbor, without going through self
+	for nbr, linkCost := range graph.links[src] {
+		dist := graph.dijkstra(nbr, src)
+		for dst, d := range dist {
+			if dst == src || math.IsInf(d, 1) {
+				continue
+			}
+
+			dstName := graph.names[dst]
+			entry := rt.entries[dstName.Hash()]
+			if entry == nil {
+				entry = &RoutingEntry{Router: dstName}
+				rt.entries[dstName.Hash()] = entry
+			}
+			entry.NextHops = append(entry.NextHops, NextHop{
+				Neighbor: graph.names[nbr],
+				Cost:     linkCost + d,
+			})
+		}
+	}
+
+	// Order next hops by cost, and keep the best ones
+	for _, entry := range rt.entries {
+		slices.SortFunc(entry.NextHops, func(a, b NextHop) int {
+			if c := cmp.Compare(a.Cost, b.Cost); c != 0 {
+				return c
+			}
+			return a.Neighbor.Compare(b.Neighbor)
+		})
+		if maxFaces > 0 && len(entry.NextHops) > maxFaces {
+			entry.NextHops = entry.NextHops[:maxFaces]
+		}
+	}
+
+	return rt
+}
+
+// Constructs an empty routing table.
+func NewRoutingTable() *RoutingTable {
+	return &RoutingTable{entries: make(map[uint64]*RoutingEntry)}
+}
+
+// Print the routing table to the console (for debugging).
+func (rt *RoutingTable) Print() {
+	for _, entry := range rt.entries {
+		fmt.Printf("=> Destination: %s\n", entry.Router.String())
+		for _, hop := range entry.NextHops {
+			fmt.Printf("===> NextHop: %s, Cost: %f\n", hop.Neighbor.String(), hop.Cost)
+		}
+	}
+}
+
+// Size gets the number of reachable routers in the routing table.
+func (rt *RoutingTable) Size() int {
+	return len(rt.entries)
+}
+
+// Get the route to a destination router, or nil if it is unreachable.
+func (rt *RoutingTable) Get(router enc.Name) *RoutingEntry {
+	return rt.entries[router.Hash()]
+}
+
+// Get the routes to all reachable routers.
+func (rt *RoutingTable) Entries() iter.Seq[*RoutingEntry] {
+	return func(yield func(*RoutingEntry) bool) {
+		for _, entry := range rt.entries {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// Builds the graph of links advertised in both directions.
+func newLinkGraph(lsdb *Lsdb) *linkGraph {
+	g := &linkGraph{index: make(map[uint64]int)}
+
+	// Costs advertised by each router
+	advertised := make(map[[2]int]float64)
+	for lsa := range lsdb.AdjacencyLsas() {
+		from := g.node(lsa.Lsa.OriginRouter)
+		for _, adj := range lsa.Adjacencies {
+			cost := tlv.FromDouble(adj.Cost)
+			if len(adj.Name) == 0 || math.IsNaN(cost) || cost < 0 {
+				continue
+			}
+			to := g.node(adj.Name)
+			if to != from {
+				advertised[[2]int{from, to}] = cost
+			}
+		}
+	}
+
+	g.links = make([]map[int]float64, len(g.names))
+	for i := range g.links {
+		g.links[i] = make(map[int]float64)
+	}
+	for link, cost := range advertised {
+		if back, ok := advertised[[2]int{link[1], link[0]}]; ok {
+			g.links[link[0]][link[1]] = max(cost, back)
+		}
+	}
+
+	return g
+}
+
+// Returns the index of a router in the graph, adding it if needed.
+func (g *linkGraph) node(name enc.Name) int {
+	hash := name.Hash()
+	if i, ok := g.index[hash]; ok {
+		return i
+	}
+	g.index[hash] = len(g.names)
+	g.names = append(g.names, name)
+	return len(g.names) - 1
+}
+
+// Calculates the distance from a router to all routers, without going through
+// the excluded router. Unreachable routers are at infinity.
+func (g *linkGraph) dijkstra(src int, exclude int) []float64 {
+	dist := make([]float64, len(g.names))
+	done := make([]bool, len(g.names))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[src] = 0
+	done[exclude] = true
+
+	for {
+		// Closest router not yet visited
+		u := -1
+		for i := range dist {
+			if !done[i] && !math.IsInf(dist[i], 1) && (u < 0 || dist[i] < dist[u]) {
+				u = i
+			}
+		}
+		if u < 0 {
+			return dist
+		}
+		done[u] = true
+
+		for v, cost := range g.links[u] {
+			if !done[v] && dist[u]+cost < dist[v] {
+				dist[v] = dist[u] + cost
+			}
+		}
+	}
+}
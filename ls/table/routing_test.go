@@ -0,0 +1,179 @@
+package table_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/ls/table"
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// testLink is a link advertised by router a with the given cost.
+type testLink struct {
+	a, b string
+	cost float64
+}
+
+// Returns the full name of a test router.
+func routerName(name string) enc.Name {
+	return tu.NoErr(enc.NameFromStr("/ndn/site/%C1.Router/" + name))
+}
+
+// Builds an LSDB with the adjacency LSAs of the links, as received on the wire.
+// Links are advertised in both directions unless oneWay is set.
+func buildLsdb(t *testing.T, links []testLink, oneWay bool) *table.Lsdb {
+	adjs := make(map[string][]*tlv.Adjacency)
+	for _, link := range links {
+		adjs[link.a] = append(adjs[link.a], &tlv.Adjacency{
+			Name: routerName(link.b),
+			Uri:  "udp4://" + link.b,
+			Cost: tlv.Double(link.cost),
+		})
+		if !oneWay {
+			adjs[link.b] = append(adjs[link.b], &tlv.Adjacency{
+				Name: routerName(link.a),
+				Uri:  "udp4://" + link.a,
+				Cost: tlv.Double(link.cost),
+			})
+		}
+	}
+
+	lsdb := table.NewLsdb()
+	for router, adj := range adjs {
+		lsa := &tlv.AdjacencyLsa{
+			Lsa: &tlv.Lsa{
+				OriginRouter:   routerName(router),
+				SequenceNumber: 1,
+				ExpirationTime: tlv.FormatExpirationTime(time.Now().Add(time.Hour)),
+			},
+			Adjacencies: adj,
+		}
+		lsa = tu.NoErr(tlv.ParseAdjacencyLsa(enc.NewWireView(lsa.Encode()), false))
+		require.True(t, tu.NoErr(lsdb.InstallAdjacency(lsa)))
+	}
+	return lsdb
+}
+
+// Returns the next hops to a router as neighbor names with costs.
+func nextHops(rt *table.RoutingTable, router string) map[string]float64 {
+	entry := rt.Get(routerName(router))
+	if entry == nil {
+		return nil
+	}
+	hops := make(map[string]float64)
+	for _, hop := range entry.NextHops {
+		hops[hop.Neighbor.At(-1).String()] = hop.Cost
+	}
+	return hops
+}
+
+func TestCalculateRoutesMultipath(t *testing.T) {
+	tu.SetT(t)
+
+	//   a --1-- b --1-- d
+	//   |               |
+	//   5 ---- c ---5---+
+	links := []testLink{
+		{"a", "b", 1},
+		{"b", "d", 1},
+		{"a", "c", 5},
+		{"c", "d", 5},
+	}
+	rt := table.CalculateRoutes(routerName("a"), buildLsdb(t, links, false), 0)
+
+	require.Equal(t, 3, rt.Size())
+	require.Nil(t, rt.Get(routerName("a")))
+	require.Equal(t, map[string]float64{"b": 1, "c": 11}, nextHops(rt, "b"))
+	require.Equal(t, map[string]float64{"b": 2, "c": 10}, nextHops(rt, "d"))
+	require.Equal(t, map[string]float64{"c": 5, "b": 7}, nextHops(rt, "c"))
+
+	// Next hops are ordered by cost
+	entry := rt.Get(routerName("d"))
+	require.Equal(t, "b", entry.NextHops[0].Neighbor.At(-1).String())
+
+	// Only the best next hops are kept
+	rt = table.CalculateRoutes(routerName("a"), buildLsdb(t, links, false), 1)
+	require.Equal(t, map[string]float64{"b": 2}, nextHops(rt, "d"))
+}
+
+func TestCalculateRoutesBidirectional(t *testing.T) {
+	tu.SetT(t)
+
+	// Links advertised in one direction only are not used
+	links := []testLink{{"a", "b", 1}, {"b", "c", 1}}
+	rt := table.CalculateRoutes(routerName("a"), buildLsdb(t, links, true), 0)
+	require.Equal(t, 0, rt.Size())
+
+	// The higher cost of the two directions is used
+	links = []testLink{{"a", "b", 1}, {"b", "a", 3}, {"b", "c", 2}, {"c", "b", 2}}
+	rt = table.CalculateRoutes(routerName("a"), buildLsdb(t, links, true), 0)
+	require.Equal(t, map[string]float64{"b": 3}, nextHops(rt, "b"))
+	require.Equal(t, map[string]float64{"b": 5}, nextHops(rt, "c"))
+
+	// Unknown router has no routes
+	rt = table.CalculateRoutes(routerName("x"), buildLsdb(t, links, true), 0)
+	require.Equal(t, 0, rt.Size())
+}
+
+func TestLsdbInstall(t *testing.T) {
+	tu.SetT(t)
+
+	lsa := func(router string, seq uint64, expires time.Time) *tlv.NameLsa {
+		return &tlv.NameLsa{
+			Lsa: &tlv.Lsa{
+				OriginRouter:   routerName(router),
+				SequenceNumber: seq,
+				ExpirationTime: tlv.FormatExpirationTime(expires),
+			},
+			Prefixes: []*tlv.PrefixInfo{{
+				Name: tu.NoErr(enc.NameFromStr("/ndn/" + router)),
+				Cost: tlv.Double(10),
+			}},
+		}
+	}
+
+	lsdb := table.NewLsdb()
+	now := time.Now()
+
+	// Only newer LSAs are installed
+	require.True(t, tu.NoErr(lsdb.InstallName(lsa("a", 2, now.Add(time.Hour)))))
+	require.False(t, tu.NoErr(lsdb.InstallName(lsa("a", 2, now.Add(time.Hour)))))
+	require.False(t, tu.NoErr(lsdb.InstallName(lsa("a", 1, now.Add(time.Hour)))))
+	require.True(t, tu.NoErr(lsdb.InstallName(lsa("a", 3, now.Add(time.Minute)))))
+	require.Equal(t, uint64(3), lsdb.Name(routerName("a")).Lsa.SequenceNumber)
+
+	// Invalid LSAs are rejected
+	_, err := lsdb.InstallName(&tlv.NameLsa{})
+	require.Error(t, err)
+	bad := lsa("b", 1, now)
+	bad.Lsa.ExpirationTime = "tomorrow"
+	_, err = lsdb.InstallName(bad)
+	require.Error(t, err)
+	for _, cost := range []float64{-1, math.Inf(1), math.NaN()} {
+		bad = lsa("b", 1, now.Add(time.Hour))
+		bad.Prefixes[0].Cost = tlv.Double(cost)
+		_, err = lsdb.InstallName(bad)
+		require.Error(t, err)
+	}
+	bad = lsa("b", 1, now.Add(time.Hour))
+	bad.Prefixes[0].Name = nil
+	_, err = lsdb.InstallName(bad)
+	require.Error(t, err)
+	require.Nil(t, lsdb.Name(routerName("b")))
+
+	// Expired LSAs are removed, except those of self
+	require.True(t, tu.NoErr(lsdb.InstallName(lsa("self", 1, now.Add(-time.Hour)))))
+	require.False(t, lsdb.Expire(now, routerName("self")))
+	require.True(t, lsdb.Expire(now.Add(2*time.Minute), routerName("self")))
+	require.Nil(t, lsdb.Name(routerName("a")))
+	require.NotNil(t, lsdb.Name(routerName("self")))
+
+	// Dataset round trip
+	status := tu.NoErr(tlv.ParseLsdbStatus(enc.NewWireView(lsdb.Status().Encode()), false))
+	require.Len(t, status.NameLsas, 1)
+	require.Equal(t, routerName("self"), status.NameLsas[0].Lsa.OriginRouter)
+}
@@ -0,0 +1,91 @@
+//go:generate gondn_tlv_gen
+package tlv
+
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// NLSR LSA formats. Costs and coordinates are IEEE 754 doubles,
+// see Double and FromDouble.
+// The name LSA lists the advertised prefixes with their costs, as in NLSR 0.7.
+
+type Lsa struct {
+	//+field:name
+	OriginRouter enc.Name `tlv:"0x07"`
+	//+field:natural
+	SequenceNumber uint64 `tlv:"0x82"`
+	//+field:string
+	ExpirationTime string `tlv:"0x8b"`
+}
+
+type AdjacencyLsa struct {
+	//+field:struct:Lsa
+	Lsa *Lsa `tlv:"0x80"`
+	//+field:sequence:*Adjacency:struct:Adjacency
+	Adjacencies []*Adjacency `tlv:"0x84"`
+}
+
+type Adjacency struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:string
+	Uri string `tlv:"0x8d"`
+	//+field:fixedUint:uint64
+	Cost uint64 `tlv:"0x8c"`
+}
+
+type CoordinateLsa struct {
+	//+field:struct:Lsa
+	Lsa *Lsa `tlv:"0x80"`
+	//+field:fixedUint:uint64
+	HyperbolicRadius uint64 `tlv:"0x87"`
+	//+field:sequence:uint64:fixedUint:uint64
+	HyperbolicAngles []uint64 `tlv:"0x88"`
+}
+
+type NameLsa struct {
+	//+field:struct:Lsa
+	Lsa *Lsa `tlv:"0x80"`
+	//+field:sequence:*PrefixInfo:struct:PrefixInfo
+	Prefixes []*PrefixInfo `tlv:"0x92"`
+}
+
+type PrefixInfo struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:fixedUint:uint64
+	Cost uint64 `tlv:"0x8c"`
+}
+
+type LsdbStatus struct {
+	//+field:sequence:*AdjacencyLsa:struct:AdjacencyLsa
+	AdjacencyLsas []*AdjacencyLsa `tlv:"0x83"`
+	//+field:sequence:*CoordinateLsa:struct:CoordinateLsa
+	CoordinateLsas []*CoordinateLsa `tlv:"0x85"`
+	//+field:sequence:*NameLsa:struct:NameLsa
+	NameLsas []*NameLsa `tlv:"0x89"`
+}
+
+type RoutingTableStatus struct {
+	//+field:sequence:*RoutingTableEntry:struct:RoutingTableEntry
+	Entries []*RoutingTableEntry `tlv:"0x91"`
+}
+
+type RoutingTableEntry struct {
+	//+field:struct:Destination
+	Destination *Destination `tlv:"0x8e"`
+	//+field:sequence:*NextHop:struct:NextHop
+	NextHops []*NextHop `tlv:"0x8f"`
+}
+
+type Destination struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+}
+
+type NextHop struct {
+	//+field:string
+	Uri string `tlv:"0x8d"`
+	//+field:fixedUint:uint64
+	Cost uint64 `tlv:"0x8c"`
+}
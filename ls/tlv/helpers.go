@@ -0,0 +1,45 @@
+package tlv
+
+import (
+	"math"
+	"time"
+)
+
+// ExpirationTimeFormat is the format of the expiration time of an LSA.
+const ExpirationTimeFormat = "2006-01-02 15:04:05.000000"
+
+// Encodes a double as the fixed-length unsigned integer of its IEEE 754 representation.
+func Double(v float64) uint64 {
+	return math.Float64bits(v)
+}
+
+// Decodes a double from the fixed-length unsigned integer of its IEEE 754 representation.
+func FromDouble(v uint64) float64 {
+	return math.Float64frombits(v)
+}
+
+// Formats the expiration time of an LSA (in UTC).
+func FormatExpirationTime(t time.Time) string {
+	return t.UTC().Format(ExpirationTimeFormat)
+}
+
+// Parses the expiration time of an LSA (in UTC).
+// NLSR omits the fractional seconds when they are zero.
+func ParseExpirationTime(s string) (time.Time, error) {
+	return time.ParseInLocation(time.DateTime, s, time.UTC)
+}
+
+// Returns the LSA info of the adjacency LSA.
+func (l *AdjacencyLsa) Info() *Lsa {
+	return l.Lsa
+}
+
+// Returns the LSA info of the coordinate LSA.
+func (l *CoordinateLsa) Info() *Lsa {
+	return l.Lsa
+}
+
+// Returns the LSA info of the name LSA.
+func (l *NameLsa) Info() *Lsa {
+	return l.Lsa
+}
@@ -0,0 +1,56 @@
+package tlv_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/ls/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests the name LSA against the encoding of NLSR.
+func TestNameLsaWire(t *testing.T) {
+	tu.SetT(t)
+
+	// NameLsa (137) with Lsa (128) and one PrefixInfo (146) of cost 10.0
+	wire := []byte{
+		0x89, 0x41,
+		0x80, 0x29,
+		0x07, 0x08, 0x08, 0x03, 'n', 'd', 'n', 0x08, 0x01, 'a',
+		0x82, 0x01, 0x05,
+		0x8b, 0x1a,
+	}
+	wire = append(wire, "2026-10-18 12:00:00.000000"...)
+	wire = append(wire,
+		0x92, 0x14,
+		0x07, 0x08, 0x08, 0x03, 'n', 'd', 'n', 0x08, 0x01, 'p',
+		0x8c, 0x08, 0x40, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	)
+
+	status, err := tlv.ParseLsdbStatus(enc.NewBufferView(wire), false)
+	require.NoError(t, err)
+	require.Len(t, status.NameLsas, 1)
+	lsa := status.NameLsas[0]
+	require.Equal(t, "/ndn/a", lsa.Lsa.OriginRouter.String())
+	require.Equal(t, uint64(5), lsa.Lsa.SequenceNumber)
+	require.Len(t, lsa.Prefixes, 1)
+	require.Equal(t, "/ndn/p", lsa.Prefixes[0].Name.String())
+	require.Equal(t, 10.0, tlv.FromDouble(lsa.Prefixes[0].Cost))
+
+	require.Equal(t, wire, status.Encode().Join())
+}
+
+// Tests the expiration time of LSAs, which NLSR encodes without zero fractional seconds.
+func TestExpirationTime(t *testing.T) {
+	tu.SetT(t)
+
+	ts := time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC)
+	require.Equal(t, "2026-10-18 12:00:00.123456", tlv.FormatExpirationTime(ts))
+	require.Equal(t, ts, tu.NoErr(tlv.ParseExpirationTime("2026-10-18 12:00:00.123456")))
+	require.Equal(t, ts.Truncate(time.Second), tu.NoErr(tlv.ParseExpirationTime("2026-10-18 12:00:00")))
+
+	_, err := tlv.ParseExpirationTime("tomorrow")
+	require.Error(t, err)
+}
@@ -17,11 +17,57 @@ type Config struct {
 	KeyChainUri string `json:"keychain"`
 	// List of trust anchor full names.
 	TrustAnchors []string `json:"trust_anchors"`
+	// Trust schemas for application data prefixes.
+	Trust []*TrustDomainConfig `json:"trust"`
+	// Cluster configures replication with other instances of the service.
+	Cluster *ClusterConfig `json:"cluster"`
+	// AllowDelete enables the commands that delete stored data.
+	// Commands are not authenticated, so any client that can reach
+	// the repo can delete data if this is set.
+	AllowDelete bool `json:"allow_delete"`
 
 	// NameN is the parsed name of the repo service.
 	NameN enc.Name
 }
 
+type TrustDomainConfig struct {
+	// Prefix is the name prefix of the application data.
+	Prefix string `json:"prefix"`
+	// Schema is the path to the compiled LVS trust schema.
+	Schema string `json:"schema"`
+	// List of trust anchor full names for this prefix.
+	TrustAnchors []string `json:"trust_anchors"`
+
+	// PrefixN is the parsed name prefix.
+	PrefixN enc.Name
+	// SchemaWire is the content of the schema file.
+	SchemaWire []byte
+	// TrustAnchorsN are the parsed trust anchor names.
+	TrustAnchorsN []enc.Name
+}
+
+type ClusterConfig struct {
+	// Node is the name of this instance, unique in the cluster.
+	// The keychain must have a key of this identity, with a certificate
+	// issued by one of the trust anchors, to sign catalog updates.
+	Node string `json:"node"`
+	// Replication is the number of instances that store each object.
+	// Zero replicates every object to all instances.
+	Replication int `json:"replication"`
+	// Prefixes limits the objects replicated by this instance.
+	// All objects are replicated if empty.
+	Prefixes []string `json:"prefixes"`
+	// MulticastPrefix is prepended to the cluster Sync Interests.
+	MulticastPrefix string `json:"multicast_prefix"`
+
+	// NodeN is the parsed name of this instance.
+	NodeN enc.Name
+	// PrefixesN are the parsed partition prefixes.
+	PrefixesN []enc.Name
+	// MulticastPrefixN is the parsed multicast prefix.
+	MulticastPrefixN enc.Name
+}
+
 // Parses the repository name into a structured format and ensures the storage directory exists, creating it if necessary, for a valid configuration setup.
 func (c *Config) Parse() (err error) {
 	c.NameN, err = enc.NameFromStr(c.Name)
@@ -41,6 +87,71 @@ func (c *Config) Parse() (err error) {
 		}
 		c.StorageDir = path
 	}
+
+	for _, trust := range c.Trust {
+		if err := trust.Parse(); err != nil {
+			return err
+		}
+	}
+
+	if c.Cluster != nil {
+		if err := c.Cluster.Parse(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Parses the node name, partition prefixes and multicast prefix of the cluster.
+func (c *ClusterConfig) Parse() (err error) {
+	c.NodeN, err = enc.NameFromStr(c.Node)
+	if err != nil || len(c.NodeN) == 0 {
+		return fmt.Errorf("failed to parse or invalid cluster node name (%s): %w", c.Node, err)
+	}
+
+	if c.Replication < 0 {
+		return fmt.Errorf("invalid cluster replication factor: %d", c.Replication)
+	}
+
+	c.PrefixesN = make([]enc.Name, len(c.Prefixes))
+	for i, prefix := range c.Prefixes {
+		c.PrefixesN[i], err = enc.NameFromStr(prefix)
+		if err != nil {
+			return fmt.Errorf("failed to parse cluster prefix (%s): %w", prefix, err)
+		}
+	}
+
+	if c.MulticastPrefix != "" {
+		c.MulticastPrefixN, err = enc.NameFromStr(c.MulticastPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to parse cluster multicast prefix (%s): %w", c.MulticastPrefix, err)
+		}
+	}
+	return nil
+}
+
+// Parses the prefix and trust anchor names of a trust domain and reads the compiled LVS schema from disk.
+func (c *TrustDomainConfig) Parse() (err error) {
+	c.PrefixN, err = enc.NameFromStr(c.Prefix)
+	if err != nil || len(c.PrefixN) == 0 {
+		return fmt.Errorf("failed to parse or invalid trust prefix (%s): %w", c.Prefix, err)
+	}
+
+	c.SchemaWire, err = os.ReadFile(c.Schema)
+	if err != nil {
+		return fmt.Errorf("failed to read trust schema for %s: %w", c.Prefix, err)
+	}
+
+	if len(c.TrustAnchors) == 0 {
+		return fmt.Errorf("no trust anchors for %s", c.Prefix)
+	}
+	c.TrustAnchorsN = make([]enc.Name, len(c.TrustAnchors))
+	for i, ta := range c.TrustAnchors {
+		c.TrustAnchorsN[i], err = enc.NameFromStr(ta)
+		if err != nil {
+			return fmt.Errorf("failed to parse trust anchor name (%s): %w", ta, err)
+		}
+	}
 	return nil
 }
 
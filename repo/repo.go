@@ -2,7 +2,10 @@ package repo
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/named-data/ndnd/repo/tlv"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/engine"
 	"github.com/named-data/ndnd/std/engine/basic"
@@ -18,19 +21,38 @@ import (
 type Repo struct {
 	config *Config
 
-	engine ndn.Engine
-	store  ndn.Store
-	client ndn.Client
-
-	groupsSvs map[string]*RepoSvs
-	mutex     sync.Mutex
+	engine   ndn.Engine
+	store    ndn.Store
+	client   ndn.Client
+	keychain ndn.KeyChain
+
+	groups  map[string]RepoGroup
+	mutex   sync.Mutex
+	cluster *RepoCluster
+
+	trust      []*repoTrust
+	trustMutex sync.RWMutex
+	// number of Data packets that failed validation
+	rejected atomic.Uint64
+
+	startTime      time.Time
+	statusStore    ndn.Store
+	statusVersions []statusVersion
+	statusMutex    sync.Mutex
+	// time and result of the last count of the store contents
+	countTime time.Time
+	counted   []*tlv.PrefixStatus
+	countErr  error
+	// time and result of the last write check of the store
+	probeTime time.Time
+	probeErr  error
 }
 
 // Constructs a new Repo instance with the provided configuration, initializing an empty map for storing groups services.
 func NewRepo(config *Config) *Repo {
 	return &Repo{
-		config:    config,
-		groupsSvs: make(map[string]*RepoSvs),
+		config: config,
+		groups: make(map[string]RepoGroup),
 	}
 }
 
@@ -42,6 +64,7 @@ func (r *Repo) String() string {
 // Initializes and starts the NDN Data Repository by setting up storage, creating an NDN engine, configuring trust management, and launching a client to handle data and management interests under the repository's prefix.
 func (r *Repo) Start() (err error) {
 	log.Info(r, "Starting NDN Data Repository", "dir", r.config.StorageDir)
+	r.startTime = time.Now()
 
 	// Make object store database
 	r.store, err = storage.NewBadgerStore(r.config.StorageDir + "/badger")
@@ -58,28 +81,32 @@ func (r *Repo) Start() (err error) {
 
 	// TODO: Trust config may be specific to application
 	// This may need us to make a client for each app
-	kc, err := keychain.NewKeyChain(r.config.KeyChainUri, r.store)
+	r.keychain, err = keychain.NewKeyChain(r.config.KeyChainUri, r.store)
 	if err != nil {
 		return err
 	}
 
-	// TODO: specify a real trust schema
-	// TODO: handle app-specific case
+	// Application data is validated with the trust schema of its prefix.
+	// This schema is only used for management commands and other data.
 	schema := trust_schema.NewNullSchema()
-
-	// TODO: handle app-specific case
 	anchors := r.config.TrustAnchorNames()
 
 	// Create trust config
-	trust, err := sec.NewTrustConfig(kc, schema, anchors)
+	trust, err := sec.NewTrustConfig(r.keychain, schema, anchors)
 	if err != nil {
 		return err
 	}
 
 	// Attach data name as forwarding hint to cert Interests
-	// TODO: what to do if this is app dependent? Separate client for each app?
 	trust.UseDataNameFwHint = true
 
+	// Trust schemas for application data prefixes
+	for _, domain := range r.config.Trust {
+		if err := r.addTrust(domain.PrefixN, domain.SchemaWire, domain.TrustAnchorsN); err != nil {
+			return err
+		}
+	}
+
 	// Start NDN Object API client
 	r.client = object.NewClient(r.engine, r.store, trust)
 	if err := r.client.Start(); err != nil {
@@ -87,7 +114,8 @@ func (r *Repo) Start() (err error) {
 	}
 
 	// Attach managmemt interest handler
-	if err := r.client.AttachCommandHandler(r.config.NameN, r.onMgmtCmd); err != nil {
+	// Other names under the service prefix are served from the store.
+	if err := r.client.AttachCommandHandler(r.cmdPrefix(), r.onMgmtCmd); err != nil {
 		return err
 	}
 	r.client.AnnouncePrefix(ndn.Announcement{
@@ -95,6 +123,22 @@ func (r *Repo) Start() (err error) {
 		Expose: true,
 	})
 
+	// Serve the status dataset
+	if err := r.startStatus(); err != nil {
+		return err
+	}
+
+	// Rejoin all groups from before the restart
+	r.restoreGroups()
+
+	// Replicate objects with other instances of the service
+	if r.config.Cluster != nil {
+		r.cluster = NewRepoCluster(r, r.config.Cluster)
+		if err := r.cluster.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -102,13 +146,20 @@ func (r *Repo) Start() (err error) {
 func (r *Repo) Stop() error {
 	log.Info(r, "Stopping NDN Data Repository")
 
-	for _, svs := range r.groupsSvs {
-		svs.Stop()
+	if r.cluster != nil {
+		r.cluster.Stop()
+	}
+
+	for _, group := range r.groups {
+		group.Stop()
 	}
-	clear(r.groupsSvs)
+	clear(r.groups)
 
 	r.client.WithdrawPrefix(r.config.NameN, nil)
-	if err := r.client.DetachCommandHandler(r.config.NameN); err != nil {
+	if err := r.engine.DetachHandler(r.statusPrefix()); err != nil {
+		log.Warn(r, "Failed to detach status handler", "err", err)
+	}
+	if err := r.client.DetachCommandHandler(r.cmdPrefix()); err != nil {
 		log.Warn(r, "Failed to detach command handler", "err", err)
 	}
 
@@ -122,18 +173,59 @@ func (r *Repo) Stop() error {
 	return nil
 }
 
+// cmdPrefix is the prefix of management commands.
+func (r *Repo) cmdPrefix() enc.Name {
+	return r.config.NameN.Append(enc.NewKeywordComponent("cmd"))
+}
+
+// statePrefix is the prefix of the internal state of the repo in the store.
+// The client serves every name in the store, so the state is kept under
+// /localhost, which is never forwarded to the repo from the network.
+func (r *Repo) statePrefix() enc.Name {
+	return enc.Name{enc.LOCALHOST, enc.NewGenericComponent("repo")}.Append(r.config.NameN...)
+}
+
+// isRepoState checks if removing a name could remove the state of the repo,
+// which is stored under the service name and the state prefix.
+func (r *Repo) isRepoState(name enc.Name) bool {
+	for _, prefix := range []enc.Name{r.config.NameN, r.statePrefix()} {
+		if prefix.IsPrefix(name) || name.IsPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // setupEngineHook sets up the hook to persist all data.
 func (r *Repo) setupEngineHook() {
 	r.engine.(*basic.Engine).OnDataHook = func(data ndn.Data, raw enc.Wire, sigCov enc.Wire) error {
 		// This is very hacky, improve if possible.
 		// Assume that if there is a version it is the second-last component.
 		// We might not want to store non-versioned data anyway (?)
-		if ver := data.Name().At(-2); ver.IsVersion() {
+		if ver := data.Name().At(-2); !ver.IsVersion() {
+			log.Trace(r, "Ignoring non-versioned data", "name", data.Name())
+			return nil
+		}
+
+		// Data without a trust schema is stored as-is
+		client, ok := r.clientFor(data.Name())
+		if !ok {
 			log.Trace(r, "Storing data", "name", data.Name())
 			return r.store.Put(data.Name(), raw.Join())
-		} else {
-			log.Trace(r, "Ignoring non-versioned data", "name", data.Name())
 		}
+
+		// Application data is stored only after validation
+		wire := raw.Join()
+		client.Validate(data, sigCov, func(valid bool, err error) {
+			if !valid {
+				r.rejectData(data.Name(), err)
+				return
+			}
+			log.Trace(r, "Storing validated data", "name", data.Name())
+			if err := r.store.Put(data.Name(), wire); err != nil {
+				log.Warn(r, "Failed to store data", "name", data.Name(), "err", err)
+			}
+		})
 		return nil
 	}
 }
@@ -0,0 +1,658 @@
+package repo
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object"
+	sec "github.com/named-data/ndnd/std/security"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+)
+
+const (
+	// clusterRetryInterval is the delay before retrying a failed replication.
+	clusterRetryInterval = 10 * time.Second
+	// clusterHeartbeatInterval is the longest time an instance stays silent.
+	// An empty update is published if nothing else was published.
+	clusterHeartbeatInterval = time.Minute
+	// clusterMemberTimeout is the time after which a silent instance is no
+	// longer counted as a holder of its objects.
+	clusterMemberTimeout = 3 * clusterHeartbeatInterval
+)
+
+// RepoCluster replicates objects between instances of the same repo service.
+// Instances exchange their catalogs in an SVS group, and each instance fetches
+// the objects it is responsible for. With a replication factor, the instances
+// that store a missing replica are chosen by rendezvous hashing of the object name.
+//
+// Catalog updates are validated with the cluster trust schema, so each
+// instance needs a key of its node name in the keychain, with a certificate
+// issued by one of the trust anchors of the repo.
+type RepoCluster struct {
+	repo   *Repo
+	config *ClusterConfig
+	svsalo *ndn_sync.SvsALO
+	// trust validates the publications of the cluster group
+	trust *repoTrust
+	// stop stops the heartbeat
+	stop chan struct{}
+
+	mutex sync.Mutex
+	// self is the TLV string of the name of this instance
+	self string
+	// members are the instances heard from, with the time they were last heard
+	members map[string]time.Time
+	// lastPublish is the time of the last publication of this instance
+	lastPublish time.Time
+	// objects is the cluster catalog
+	objects map[string]*clusterObject
+	// fetching are the objects currently being replicated
+	fetching map[string]bool
+	// announced are the prefixes announced by this instance
+	announced map[string]enc.Name
+	// stopped is set when the cluster is stopped
+	stopped bool
+}
+
+// clusterObject is an entry in the cluster catalog.
+type clusterObject struct {
+	name    enc.Name
+	holders map[string]bool
+}
+
+// Constructs a new RepoCluster for the repo with the provided cluster configuration.
+func NewRepoCluster(repo *Repo, config *ClusterConfig) *RepoCluster {
+	self := config.NodeN.TlvStr()
+	return &RepoCluster{
+		repo:      repo,
+		config:    config,
+		self:      self,
+		members:   map[string]time.Time{self: {}},
+		objects:   make(map[string]*clusterObject),
+		fetching:  make(map[string]bool),
+		announced: make(map[string]enc.Name),
+	}
+}
+
+// Returns a string representation of the cluster, including the name of this instance.
+func (c *RepoCluster) String() string {
+	return "repo-cluster (" + c.config.NodeN.String() + ")"
+}
+
+// groupPrefix is the prefix of the cluster sync group.
+func (c *RepoCluster) groupPrefix() enc.Name {
+	return c.repo.config.NameN.Append(enc.NewKeywordComponent("cluster"))
+}
+
+// catalogPrefix is the prefix of the store records of the objects held by this instance.
+func (c *RepoCluster) catalogPrefix() enc.Name {
+	return c.repo.statePrefix().Append(enc.NewKeywordComponent("cluster-catalog"))
+}
+
+// Joins the cluster sync group, announces the prefixes served by this instance
+// and publishes the objects it already holds.
+func (c *RepoCluster) Start() (err error) {
+	log.Info(c, "Starting cluster", "replication", c.config.Replication)
+
+	// Publications are signed with the key of this instance
+	schema := &clusterSchema{
+		group:   c.groupPrefix(),
+		anchors: c.repo.config.TrustAnchorNames(),
+	}
+	pubPrefix := c.groupPrefix().Append(c.config.NodeN...).Append(enc.NewTimestampComponent(0))
+	if schema.Suggest(pubPrefix, c.repo.keychain) == nil {
+		return fmt.Errorf("no key for cluster node %s in keychain", c.config.NodeN)
+	}
+
+	trust, err := sec.NewTrustConfig(c.repo.keychain, schema, schema.anchors)
+	if err != nil {
+		return err
+	}
+	trust.UseDataNameFwHint = true
+
+	// Replicated publications are validated by the engine hook as well
+	c.trust = &repoTrust{
+		prefix: c.groupPrefix(),
+		client: object.NewClient(c.repo.engine, c.repo.store, trust),
+	}
+	c.repo.setTrust(c.trust)
+
+	c.svsalo, err = ndn_sync.NewSvsALO(ndn_sync.SvsAloOpts{
+		Name: c.config.NodeN,
+		Svs: ndn_sync.SvSyncOpts{
+			Client:      c.trust.client,
+			GroupPrefix: c.groupPrefix(),
+			TrustSchema: schema,
+		},
+		MulticastPrefix: c.config.MulticastPrefixN,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.svsalo.SetOnError(func(err error) {
+		log.Warn(c, "Cluster sync error", "err", err)
+	})
+	c.svsalo.SetOnPublisher(func(node enc.Name) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		c.members[node.TlvStr()] = time.Now()
+	})
+	c.svsalo.SubscribePublisher(enc.Name{}, c.onUpdate)
+
+	// Other instances fetch catalog updates from this prefix
+	c.repo.client.AnnouncePrefix(ndn.Announcement{
+		Name:   c.svsalo.GroupPrefix(),
+		Cost:   1000,
+		Expose: true,
+	})
+	if c.config.MulticastPrefixN != nil {
+		c.repo.client.AnnouncePrefix(ndn.Announcement{
+			Name:   c.svsalo.SyncPrefix(),
+			Cost:   1000,
+			Expose: true,
+		})
+	}
+
+	// Partitioned instances serve their whole partition
+	c.mutex.Lock()
+	for _, prefix := range c.config.PrefixesN {
+		c.announce(prefix)
+	}
+	stored := c.readCatalog()
+	for _, name := range stored {
+		c.addHolder(name, c.self)
+		c.announce(c.servedPrefix(name))
+	}
+	c.mutex.Unlock()
+
+	if err = c.svsalo.Start(); err != nil {
+		return err
+	}
+
+	// Other instances may have missed our catalog before a restart
+	if len(stored) > 0 {
+		c.publish(&tlv.ClusterUpdate{Stored: nameContainers(stored)})
+	}
+
+	c.stop = make(chan struct{})
+	go c.heartbeat(c.stop)
+
+	return nil
+}
+
+// Stops the cluster sync group and withdraws all prefixes announced by this instance.
+func (c *RepoCluster) Stop() error {
+	log.Info(c, "Stopping cluster")
+
+	c.mutex.Lock()
+	c.stopped = true
+	for _, prefix := range c.announced {
+		c.repo.client.WithdrawPrefix(prefix, nil)
+	}
+	clear(c.announced)
+	c.mutex.Unlock()
+
+	if c.stop != nil {
+		close(c.stop)
+	}
+	if c.trust != nil {
+		c.repo.resetTrust(c.trust, nil)
+	}
+	if c.svsalo == nil {
+		return nil
+	}
+
+	c.repo.client.WithdrawPrefix(c.svsalo.GroupPrefix(), nil)
+	if c.config.MulticastPrefixN != nil {
+		c.repo.client.WithdrawPrefix(c.svsalo.SyncPrefix(), nil)
+	}
+
+	return c.svsalo.Stop()
+}
+
+// ObjectStored adds an object stored by this instance to the cluster catalog.
+func (c *RepoCluster) ObjectStored(name enc.Name) {
+	c.mutex.Lock()
+	c.addHolder(name, c.self)
+	delete(c.fetching, name.TlvStr())
+	c.announce(c.servedPrefix(name))
+	c.saveObject(name)
+	c.mutex.Unlock()
+
+	c.publish(&tlv.ClusterUpdate{
+		Stored: []*spec.NameContainer{{Name: name}},
+	})
+}
+
+// ObjectRemoved removes all objects under a name from the cluster.
+// The removal is propagated to all other instances.
+func (c *RepoCluster) ObjectRemoved(name enc.Name) {
+	c.mutex.Lock()
+	c.removeObjects(name)
+	c.mutex.Unlock()
+
+	c.publish(&tlv.ClusterUpdate{
+		Removed: []*spec.NameContainer{{Name: name}},
+	})
+}
+
+// GroupJoined propagates a sync group joined by this instance to all other instances.
+func (c *RepoCluster) GroupJoined(cmd *tlv.SyncJoin) {
+	c.publish(&tlv.ClusterUpdate{Joined: []*tlv.SyncJoin{cmd}})
+}
+
+// GroupLeft propagates a sync group left by this instance to all other instances.
+// The data of the group is deleted by the other instances if requested by the command.
+func (c *RepoCluster) GroupLeft(cmd *tlv.SyncLeave) {
+	c.publish(&tlv.ClusterUpdate{Left: []*tlv.SyncLeave{cmd}})
+}
+
+// onUpdate processes a catalog update published by another instance.
+func (c *RepoCluster) onUpdate(pub ndn_sync.SvsPub) {
+	update, err := tlv.ParseClusterUpdate(enc.NewWireView(pub.Content), false)
+	if err != nil {
+		log.Warn(c, "Failed to parse cluster update", "publisher", pub.Publisher, "err", err)
+		return
+	}
+	c.applyUpdate(pub.Publisher.TlvStr(), update)
+}
+
+// applyUpdate applies a catalog update of another instance, joins and leaves
+// the sync groups of the update, and fetches the objects this instance is responsible for.
+func (c *RepoCluster) applyUpdate(node string, update *tlv.ClusterUpdate) {
+	c.mutex.Lock()
+	c.members[node] = time.Now()
+	for _, removed := range update.Removed {
+		// Removals never cover the whole store or the state of the repo
+		if removed == nil || len(removed.Name) == 0 || c.repo.isRepoState(removed.Name) {
+			log.Warn(c, "Ignoring invalid cluster removal", "publisher", node)
+			continue
+		}
+		c.removeObjects(removed.Name)
+	}
+
+	fetch := make([]enc.Name, 0, len(update.Stored))
+	for _, stored := range update.Stored {
+		if stored == nil || len(stored.Name) == 0 {
+			continue
+		}
+		obj := c.addHolder(stored.Name, node)
+		if c.shouldFetch(obj) {
+			c.fetching[obj.name.TlvStr()] = true
+			fetch = append(fetch, obj.name)
+		}
+	}
+	c.mutex.Unlock()
+
+	// Group membership is replicated within the partition of this instance
+	for _, cmd := range update.Joined {
+		if cmd == nil || cmd.Group == nil || !c.inPartition(cmd.Group.Name) {
+			continue
+		}
+		if res := c.repo.joinGroup(cmd); res.Status != 200 {
+			log.Warn(c, "Failed to join cluster sync group", "group", cmd.Group.Name, "status", res.Status)
+		}
+	}
+	for _, cmd := range update.Left {
+		if cmd == nil || cmd.Group == nil || len(cmd.Group.Name) == 0 {
+			continue
+		}
+		// Deletions never cover the state of the repo
+		if cmd.DeleteData && c.repo.isRepoState(cmd.Group.Name) {
+			log.Warn(c, "Ignoring invalid cluster group deletion", "publisher", node)
+			continue
+		}
+		c.repo.leaveGroup(cmd)
+	}
+
+	for _, name := range fetch {
+		c.fetch(name)
+	}
+}
+
+// fetch replicates an object from the other instances.
+// The engine hook validates and persists the fetched data.
+func (c *RepoCluster) fetch(name enc.Name) {
+	log.Debug(c, "Replicating object", "name", name)
+
+	client, _ := c.repo.clientFor(name)
+	client.Consume(name, func(status ndn.ConsumeState) {
+		if err := status.Error(); err != nil {
+			log.Warn(c, "Failed to replicate object", "name", name, "err", err)
+			time.AfterFunc(clusterRetryInterval, func() { c.retry(name) })
+			return
+		}
+
+		log.Info(c, "Replicated object", "name", name)
+		c.ObjectStored(name)
+	})
+}
+
+// retry fetches an object again if it still needs a replica on this instance.
+func (c *RepoCluster) retry(name enc.Name) {
+	c.mutex.Lock()
+	delete(c.fetching, name.TlvStr())
+	obj := c.objects[name.TlvStr()]
+	retry := !c.stopped && obj != nil && c.shouldFetch(obj)
+	if retry {
+		c.fetching[name.TlvStr()] = true
+	}
+	c.mutex.Unlock()
+
+	if retry {
+		c.fetch(name)
+	}
+}
+
+// shouldFetch checks if this instance is responsible for a missing replica of an object.
+// Only replicas held by current members are counted. The members that do not hold
+// the object are ranked by rendezvous hashing, and the highest ranked instances
+// fetch the missing replicas.
+func (c *RepoCluster) shouldFetch(obj *clusterObject) bool {
+	if obj.holders[c.self] || c.fetching[obj.name.TlvStr()] || !c.inPartition(obj.name) {
+		return false
+	}
+	if c.config.Replication == 0 {
+		return true
+	}
+
+	missing := c.config.Replication
+	candidates := make([]string, 0, len(c.members))
+	for node := range c.members {
+		if obj.holders[node] {
+			missing--
+		} else {
+			candidates = append(candidates, node)
+		}
+	}
+	if missing <= 0 {
+		return false
+	}
+
+	key := obj.name.TlvStr()
+	slices.SortFunc(candidates, func(a, b string) int {
+		if r := cmp.Compare(rendezvousScore(b, key), rendezvousScore(a, key)); r != 0 {
+			return r
+		}
+		return strings.Compare(a, b)
+	})
+
+	rank := slices.Index(candidates, c.self)
+	return rank >= 0 && rank < missing
+}
+
+// inPartition checks if an object is replicated by this instance.
+func (c *RepoCluster) inPartition(name enc.Name) bool {
+	if len(c.config.PrefixesN) == 0 {
+		return true
+	}
+	for _, prefix := range c.config.PrefixesN {
+		if prefix.IsPrefix(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// servedPrefix is the prefix announced for an object held by this instance.
+// Objects in a partition are covered by the partition prefix.
+func (c *RepoCluster) servedPrefix(name enc.Name) enc.Name {
+	for _, prefix := range c.config.PrefixesN {
+		if prefix.IsPrefix(name) {
+			return prefix
+		}
+	}
+	if name.At(-1).IsVersion() {
+		return name.Prefix(-1)
+	}
+	return name
+}
+
+// addHolder records that an instance holds an object.
+func (c *RepoCluster) addHolder(name enc.Name, node string) *clusterObject {
+	key := name.TlvStr()
+	obj := c.objects[key]
+	if obj == nil {
+		obj = &clusterObject{name: name.Clone(), holders: make(map[string]bool)}
+		c.objects[key] = obj
+	}
+	obj.holders[node] = true
+	return obj
+}
+
+// removeObjects removes all objects under a name from the catalog and the store.
+func (c *RepoCluster) removeObjects(name enc.Name) {
+	changed := false
+	for key, obj := range c.objects {
+		if !name.IsPrefix(obj.name) {
+			continue
+		}
+		if obj.holders[c.self] {
+			if err := c.repo.store.RemovePrefix(obj.name); err != nil {
+				log.Warn(c, "Failed to remove object", "name", obj.name, "err", err)
+			}
+			c.forgetObject(obj.name)
+			changed = true
+		}
+		delete(c.objects, key)
+	}
+	if !changed {
+		return
+	}
+
+	// Withdraw object prefixes that are no longer served
+	for key, prefix := range c.announced {
+		if slices.ContainsFunc(c.config.PrefixesN, prefix.Equal) {
+			continue
+		}
+		served := false
+		for _, obj := range c.objects {
+			if obj.holders[c.self] && prefix.IsPrefix(obj.name) {
+				served = true
+				break
+			}
+		}
+		if !served {
+			c.repo.client.WithdrawPrefix(prefix, nil)
+			delete(c.announced, key)
+		}
+	}
+}
+
+// announce announces a prefix served by this instance if not already announced.
+func (c *RepoCluster) announce(prefix enc.Name) {
+	key := prefix.TlvStr()
+	if _, ok := c.announced[key]; ok || c.stopped {
+		return
+	}
+	c.announced[key] = prefix
+	c.repo.client.AnnouncePrefix(ndn.Announcement{
+		Name:   prefix,
+		Expose: true,
+	})
+}
+
+// publish publishes a catalog update to the cluster.
+func (c *RepoCluster) publish(update *tlv.ClusterUpdate) {
+	c.mutex.Lock()
+	c.lastPublish = time.Now()
+	c.mutex.Unlock()
+
+	if _, _, err := c.svsalo.Publish(update.Encode()); err != nil {
+		log.Error(c, "Failed to publish cluster update", "err", err)
+	}
+}
+
+// heartbeat keeps this instance alive in the cluster and expires silent members.
+func (c *RepoCluster) heartbeat(stop <-chan struct{}) {
+	ticker := time.NewTicker(clusterHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			c.mutex.Lock()
+			idle := now.Sub(c.lastPublish) >= clusterHeartbeatInterval
+			c.mutex.Unlock()
+
+			if idle {
+				c.publish(&tlv.ClusterUpdate{})
+			}
+			c.expireMembers(now)
+		}
+	}
+}
+
+// expireMembers removes the instances not heard from for clusterMemberTimeout,
+// and fetches the replicas they held that this instance is now responsible for.
+// The objects of an expired instance are counted again once it is heard from.
+func (c *RepoCluster) expireMembers(now time.Time) {
+	c.mutex.Lock()
+	expired := false
+	for node, seen := range c.members {
+		if node != c.self && now.Sub(seen) >= clusterMemberTimeout {
+			log.Info(c, "Cluster member expired", "node", node)
+			delete(c.members, node)
+			expired = true
+		}
+	}
+
+	fetch := make([]enc.Name, 0)
+	if expired && !c.stopped {
+		for key, obj := range c.objects {
+			if c.shouldFetch(obj) {
+				c.fetching[key] = true
+				fetch = append(fetch, obj.name)
+			}
+		}
+	}
+	c.mutex.Unlock()
+
+	for _, name := range fetch {
+		c.fetch(name)
+	}
+}
+
+// saveObject persists an object held by this instance.
+// Each object is a separate record, so the catalog is never rewritten.
+func (c *RepoCluster) saveObject(name enc.Name) {
+	if err := c.repo.store.Put(c.catalogPrefix().Append(name...), name.Bytes()); err != nil {
+		log.Error(c, "Failed to persist cluster catalog", "name", name, "err", err)
+	}
+}
+
+// forgetObject removes a persisted object that is no longer held by this instance.
+func (c *RepoCluster) forgetObject(name enc.Name) {
+	if err := c.repo.store.Remove(c.catalogPrefix().Append(name...)); err != nil {
+		log.Error(c, "Failed to remove object from cluster catalog", "name", name, "err", err)
+	}
+}
+
+// readCatalog reads the objects held by this instance before a restart.
+func (c *RepoCluster) readCatalog() []enc.Name {
+	stored := make([]enc.Name, 0)
+	wires, iterErr := c.repo.store.Iter(c.catalogPrefix())
+	for key, wire := range wires {
+		name, err := enc.NameFromBytes(wire)
+		if err != nil || len(name) == 0 {
+			log.Warn(c, "Failed to parse persisted cluster catalog", "key", key, "err", err)
+			continue
+		}
+		stored = append(stored, name)
+	}
+	if err := iterErr(); err != nil {
+		log.Error(c, "Failed to read persisted cluster catalog", "err", err)
+	}
+	return stored
+}
+
+// nameContainers wraps a list of names for encoding.
+func nameContainers(names []enc.Name) []*spec.NameContainer {
+	res := make([]*spec.NameContainer, len(names))
+	for i, name := range names {
+		res[i] = &spec.NameContainer{Name: name}
+	}
+	return res
+}
+
+// rendezvousScore is the rendezvous hash of an instance for an object.
+func rendezvousScore(node string, object string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(node))
+	h.Write([]byte(object))
+	return h.Sum64()
+}
+
+// clusterSchema is the trust schema of the cluster sync group.
+// Each instance publishes under its node name with a key of the same
+// identity, certified directly by one of the trust anchors of the repo.
+type clusterSchema struct {
+	group   enc.Name
+	anchors []enc.Name
+}
+
+// Check allows publications of the identity of the key under the group prefix,
+// state vector entries of the same identity, and certificates issued by a trust anchor.
+func (s *clusterSchema) Check(pkt enc.Name, cert enc.Name) bool {
+	id := keyIdentity(cert)
+	if len(id) == 0 {
+		return false
+	}
+
+	// Publications are named /<group>/<node>/<boot time>/...
+	if s.group.IsPrefix(pkt) {
+		node := pkt[len(s.group):]
+		return len(node) > len(id) && id.IsPrefix(node) && node[len(id)].IsTimestamp()
+	}
+
+	// Certificates of the instances
+	if _, err := sec.GetKeyNameFromCertName(pkt); err == nil {
+		return slices.ContainsFunc(s.anchors, func(anchor enc.Name) bool {
+			return anchor.IsPrefix(cert)
+		})
+	}
+
+	// State vector entries are named by the node name
+	return id.Equal(pkt)
+}
+
+// Suggest returns a signer with the first key in the keychain allowed to sign the name.
+func (s *clusterSchema) Suggest(name enc.Name, keychain ndn.KeyChain) ndn.Signer {
+	for _, id := range keychain.Identities() {
+		for _, key := range id.Keys() {
+			for _, cert := range key.UniqueCerts() {
+				if s.Check(name, cert) {
+					return &sig.ContextSigner{
+						Signer:         key.Signer(),
+						KeyLocatorName: cert[:len(cert)-1], // remove version
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// keyIdentity is the identity of a key or certificate name, or nil if the name is neither.
+func keyIdentity(name enc.Name) enc.Name {
+	for i := len(name) - 2; i > 0; i-- {
+		if name[i].IsGeneric("KEY") {
+			return name[:i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package repo
+
+import (
+	"crypto/elliptic"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sec "github.com/named-data/ndnd/std/security"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoClusterSchema(t *testing.T) {
+	tu.SetT(t)
+
+	name := func(s string) enc.Name { return tu.NoErr(enc.NameFromStr(s)) }
+	schema := &clusterSchema{
+		group:   name("/ndn/repo/32=cluster"),
+		anchors: []enc.Name{name("/ndn/KEY/a/self/v=1")},
+	}
+
+	tests := []struct {
+		pkt   string
+		cert  string
+		valid bool
+	}{
+		// Publications of the node of the key
+		{"/ndn/repo/32=cluster/node1/t=1/seq=1/v=0/seg=0", "/node1/KEY/k/ndn/v=1", true},
+		{"/ndn/repo/32=cluster/node1/t=1/32=svs/v=2", "/node1/KEY/k/ndn", true},
+		{"/ndn/repo/32=cluster/node2/t=1/seq=1/v=0/seg=0", "/node1/KEY/k/ndn/v=1", false},
+		{"/ndn/repo/32=cluster/node1/sub/t=1/seq=1", "/node1/KEY/k/ndn/v=1", false},
+		{"/ndn/repo/32=cluster/node1", "/node1/KEY/k/ndn/v=1", false},
+		// State vector entries of the node of the key
+		{"/node1", "/node1/KEY/k/ndn", true},
+		{"/node2", "/node1/KEY/k/ndn", false},
+		// Certificates are issued by a trust anchor
+		{"/node1/KEY/k/ndn/v=1", "/ndn/KEY/a/self/v=1", true},
+		{"/node2/KEY/k/ndn/v=1", "/node1/KEY/k/ndn/v=1", false},
+		// Other data and signers
+		{"/ndn/app/obj/v=1/seg=0", "/node1/KEY/k/ndn/v=1", false},
+		{"/ndn/repo/32=cluster/node1/t=1/seq=1", "/node1", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.valid, schema.Check(name(test.pkt), name(test.cert)), "%s by %s", test.pkt, test.cert)
+	}
+}
+
+// Adds a key for each node to the keychain of its repo,
+// with a certificate issued by a common trust anchor.
+func clusterKeys(t *testing.T, repos []*Repo, nodes []enc.Name) {
+	anchorKey := tu.NoErr(sig.KeygenEcc(sec.MakeKeyName(tu.NoErr(enc.NameFromStr("/ndn"))), elliptic.P256()))
+	anchor := tu.NoErr(sec.SelfSign(sec.SignCertArgs{
+		Signer:    anchorKey,
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	})).Join()
+	anchorData, _, err := spec.Spec{}.ReadData(enc.NewBufferView(anchor))
+	require.NoError(t, err)
+
+	for i, r := range repos {
+		require.NoError(t, r.keychain.InsertCert(anchor))
+		r.config.TrustAnchors = []string{anchorData.Name().String()}
+
+		key := tu.NoErr(sig.KeygenEcc(sec.MakeKeyName(nodes[i]), elliptic.P256()))
+		cert := tu.NoErr(sec.SignCert(sec.SignCertArgs{
+			Signer:    anchorKey,
+			Data:      tu.NoErr(sig.MarshalSecretToData(key)),
+			IssuerId:  enc.NewGenericComponent("ndn"),
+			NotBefore: time.Now(),
+			NotAfter:  time.Now().Add(time.Hour),
+		}))
+		require.NoError(t, r.keychain.InsertKey(key))
+		require.NoError(t, r.keychain.InsertCert(cert.Join()))
+	}
+}
+
+// Checks if an object is in the catalog of a cluster with a holder.
+func clusterHolds(c *RepoCluster, name enc.Name, node enc.Name) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	obj := c.objects[name.TlvStr()]
+	return obj != nil && obj.holders[node.TlvStr()]
+}
+
+func TestRepoClusterReplicate(t *testing.T) {
+	tu.SetT(t)
+
+	a, aFace := newFaceRepo(t)
+	b, bFace := newFaceRepo(t)
+	linkFaces(t, aFace, bFace)
+
+	nodes := []enc.Name{
+		tu.NoErr(enc.NameFromStr("/node1")),
+		tu.NoErr(enc.NameFromStr("/node2")),
+	}
+	config := func(node enc.Name) *ClusterConfig {
+		return &ClusterConfig{NodeN: node, Replication: 1}
+	}
+
+	// Instances need a certified key of their node name
+	require.Error(t, NewRepoCluster(a, config(nodes[0])).Start())
+
+	clusterKeys(t, []*Repo{a, b}, nodes)
+	for i, r := range []*Repo{a, b} {
+		r.cluster = NewRepoCluster(r, config(nodes[i]))
+		require.NoError(t, r.cluster.Start())
+		t.Cleanup(func() { r.cluster.Stop() })
+	}
+
+	prefix := tu.NoErr(enc.NameFromStr("/ndn/app/obj"))
+	count := func(r *Repo, name enc.Name) int {
+		n := 0
+		wires, iterErr := r.store.Iter(name)
+		for range wires {
+			n++
+		}
+		require.NoError(t, iterErr())
+		return n
+	}
+
+	// Stored objects are persisted and published
+	version := tu.NoErr(a.client.Produce(ndn.ProduceArgs{
+		Name:    prefix.WithVersion(1),
+		Content: enc.Wire{make([]byte, 20000)},
+	}))
+	a.cluster.ObjectStored(version)
+	require.Equal(t, []enc.Name{version}, a.cluster.readCatalog())
+	require.Eventually(t, func() bool {
+		return clusterHolds(b.cluster, version, nodes[0])
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The object already has enough replicas
+	require.Zero(t, count(b, version))
+	require.Empty(t, b.cluster.readCatalog())
+
+	// A replica is fetched when its holder expires
+	b.cluster.expireMembers(time.Now().Add(clusterMemberTimeout))
+	require.Eventually(t, func() bool {
+		return clusterHolds(a.cluster, version, nodes[1])
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, count(a, version), count(b, version))
+	require.Equal(t, []enc.Name{version}, b.cluster.readCatalog())
+
+	// Expired instances are members again once heard from
+	b.cluster.applyUpdate(nodes[0].TlvStr(), &tlv.ClusterUpdate{})
+	b.cluster.mutex.Lock()
+	require.Contains(t, b.cluster.members, nodes[0].TlvStr())
+	b.cluster.mutex.Unlock()
+
+	// Removals of everything or of the repo state are ignored
+	b.cluster.applyUpdate(nodes[0].TlvStr(), &tlv.ClusterUpdate{
+		Removed: nameContainers([]enc.Name{{}, b.config.NameN, b.statePrefix(), {enc.LOCALHOST}}),
+	})
+	require.NotZero(t, count(b, version))
+	require.Equal(t, []enc.Name{version}, b.cluster.readCatalog())
+
+	// Removed objects are deleted from all instances
+	a.cluster.ObjectRemoved(prefix)
+	require.Zero(t, count(a, version))
+	require.Eventually(t, func() bool {
+		return count(b, version) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, b.cluster.readCatalog())
+	require.Empty(t, a.cluster.readCatalog())
+
+	// Sync groups are joined and left by all instances
+	group := tu.NoErr(enc.NameFromStr("/ndn/app/group"))
+	res := runCmd(t, func(reply func(enc.Wire) error) {
+		a.handleSyncJoin(syncJoin(group, tlv.SyncProtocolSvsV3), reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Eventually(t, func() bool {
+		return b.joined(group)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotZero(t, count(b, b.groupStateName(group)))
+
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		a.handleSyncLeave(&tlv.SyncLeave{Group: nameContainer(group)}, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Eventually(t, func() bool {
+		return !b.joined(group)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, count(b, b.groupStateName(group)))
+
+	// Groups outside the partition are not joined,
+	// and deletions of the repo state are ignored
+	b.cluster.config.PrefixesN = []enc.Name{prefix}
+	b.cluster.applyUpdate(nodes[0].TlvStr(), &tlv.ClusterUpdate{
+		Joined: []*tlv.SyncJoin{syncJoin(group, tlv.SyncProtocolSvsV3)},
+	})
+	require.False(t, b.joined(group))
+	b.cluster.config.PrefixesN = nil
+
+	repoGroup := b.config.NameN.Append(enc.NewGenericComponent("group"))
+	require.Equal(t, uint64(200), b.joinGroup(syncJoin(repoGroup, tlv.SyncProtocolSvsV3)).Status)
+	b.cluster.applyUpdate(nodes[0].TlvStr(), &tlv.ClusterUpdate{
+		Left: []*tlv.SyncLeave{{Group: nameContainer(repoGroup), DeleteData: true}},
+	})
+	require.True(t, b.joined(repoGroup))
+}
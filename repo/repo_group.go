@@ -0,0 +1,111 @@
+package repo
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// RepoGroup is a sync group joined by the repo.
+type RepoGroup interface {
+	fmt.Stringer
+	Start() error
+	Stop() error
+	// Status reports the sync state of the group.
+	Status() *tlv.GroupStatus
+}
+
+// groupPubHandler processes repo commands published in a sync group.
+type groupPubHandler struct {
+	client ndn.Client
+	group  enc.Name
+	// time of the last publication in unix milliseconds
+	lastPub atomic.Int64
+}
+
+// Returns a string representation of the handler, including the name of its group.
+func (r *groupPubHandler) String() string {
+	return fmt.Sprintf("repo-group (%s)", r.group)
+}
+
+// processIncomingPub checks if the given pub is a command for repo.
+func (r *groupPubHandler) processIncomingPub(w enc.Wire) {
+	r.lastPub.Store(time.Now().UnixMilli())
+
+	cmd, err := tlv.ParseRepoCmd(enc.NewWireView(w), false)
+	if err != nil {
+		// Likely application data.
+		return
+	}
+
+	if cmd.BlobFetch != nil && cmd.BlobFetch.Name != nil {
+		r.processBlobFetch(cmd.BlobFetch.Name.Name)
+	} else if cmd.BlobFetch != nil && len(cmd.BlobFetch.Data) > 0 {
+		r.processBlobStore(cmd.BlobFetch.Data)
+	}
+}
+
+// lastPublication returns the time of the last publication, if any.
+func (r *groupPubHandler) lastPublication() optional.Optional[uint64] {
+	if t := r.lastPub.Load(); t > 0 {
+		return optional.Some(uint64(t))
+	}
+	return optional.None[uint64]()
+}
+
+// processBlobFetch processes a BlobFetch command.
+func (r *groupPubHandler) processBlobFetch(name enc.Name) {
+	if !r.group.IsPrefix(name) {
+		log.Warn(r, "Ignoring BlobFetch outside group", "name", name)
+		return
+	}
+
+	// TODO: retry fetching if failed, even across restarts
+	// TODO: do not fetch blobs that are too large
+	// TODO: do not fetch blobs that are already stored (though this shouldn't happen)
+	r.client.Consume(name, func(status ndn.ConsumeState) {
+		if status.Error() != nil {
+			log.Warn(r, "BlobFetch error", "err", status.Error(), "name", name)
+			return
+		}
+		log.Info(r, "BlobFetch success", "name", name)
+	})
+}
+
+// processBlobStore directly stores data from the BlobFetch command.
+func (r *groupPubHandler) processBlobStore(data [][]byte) {
+	for _, w := range data {
+		data, sigCov, err := spec.Spec{}.ReadData(enc.NewBufferView(w))
+		if err != nil {
+			log.Warn(r, "BlobFetch store failed to parse data", "err", err)
+			continue
+		}
+		name := data.Name()
+
+		if !r.group.IsPrefix(name) {
+			log.Warn(r, "Ignoring BlobFetch store outside group", "name", name)
+			continue
+		}
+
+		r.client.Validate(data, sigCov, func(valid bool, err error) {
+			if !valid {
+				log.Warn(r, "BlobFetch store data validation failed", "name", name, "err", err)
+				return
+			}
+
+			if err := r.client.Store().Put(name, w); err != nil {
+				log.Warn(r, "BlobFetch store failed to store data", "err", err)
+				return
+			}
+
+			log.Info(r, "BlobFetch store success", "name", name)
+		})
+	}
+}
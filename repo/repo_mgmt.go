@@ -8,7 +8,7 @@ import (
 	"github.com/named-data/ndnd/std/log"
 )
 
-// Handles incoming management commands by parsing wire data, triggering asynchronous handling for SyncJoin commands, and logging warnings for parsing errors or unrecognized command types.
+// Handles incoming management commands by parsing wire data, triggering asynchronous handling for sync group and object commands, and logging warnings for parsing errors or unrecognized command types.
 func (r *Repo) onMgmtCmd(_ enc.Name, wire enc.Wire, reply func(enc.Wire) error) {
 	cmd, err := tlv.ParseRepoCmd(enc.NewWireView(wire), false)
 	if err != nil {
@@ -16,34 +16,141 @@ func (r *Repo) onMgmtCmd(_ enc.Name, wire enc.Wire, reply func(enc.Wire) error)
 		return
 	}
 
-	if cmd.SyncJoin != nil {
+	switch {
+	case cmd.SyncJoin != nil:
 		go r.handleSyncJoin(cmd.SyncJoin, reply)
 		return
+	case cmd.SyncLeave != nil:
+		go r.handleSyncLeave(cmd.SyncLeave, reply)
+		return
+	case cmd.BlobFetch != nil:
+		go r.handleBlobFetch(cmd.BlobFetch, reply)
+		return
+	case cmd.ObjInsert != nil:
+		go r.handleObjInsert(cmd.ObjInsert, reply)
+		return
+	case cmd.ObjDelete != nil:
+		go r.handleObjDelete(cmd.ObjDelete, reply)
+		return
+	case cmd.ObjStatus != nil:
+		go r.handleObjStatus(cmd.ObjStatus, reply)
+		return
 	}
 
 	log.Warn(r, "Unknown management command received")
 }
 
-// Handles a SyncJoin command by initiating the SVSv3 synchronization protocol if specified, returning appropriate status codes and logging errors or warnings based on protocol validity and operation success.
+// Handles a SyncJoin command by joining the group with the sync protocol (SVSv3 or PSync) specified and persisting the group, returning appropriate status codes and logging errors or warnings based on protocol validity and operation success.
+// Groups joined by a command are joined by the other instances of the cluster.
 func (r *Repo) handleSyncJoin(cmd *tlv.SyncJoin, reply func(enc.Wire) error) {
+	res := r.joinGroup(cmd)
+	reply(res.Encode())
+	if res.Status == 200 && r.cluster != nil {
+		r.cluster.GroupJoined(cmd)
+	}
+}
+
+// Joins and persists the sync group of a SyncJoin command.
+func (r *Repo) joinGroup(cmd *tlv.SyncJoin) tlv.RepoCmdRes {
 	res := tlv.RepoCmdRes{Status: 200}
 
-	if cmd.Protocol != nil && cmd.Protocol.Name.Equal(tlv.SyncProtocolSvsV3) {
-		if err := r.startSvs(cmd); err != nil {
-			res.Status = 500
-			log.Error(r, "Failed to start SVS", "err", err)
-		}
+	create := r.groupFactory(cmd)
+	if create == nil {
+		log.Warn(r, "Unknown sync protocol specified in command", "protocol", cmd.Protocol)
+		res.Status = 400
+		return res
+	}
+
+	// The trust schema of a running group is not changed
+	if cmd.Group != nil && r.joined(cmd.Group.Name) {
+		return res
+	}
+
+	if trust, anchors, err := r.groupTrust(cmd); err != nil {
+		res.Status = 400
+		res.Message = err.Error()
+		log.Warn(r, "Invalid trust schema in command", "err", err)
+	} else if err := r.startGroup(cmd, create, trust); err != nil {
+		r.removeAnchors(anchors)
+		res.Status = 500
+		log.Error(r, "Failed to join sync group", "err", err)
+	} else if err := r.persistGroup(cmd); err != nil {
+		res.Status = 500
+		log.Error(r, "Failed to persist sync group", "err", err)
+	}
+	return res
+}
+
+// Handles a SyncLeave command by stopping the group, removing it from the persisted group list, and optionally deleting all data stored under the group prefix.
+// Groups left by a command are left by the other instances of the cluster.
+func (r *Repo) handleSyncLeave(cmd *tlv.SyncLeave, reply func(enc.Wire) error) {
+	if cmd.Group == nil || len(cmd.Group.Name) == 0 {
+		reply(badRequest("missing group name"))
+		return
+	}
+
+	// Commands are not authenticated, so deletion must be enabled
+	if cmd.DeleteData && !r.config.AllowDelete {
+		res := tlv.RepoCmdRes{Status: 403, Message: "deletion is disabled"}
 		reply(res.Encode())
 		return
 	}
 
-	log.Warn(r, "Unknown sync protocol specified in command", "protocol", cmd.Protocol)
-	res.Status = 400
+	res := r.leaveGroup(cmd)
 	reply(res.Encode())
+	if res.Status == 200 && r.cluster != nil {
+		r.cluster.GroupLeft(cmd)
+	}
+}
+
+// Leaves the sync group of a SyncLeave command and removes it from the persisted groups.
+func (r *Repo) leaveGroup(cmd *tlv.SyncLeave) tlv.RepoCmdRes {
+	res := tlv.RepoCmdRes{Status: 200}
+	group := cmd.Group.Name
+
+	if !r.stopGroup(group) {
+		res.Status = 404
+		res.Message = "group not joined"
+		return res
+	}
+
+	if err := r.store.Remove(r.groupStateName(group)); err != nil {
+		res.Status = 500
+		log.Error(r, "Failed to remove persisted sync group", "err", err)
+	}
+
+	if cmd.DeleteData {
+		if err := r.store.RemovePrefix(group); err != nil {
+			res.Status = 500
+			log.Error(r, "Failed to delete sync group data", "err", err)
+		}
+	}
+
+	log.Info(r, "Left sync group", "group", group, "delete", cmd.DeleteData)
+	return res
 }
 
-// Initializes and starts a synchronization service for the specified group if not already active, using the provided SyncJoin command, ensuring thread-safe creation and error handling for missing group names.
-func (r *Repo) startSvs(cmd *tlv.SyncJoin) error {
+// Returns the constructor for a sync group with the protocol of the command, or nil if the protocol is unknown.
+func (r *Repo) groupFactory(cmd *tlv.SyncJoin) func() RepoGroup {
+	switch {
+	case cmd.Protocol != nil && cmd.Protocol.Name.Equal(tlv.SyncProtocolSvsV3):
+		return func() RepoGroup {
+			client, _ := r.clientFor(cmd.Group.Name)
+			return NewRepoSvs(r.config, client, cmd)
+		}
+	case cmd.Protocol != nil && cmd.Protocol.Name.Equal(tlv.SyncProtocolPSync):
+		return func() RepoGroup {
+			client, _ := r.clientFor(cmd.Group.Name)
+			return NewRepoPSync(r.config, client, cmd)
+		}
+	default:
+		return nil
+	}
+}
+
+// Initializes and starts a sync group if not already active, using the provided constructor, ensuring thread-safe creation and error handling for missing group names.
+// The trust configuration of the group (optional) is used only if the group is started.
+func (r *Repo) startGroup(cmd *tlv.SyncJoin, create func() RepoGroup, trust *repoTrust) error {
 	if cmd.Group == nil || len(cmd.Group.Name) == 0 {
 		return fmt.Errorf("missing group name")
 	}
@@ -53,16 +160,102 @@ func (r *Repo) startSvs(cmd *tlv.SyncJoin) error {
 
 	// Check if already started
 	hash := cmd.Group.Name.TlvStr()
-	if _, ok := r.groupsSvs[hash]; ok {
+	if _, ok := r.groups[hash]; ok {
 		return nil
 	}
 
 	// Start group
-	svs := NewRepoSvs(r.config, r.client, cmd)
-	if err := svs.Start(); err != nil {
+	var prevTrust *repoTrust
+	if trust != nil {
+		prevTrust = r.setTrust(trust)
+	}
+	group := create()
+	if err := group.Start(); err != nil {
+		if trust != nil {
+			r.resetTrust(trust, prevTrust)
+		}
 		return err
 	}
-	r.groupsSvs[hash] = svs
+	r.groups[hash] = group
 
 	return nil
 }
+
+// Stops a sync group and removes it from the active groups, returning false if the group was not joined.
+func (r *Repo) stopGroup(name enc.Name) bool {
+	hash := name.TlvStr()
+
+	r.mutex.Lock()
+	group, ok := r.groups[hash]
+	delete(r.groups, hash)
+	r.mutex.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	// Stopping may wait for the group, which must not hold the lock
+	if err := group.Stop(); err != nil {
+		log.Warn(r, "Failed to stop sync group", "group", name, "err", err)
+	}
+	return true
+}
+
+// Checks if a sync group is joined.
+func (r *Repo) joined(group enc.Name) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	_, ok := r.groups[group.TlvStr()]
+	return ok
+}
+
+// Returns the name under which the SyncJoin command of a group is persisted.
+func (r *Repo) groupStateName(group enc.Name) enc.Name {
+	return r.statePrefix().
+		Append(enc.NewKeywordComponent("groups")).
+		Append(group...)
+}
+
+// Saves the SyncJoin command of a group so the group is joined again after a restart.
+func (r *Repo) persistGroup(cmd *tlv.SyncJoin) error {
+	return r.store.Put(r.groupStateName(cmd.Group.Name), cmd.Encode().Join())
+}
+
+// Joins all sync groups persisted in the store, logging errors for groups that cannot be started.
+func (r *Repo) restoreGroups() {
+	prefix := r.statePrefix().Append(enc.NewKeywordComponent("groups"))
+
+	// The store must not be modified during iteration
+	cmds := make([]*tlv.SyncJoin, 0)
+	wires, iterErr := r.store.Iter(prefix)
+	for name, wire := range wires {
+		cmd, err := tlv.ParseSyncJoin(enc.NewBufferView(wire), false)
+		if err != nil {
+			log.Warn(r, "Failed to parse persisted sync group", "name", name, "err", err)
+			continue
+		}
+		cmds = append(cmds, cmd)
+	}
+	if err := iterErr(); err != nil {
+		log.Error(r, "Failed to read persisted sync groups", "err", err)
+	}
+
+	for _, cmd := range cmds {
+		create := r.groupFactory(cmd)
+		if create == nil {
+			log.Warn(r, "Unknown sync protocol in persisted group", "protocol", cmd.Protocol)
+			continue
+		}
+		trust, anchors, err := r.groupTrust(cmd)
+		if err != nil {
+			log.Error(r, "Invalid trust schema in persisted group", "err", err)
+			continue
+		}
+		if err := r.startGroup(cmd, create, trust); err != nil {
+			r.removeAnchors(anchors)
+			log.Error(r, "Failed to rejoin sync group", "err", err)
+			continue
+		}
+		log.Info(r, "Rejoined sync group", "group", cmd.Group.Name)
+	}
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// stopGroup is a group that runs a function when stopped.
+type stopGroup struct {
+	onStop func()
+}
+
+func (g *stopGroup) String() string           { return "stop-group" }
+func (g *stopGroup) Start() error             { return nil }
+func (g *stopGroup) Stop() error              { g.onStop(); return nil }
+func (g *stopGroup) Status() *tlv.GroupStatus { return &tlv.GroupStatus{} }
+
+func syncJoin(group enc.Name, protocol enc.Name) *tlv.SyncJoin {
+	return &tlv.SyncJoin{
+		Protocol: &spec.NameContainer{Name: protocol},
+		Group:    &spec.NameContainer{Name: group},
+	}
+}
+
+func TestRepoSyncJoinRestore(t *testing.T) {
+	tu.SetT(t)
+
+	r, _ := newTestRepo(t)
+	group := tu.NoErr(enc.NameFromStr("/ndn/app/group"))
+
+	// Unknown protocols are rejected
+	res := runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleSyncJoin(syncJoin(group, group), reply)
+	})
+	require.Equal(t, uint64(400), res.Status)
+	require.False(t, r.joined(group))
+
+	// Group is joined and persisted
+	cmd := syncJoin(group, tlv.SyncProtocolSvsV3)
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleSyncJoin(cmd, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.True(t, r.joined(group))
+	require.Equal(t, cmd.Encode().Join(), tu.NoErr(r.store.Get(r.groupStateName(group), false)))
+
+	// Joining again keeps the running group
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleSyncJoin(cmd, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Len(t, r.groups, 1)
+
+	require.True(t, r.stopGroup(group))
+
+	// Persisted groups are joined again after a restart
+	restarted, _ := newTestRepo(t)
+	restarted.store = r.store
+	restarted.restoreGroups()
+	require.True(t, restarted.joined(group))
+	require.Len(t, restarted.groups, 1)
+	require.True(t, restarted.stopGroup(group))
+}
+
+func TestRepoSyncLeave(t *testing.T) {
+	tu.SetT(t)
+
+	r, _ := newTestRepo(t)
+	group := tu.NoErr(enc.NameFromStr("/ndn/app/group"))
+	other := tu.NoErr(enc.NameFromStr("/ndn/app/other"))
+	leave := func(cmd *tlv.SyncLeave) *tlv.RepoCmdRes {
+		return runCmd(t, func(reply func(enc.Wire) error) {
+			r.handleSyncLeave(cmd, reply)
+		})
+	}
+
+	for _, name := range []enc.Name{group, other} {
+		res := runCmd(t, func(reply func(enc.Wire) error) {
+			r.handleSyncJoin(syncJoin(name, tlv.SyncProtocolPSync), reply)
+		})
+		require.Equal(t, uint64(200), res.Status)
+	}
+	data := group.Append(enc.NewGenericComponent("data"))
+	require.NoError(t, r.store.Put(data, []byte{0x06, 0x00}))
+
+	// Missing and unknown groups
+	require.Equal(t, uint64(400), leave(&tlv.SyncLeave{}).Status)
+	unknown := tu.NoErr(enc.NameFromStr("/ndn/app/unknown"))
+	require.Equal(t, uint64(404), leave(&tlv.SyncLeave{Group: &spec.NameContainer{Name: unknown}}).Status)
+
+	// Leaving keeps the data unless requested
+	require.Equal(t, uint64(200), leave(&tlv.SyncLeave{Group: &spec.NameContainer{Name: other}}).Status)
+	require.False(t, r.joined(other))
+	require.Nil(t, tu.NoErr(r.store.Get(r.groupStateName(other), false)))
+	require.Equal(t, uint64(404), leave(&tlv.SyncLeave{Group: &spec.NameContainer{Name: other}}).Status)
+
+	// Deleting the data must be enabled
+	require.Equal(t, uint64(403), leave(&tlv.SyncLeave{Group: &spec.NameContainer{Name: group}, DeleteData: true}).Status)
+	require.True(t, r.joined(group))
+	r.config.AllowDelete = true
+	require.Equal(t, uint64(200), leave(&tlv.SyncLeave{Group: &spec.NameContainer{Name: group}, DeleteData: true}).Status)
+	require.False(t, r.joined(group))
+	require.Nil(t, tu.NoErr(r.store.Get(data, false)))
+
+	// Left groups are not joined after a restart
+	r.restoreGroups()
+	require.Empty(t, r.groups)
+}
+
+// Tests that a group is stopped without holding the group lock.
+func TestRepoStopGroupUnlocked(t *testing.T) {
+	tu.SetT(t)
+
+	r := NewRepo(&Config{NameN: tu.NoErr(enc.NameFromStr("/ndn/repo"))})
+	group := tu.NoErr(enc.NameFromStr("/ndn/app/group"))
+	stopped := make(chan bool, 1)
+	create := func() RepoGroup {
+		return &stopGroup{onStop: func() { stopped <- r.joined(group) }}
+	}
+	require.NoError(t, r.startGroup(syncJoin(group, tlv.SyncProtocolSvsV3), create, nil))
+
+	done := make(chan bool, 1)
+	go func() { done <- r.stopGroup(group) }()
+	select {
+	case ok := <-done:
+		require.True(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "stopGroup blocked")
+	}
+	require.False(t, <-stopped)
+	require.False(t, r.stopGroup(group))
+}
@@ -0,0 +1,200 @@
+package repo
+
+import (
+	"fmt"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	rdr "github.com/named-data/ndnd/std/ndn/rdr_2024"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// Handles an ObjInsert command by fetching and validating the object, which is persisted by the engine hook, and replying with the versioned name of the object.
+func (r *Repo) handleObjInsert(cmd *tlv.ObjInsert, reply func(enc.Wire) error) {
+	if cmd.Name == nil || len(cmd.Name.Name) == 0 {
+		reply(badRequest("missing object name"))
+		return
+	}
+	r.insertObject(cmd.Name.Name, reply)
+}
+
+// Handles a BlobFetch command by either fetching the named object or validating and storing the attached Data packets.
+func (r *Repo) handleBlobFetch(cmd *tlv.BlobFetch, reply func(enc.Wire) error) {
+	if cmd.Name != nil && len(cmd.Name.Name) > 0 {
+		r.insertObject(cmd.Name.Name, reply)
+		return
+	}
+	if len(cmd.Data) == 0 {
+		reply(badRequest("missing object name or data"))
+		return
+	}
+
+	res := tlv.RepoCmdRes{Status: 200}
+	stored := uint64(0)
+	for _, wire := range cmd.Data {
+		data, sigCov, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+		if err != nil {
+			log.Warn(r, "BlobFetch failed to parse data", "err", err)
+			res.Status = 400
+			res.Message = err.Error()
+			continue
+		}
+
+		client, _ := r.clientFor(data.Name())
+		valid := make(chan error, 1)
+		client.Validate(data, sigCov, func(ok bool, err error) {
+			if !ok && err == nil {
+				err = fmt.Errorf("%w: invalid signature", ndn.ErrSecurity)
+			}
+			valid <- err
+		})
+		if err := <-valid; err != nil {
+			r.rejectData(data.Name(), err)
+			res.Status = 403
+			res.Message = err.Error()
+			continue
+		}
+
+		if err := r.store.Put(data.Name(), wire); err != nil {
+			log.Error(r, "BlobFetch failed to store data", "name", data.Name(), "err", err)
+			res.Status = 500
+			res.Message = err.Error()
+			continue
+		}
+		stored++
+	}
+
+	res.Count = optional.Some(stored)
+	reply(res.Encode())
+}
+
+// Fetches an object into the repo and replies with the result.
+func (r *Repo) insertObject(name enc.Name, reply func(enc.Wire) error) {
+	// Each segment is validated with the trust schema of the name
+	client, _ := r.clientFor(name)
+	client.Consume(name, func(status ndn.ConsumeState) {
+		if err := status.Error(); err != nil {
+			log.Warn(r, "Object insert failed", "name", name, "err", err)
+			reply((&tlv.RepoCmdRes{Status: 502, Message: err.Error()}).Encode())
+			return
+		}
+
+		log.Info(r, "Object insert success", "name", status.Name())
+		if r.cluster != nil {
+			r.cluster.ObjectStored(status.Name())
+		}
+		reply((&tlv.RepoCmdRes{
+			Status: 200,
+			Name:   &spec.NameContainer{Name: status.Name()},
+			Count:  optional.Some(uint64(status.ProgressMax())),
+		}).Encode())
+	})
+}
+
+// Handles an ObjDelete command by removing an object (all versions and segments) or all Data packets under a prefix, replying with the number of removed packets.
+func (r *Repo) handleObjDelete(cmd *tlv.ObjDelete, reply func(enc.Wire) error) {
+	if cmd.Name == nil || len(cmd.Name.Name) == 0 {
+		reply(badRequest("missing object name"))
+		return
+	}
+	name := cmd.Name.Name
+
+	// Commands are not authenticated, so deletion must be enabled
+	if !r.config.AllowDelete {
+		reply((&tlv.RepoCmdRes{Status: 403, Message: "deletion is disabled"}).Encode())
+		return
+	}
+
+	// The state of the repo itself cannot be deleted
+	if r.isRepoState(name) {
+		reply((&tlv.RepoCmdRes{Status: 403, Message: "cannot delete repo state"}).Encode())
+		return
+	}
+
+	// Count before removing; the store cannot be modified during iteration.
+	// Removing a version of an object also removes its metadata.
+	removed := []enc.Name{name}
+	if version := name.At(-1); !cmd.Prefix && version.IsVersion() {
+		removed = append(removed, name.Prefix(-1).Append(enc.NewKeywordComponent(rdr.MetadataKeyword), version))
+	}
+	count, err := r.countStored(removed...)
+	if err != nil {
+		log.Error(r, "Object delete failed", "name", name, "err", err)
+		reply((&tlv.RepoCmdRes{Status: 500, Message: err.Error()}).Encode())
+		return
+	}
+
+	if cmd.Prefix {
+		err = r.store.RemovePrefix(name)
+	} else {
+		err = r.client.Remove(name)
+	}
+	if err != nil {
+		log.Error(r, "Object delete failed", "name", name, "err", err)
+		reply((&tlv.RepoCmdRes{Status: 500, Message: err.Error()}).Encode())
+		return
+	}
+
+	log.Info(r, "Object delete success", "name", name, "count", count)
+	if r.cluster != nil {
+		r.cluster.ObjectRemoved(name)
+	}
+	reply((&tlv.RepoCmdRes{
+		Status: 200,
+		Name:   &spec.NameContainer{Name: name},
+		Count:  optional.Some(count),
+	}).Encode())
+}
+
+// Handles an ObjStatus command by replying with the latest version of the object and the number of stored Data packets under the name, or 404 if nothing is stored.
+func (r *Repo) handleObjStatus(cmd *tlv.ObjStatus, reply func(enc.Wire) error) {
+	if cmd.Name == nil || len(cmd.Name.Name) == 0 {
+		reply(badRequest("missing object name"))
+		return
+	}
+	name := cmd.Name.Name
+
+	count, err := r.countStored(name)
+	if err != nil {
+		reply((&tlv.RepoCmdRes{Status: 500, Message: err.Error()}).Encode())
+		return
+	}
+	if count == 0 {
+		reply((&tlv.RepoCmdRes{Status: 404, Message: "object not found"}).Encode())
+		return
+	}
+
+	// Report the latest version if this is an object
+	latest, _ := r.client.LatestLocal(name)
+	if latest == nil {
+		latest = name
+	}
+
+	reply((&tlv.RepoCmdRes{
+		Status: 200,
+		Name:   &spec.NameContainer{Name: latest},
+		Count:  optional.Some(count),
+	}).Encode())
+}
+
+// badRequest encodes a 400 response with a message.
+func badRequest(msg string) enc.Wire {
+	return (&tlv.RepoCmdRes{Status: 400, Message: msg}).Encode()
+}
+
+// Counts the Data packets stored under a list of prefixes.
+func (r *Repo) countStored(prefixes ...enc.Name) (count uint64, err error) {
+	for _, prefix := range prefixes {
+		wires, iterErr := r.store.Iter(prefix)
+		for range wires {
+			count++
+		}
+		if err = iterErr(); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
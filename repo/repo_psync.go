@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"fmt"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec_psync "github.com/named-data/ndnd/std/ndn/psync"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+)
+
+type RepoPSync struct {
+	config *Config
+	client ndn.Client
+	cmd    *tlv.SyncJoin
+	psync  *ndn_sync.PSyncFull
+	pubs   *groupPubHandler
+}
+
+// Constructs a new RepoPSync instance initialized with the provided configuration, NDN client, and SyncJoin command.
+func NewRepoPSync(config *Config, client ndn.Client, cmd *tlv.SyncJoin) *RepoPSync {
+	return &RepoPSync{
+		config: config,
+		client: client,
+		cmd:    cmd,
+		psync:  nil,
+		pubs:   &groupPubHandler{client: client, group: cmd.Group.Name},
+	}
+}
+
+// Returns a string representation of the RepoPSync instance, including the name of its associated command group.
+func (r *RepoPSync) String() string {
+	return fmt.Sprintf("repo-psync (%s)", r.cmd.Group.Name)
+}
+
+// Starts a PSync full-sync participant for the group, fetching every new publication and persisting the sync state after each update.
+func (r *RepoPSync) Start() (err error) {
+	log.Info(r, "Starting PSync")
+
+	r.psync = ndn_sync.NewPSyncFull(ndn_sync.PSyncOpts{
+		Client:       r.client,
+		GroupPrefix:  r.cmd.Group.Name,
+		OnUpdate:     r.onUpdate,
+		InitialState: r.readState(),
+	})
+
+	// This covers both the sync prefix and all producers' data prefixes.
+	r.client.AnnouncePrefix(ndn.Announcement{
+		Name:    r.cmd.Group.Name,
+		Cost:    1000,
+		Expose:  true,
+		OnError: nil, // TODO
+	})
+
+	// Start PSync
+	if err = r.psync.Start(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Stops the PSync participant by withdrawing the group prefix.
+func (r *RepoPSync) Stop() (err error) {
+	log.Info(r, "Stopping PSync")
+	if r.psync == nil {
+		return nil
+	}
+
+	// Withdraw group prefix.
+	r.client.WithdrawPrefix(r.cmd.Group.Name, nil)
+
+	// Stop PSync
+	if err = r.psync.Stop(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reports the number of producers in the PSync state and the time of the last publication.
+func (r *RepoPSync) Status() *tlv.GroupStatus {
+	status := &tlv.GroupStatus{
+		Group:           r.cmd.Group,
+		Protocol:        r.cmd.Protocol,
+		LastPublication: r.pubs.lastPublication(),
+	}
+	if r.psync != nil {
+		status.StateVectorSize = uint64(len(r.psync.GetNames()))
+	}
+	return status
+}
+
+// onUpdate fetches all new publications of a producer prefix.
+func (r *RepoPSync) onUpdate(update ndn_sync.SvSyncUpdate) {
+	for seq := update.Low; seq <= update.High; seq++ {
+		name := update.Name.Append(enc.NewSequenceNumComponent(seq))
+		r.client.Consume(name, func(status ndn.ConsumeState) {
+			if status.Error() != nil {
+				log.Warn(r, "Failed to fetch publication", "err", status.Error(), "name", name)
+				return
+			}
+			r.pubs.processIncomingPub(status.Content())
+		})
+	}
+
+	r.commitState(r.psync.State())
+}
+
+// Saves the provided state under a repository-specific name constructed by appending "psync-state" to the group name.
+func (r *RepoPSync) commitState(state *spec_psync.State) {
+	name := r.cmd.Group.Name.Append(enc.NewKeywordComponent("psync-state"))
+	r.client.Store().Put(name, state.Encode().Join())
+}
+
+// Retrieves the stored state associated with the group's name and "psync-state" key from the repository's storage.
+func (r *RepoPSync) readState() *spec_psync.State {
+	name := r.cmd.Group.Name.Append(enc.NewKeywordComponent("psync-state"))
+	if stateWire, _ := r.client.Store().Get(name, false); stateWire != nil {
+		state, err := spec_psync.ParseState(enc.NewBufferView(stateWire), true)
+		if err != nil {
+			log.Warn(r, "Failed to parse stored PSync state", "err", err)
+			return nil
+		}
+		return state
+	}
+	return nil
+}
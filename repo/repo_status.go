@@ -0,0 +1,205 @@
+package repo
+
+import (
+	"slices"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	rdr "github.com/named-data/ndnd/std/ndn/rdr_2024"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	sig "github.com/named-data/ndnd/std/security/signer"
+)
+
+const (
+	// statusInterval is the minimum interval between versions of the status dataset.
+	// Metadata requests within the interval are answered with the latest version.
+	statusInterval = time.Second
+	// statusRetention is how long old versions are kept for consumers still fetching them.
+	statusRetention = 30 * time.Second
+	// statusCountInterval is the minimum interval between counts of the store contents.
+	// Counting iterates over the whole store, so versions in between reuse the last count.
+	statusCountInterval = time.Minute
+	// statusProbeInterval is the minimum interval between write checks of the store.
+	statusProbeInterval = time.Minute
+)
+
+// statusVersion is a version of the status dataset in the status store.
+type statusVersion struct {
+	name     enc.Name
+	produced time.Time
+}
+
+// statusPrefix is the prefix of the status dataset.
+func (r *Repo) statusPrefix() enc.Name {
+	return r.config.NameN.Append(enc.NewKeywordComponent("status"))
+}
+
+// startStatus attaches the handler that serves the status dataset.
+// RDR metadata requests produce a new version of the dataset, at most once per statusInterval.
+func (r *Repo) startStatus() error {
+	r.statusStore = storage.NewMemoryStore()
+	return r.engine.AttachHandler(r.statusPrefix(), r.onStatusInterest)
+}
+
+// Serves an Interest for the status dataset, producing a new version for metadata requests if the latest is outdated.
+func (r *Repo) onStatusInterest(args ndn.InterestHandlerArgs) {
+	name := args.Interest.Name()
+	prefix := r.statusPrefix()
+
+	go func() {
+		if len(name) == len(prefix)+1 && name.At(-1).IsKeyword(rdr.MetadataKeyword) {
+			if err := r.produceStatus(); err != nil {
+				log.Error(r, "Failed to produce status dataset", "err", err)
+				return
+			}
+		}
+
+		wire, err := r.statusStore.Get(name, args.Interest.CanBePrefix())
+		if err != nil || wire == nil {
+			return
+		}
+		args.Reply(enc.Wire{wire})
+	}()
+}
+
+// produceStatus produces a new version of the status dataset, unless the latest
+// version is newer than statusInterval, and removes versions older than statusRetention.
+func (r *Repo) produceStatus() error {
+	r.statusMutex.Lock()
+	defer r.statusMutex.Unlock()
+
+	now := time.Now()
+	if n := len(r.statusVersions); n > 0 && now.Sub(r.statusVersions[n-1].produced) < statusInterval {
+		return nil
+	}
+
+	prefix := r.statusPrefix()
+	dataset := r.status()
+
+	signer := r.client.SuggestSigner(prefix)
+	if signer == nil {
+		signer = sig.NewSha256Signer()
+	}
+
+	name, err := object.Produce(ndn.ProduceArgs{
+		Name:            prefix.WithVersion(enc.VersionUnixMicro),
+		Content:         dataset.Encode(),
+		FreshnessPeriod: time.Second,
+	}, r.statusStore, signer)
+	if err != nil {
+		return err
+	}
+
+	r.statusVersions = append(r.statusVersions, statusVersion{name: name, produced: now})
+	for now.Sub(r.statusVersions[0].produced) >= statusRetention {
+		old := r.statusVersions[0].name
+		r.statusVersions = r.statusVersions[1:]
+		r.statusStore.RemovePrefix(old)
+		r.statusStore.RemovePrefix(prefix.
+			Append(enc.NewKeywordComponent(rdr.MetadataKeyword)).
+			Append(old.At(-1)))
+	}
+
+	return nil
+}
+
+// status collects the status of the groups and the store.
+func (r *Repo) status() *tlv.RepoStatus {
+	status := &tlv.RepoStatus{
+		Name:             &spec.NameContainer{Name: r.config.NameN},
+		StartTimestamp:   uint64(r.startTime.UnixMilli()),
+		CurrentTimestamp: uint64(time.Now().UnixMilli()),
+		RejectedData:     r.rejected.Load(),
+	}
+
+	r.mutex.Lock()
+	for _, group := range r.groups {
+		status.Groups = append(status.Groups, group.Status())
+	}
+	r.mutex.Unlock()
+	slices.SortFunc(status.Groups, func(a, b *tlv.GroupStatus) int {
+		return a.Group.Name.Compare(b.Group.Name)
+	})
+
+	status.Prefixes, status.Store = r.storeStatus()
+	return status
+}
+
+// storeStatus returns the objects, packets and bytes under each top-level prefix,
+// counted at most once per statusCountInterval, and checks if the store can be
+// written at most once per statusProbeInterval.
+// Call with statusMutex locked
+func (r *Repo) storeStatus() ([]*tlv.PrefixStatus, *tlv.StoreStatus) {
+	if time.Since(r.countTime) >= statusCountInterval {
+		r.countTime = time.Now()
+		r.counted, r.countErr = r.countPrefixes()
+	}
+
+	store := &tlv.StoreStatus{Healthy: true}
+	for _, prefix := range r.counted {
+		store.Packets += prefix.Packets
+		store.Size += prefix.Size
+	}
+
+	// Check that the store is writable
+	if time.Since(r.probeTime) >= statusProbeInterval {
+		r.probeTime = time.Now()
+		probe := r.statePrefix().Append(enc.NewKeywordComponent("status-probe"))
+		if r.probeErr = r.store.Put(probe, []byte{}); r.probeErr == nil {
+			r.probeErr = r.store.Remove(probe)
+		}
+	}
+	if r.probeErr != nil {
+		store.Healthy = false
+		store.Error = r.probeErr.Error()
+	}
+	if r.countErr != nil {
+		store.Healthy = false
+		store.Error = r.countErr.Error()
+	}
+
+	return r.counted, store
+}
+
+// countPrefixes counts the objects, packets and bytes under each top-level prefix of the store.
+func (r *Repo) countPrefixes() ([]*tlv.PrefixStatus, error) {
+	prefixes := make([]*tlv.PrefixStatus, 0)
+	var current *tlv.PrefixStatus
+	var lastObject enc.Name
+
+	// Names are iterated in order, so all names of a prefix are adjacent
+	wires, iterErr := r.store.Iter(enc.Name{})
+	for name, wire := range wires {
+		if len(name) == 0 {
+			continue
+		}
+		if current == nil || !current.Prefix.Name.IsPrefix(name) {
+			current = &tlv.PrefixStatus{Prefix: &spec.NameContainer{Name: name.Prefix(1).Clone()}}
+			prefixes = append(prefixes, current)
+		}
+
+		current.Packets++
+		current.Size += uint64(len(wire))
+
+		// Objects are identified by their versioned name
+		for i, c := range name {
+			if c.IsKeyword(rdr.MetadataKeyword) {
+				break
+			}
+			if c.IsVersion() {
+				if obj := name.Prefix(i + 1); !obj.Equal(lastObject) {
+					current.Objects++
+					lastObject = obj.Clone()
+				}
+				break
+			}
+		}
+	}
+
+	return prefixes, iterErr()
+}
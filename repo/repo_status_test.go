@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoStatus(t *testing.T) {
+	tu.SetT(t)
+
+	r, consumer := newTestRepo(t)
+	require.NoError(t, r.startStatus())
+	for _, name := range []string{"/ndn/app/a", "/ndn/app/b", "/other/c"} {
+		tu.NoErr(r.client.Produce(ndn.ProduceArgs{
+			Name:    tu.NoErr(enc.NameFromStr(name)).WithVersion(1),
+			Content: enc.Wire{[]byte(name)},
+		}))
+	}
+
+	fetch := func() *tlv.RepoStatus {
+		ch := make(chan ndn.ConsumeState, 1)
+		consumer.Consume(r.statusPrefix(), func(state ndn.ConsumeState) { ch <- state })
+		state := <-ch
+		require.NoError(t, state.Error())
+		return tu.NoErr(tlv.ParseRepoStatus(enc.NewWireView(state.Content()), false))
+	}
+
+	// Objects are counted under each top-level prefix
+	status := fetch()
+	require.Len(t, status.Prefixes, 2)
+	require.Equal(t, "/ndn", status.Prefixes[0].Prefix.Name.String())
+	require.Equal(t, uint64(2), status.Prefixes[0].Objects)
+	require.Equal(t, uint64(1), status.Prefixes[1].Objects)
+	require.True(t, status.Store.Healthy)
+	require.Len(t, r.statusVersions, 1)
+
+	// Requests within the interval are served the latest version
+	probed, counted := r.probeTime, r.countTime
+	require.NoError(t, r.produceStatus())
+	require.Len(t, r.statusVersions, 1)
+	require.Equal(t, status.CurrentTimestamp, fetch().CurrentTimestamp)
+
+	// Old versions are kept while they may still be fetched
+	r.statusMutex.Lock()
+	r.statusVersions[0].produced = time.Now().Add(-statusInterval)
+	r.statusMutex.Unlock()
+	require.NoError(t, r.produceStatus())
+	require.Len(t, r.statusVersions, 2)
+	first := r.statusVersions[0].name
+	require.NotNil(t, tu.NoErr(r.statusStore.Get(first, true)))
+
+	// The store is not written or counted for every version
+	require.Equal(t, probed, r.probeTime)
+	require.Equal(t, counted, r.countTime)
+	tu.NoErr(r.client.Produce(ndn.ProduceArgs{
+		Name:    tu.NoErr(enc.NameFromStr("/other/d")).WithVersion(1),
+		Content: enc.Wire{[]byte("d")},
+	}))
+	expire := func() {
+		r.statusMutex.Lock()
+		r.statusVersions[len(r.statusVersions)-1].produced = time.Now().Add(-statusInterval)
+		r.statusMutex.Unlock()
+	}
+	expire()
+	require.Equal(t, uint64(1), fetch().Prefixes[1].Objects)
+	require.Len(t, r.statusVersions, 3)
+
+	// The store is counted again after the interval
+	expire()
+	r.statusMutex.Lock()
+	r.countTime = time.Now().Add(-statusCountInterval)
+	r.statusMutex.Unlock()
+	status = fetch()
+	require.Equal(t, uint64(2), status.Prefixes[1].Objects)
+	require.Equal(t, status.Prefixes[0].Packets+status.Prefixes[1].Packets, status.Store.Packets)
+
+	// Versions are removed after the retention time
+	r.statusMutex.Lock()
+	r.statusVersions[0].produced = time.Now().Add(-statusRetention)
+	r.statusMutex.Unlock()
+	expire()
+	require.NoError(t, r.produceStatus())
+	require.Len(t, r.statusVersions, 4)
+	require.Nil(t, tu.NoErr(r.statusStore.Get(first, true)))
+}
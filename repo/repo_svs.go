@@ -8,7 +8,6 @@ import (
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
 	"github.com/named-data/ndnd/std/ndn"
-	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 	"github.com/named-data/ndnd/std/ndn/svs_ps"
 	ndn_sync "github.com/named-data/ndnd/std/sync"
 )
@@ -18,6 +17,7 @@ type RepoSvs struct {
 	client ndn.Client
 	cmd    *tlv.SyncJoin
 	svsalo *ndn_sync.SvsALO
+	pubs   *groupPubHandler
 }
 
 // Constructs a new RepoSvs instance initialized with the provided configuration, NDN client, and SyncJoin command.
@@ -27,6 +27,7 @@ func NewRepoSvs(config *Config, client ndn.Client, cmd *tlv.SyncJoin) *RepoSvs {
 		client: client,
 		cmd:    cmd,
 		svsalo: nil,
+		pubs:   &groupPubHandler{client: client, group: cmd.Group.Name},
 	}
 }
 
@@ -96,12 +97,12 @@ func (r *RepoSvs) Start() (err error) {
 				}
 
 				for _, entry := range snapshot.Entries {
-					r.processIncomingPub(entry.Content)
+					r.pubs.processIncomingPub(entry.Content)
 				}
 			}
 		} else {
 			// Process the publication.
-			r.processIncomingPub(pub.Content)
+			r.pubs.processIncomingPub(pub.Content)
 		}
 
 		r.commitState(pub.State)
@@ -156,6 +157,19 @@ func (r *RepoSvs) Stop() (err error) {
 	return nil
 }
 
+// Reports the size of the SVS state vector and the time of the last publication.
+func (r *RepoSvs) Status() *tlv.GroupStatus {
+	status := &tlv.GroupStatus{
+		Group:           r.cmd.Group,
+		Protocol:        r.cmd.Protocol,
+		LastPublication: r.pubs.lastPublication(),
+	}
+	if r.svsalo != nil {
+		status.StateVectorSize = uint64(len(r.svsalo.SVS().GetNames()))
+	}
+	return status
+}
+
 // Saves the provided state data under a repository-specific name constructed by appending "alo-state" to the group name.
 func (r *RepoSvs) commitState(state enc.Wire) {
 	name := r.cmd.Group.Name.Append(enc.NewKeywordComponent("alo-state"))
@@ -170,61 +184,3 @@ func (r *RepoSvs) readState() enc.Wire {
 	}
 	return nil
 }
-
-// processIncomingPub checks if the given pub is a command for repo.
-func (r *RepoSvs) processIncomingPub(w enc.Wire) {
-	cmd, err := tlv.ParseRepoCmd(enc.NewWireView(w), false)
-	if err != nil {
-		// Likely application data.
-		return
-	}
-
-	if cmd.BlobFetch != nil && cmd.BlobFetch.Name != nil {
-		r.processBlobFetch(cmd.BlobFetch.Name.Name)
-	} else if cmd.BlobFetch != nil && len(cmd.BlobFetch.Data) > 0 {
-		r.processBlobStore(cmd.BlobFetch.Data)
-	}
-}
-
-// processBlobFetch processes a BlobFetch command.
-func (r *RepoSvs) processBlobFetch(name enc.Name) {
-	if !r.cmd.Group.Name.IsPrefix(name) {
-		log.Warn(r, "Ignoring BlobFetch outside group", "name", name)
-		return
-	}
-
-	// TODO: retry fetching if failed, even across restarts
-	// TODO: do not fetch blobs that are too large
-	// TODO: do not fetch blobs that are already stored (though this shouldn't happen)
-	r.client.Consume(name, func(status ndn.ConsumeState) {
-		if status.Error() != nil {
-			log.Warn(r, "BlobFetch error", "err", status.Error(), "name", name)
-			return
-		}
-		log.Info(r, "BlobFetch success", "name", name)
-	})
-}
-
-// processBlobStore directly stores data from the BlobFetch command.
-func (r *RepoSvs) processBlobStore(data [][]byte) {
-	for _, w := range data {
-		data, _, err := spec.Spec{}.ReadData(enc.NewBufferView(w))
-		if err != nil {
-			log.Warn(r, "BlobFetch store failed to parse data", "err", err)
-			continue
-		}
-		name := data.Name()
-
-		if !r.cmd.Group.Name.IsPrefix(name) {
-			log.Warn(r, "Ignoring BlobFetch store outside group", "name", name)
-			continue
-		}
-
-		if err := r.client.Store().Put(name, w); err != nil {
-			log.Warn(r, "BlobFetch store failed to store data", "err", err)
-			continue
-		}
-
-		log.Info(r, "BlobFetch store success", "name", name)
-	}
-}
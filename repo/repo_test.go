@@ -0,0 +1,229 @@
+package repo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/named-data/ndnd/std/security/keychain"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates a repo with a memory store on a dummy face.
+func newFaceRepo(t *testing.T) (*Repo, *face.DummyFace) {
+	repoFace := face.NewDummyFace()
+
+	r := NewRepo(&Config{
+		Name:  "/ndn/repo",
+		NameN: tu.NoErr(enc.NameFromStr("/ndn/repo")),
+	})
+	r.startTime = time.Now()
+	r.store = storage.NewMemoryStore()
+	r.keychain = keychain.NewKeyChainMem(r.store)
+	r.engine = engine.NewBasicEngine(repoFace)
+	r.setupEngineHook()
+	require.NoError(t, r.engine.Start())
+	r.client = object.NewClient(r.engine, r.store, nil)
+	require.NoError(t, r.client.Start())
+
+	t.Cleanup(func() {
+		r.client.Stop()
+		r.engine.Stop()
+	})
+	return r, repoFace
+}
+
+// Forwards all packets between two dummy faces until the test ends.
+func linkFaces(t *testing.T, a, b *face.DummyFace) {
+	done := make(chan struct{})
+	pump := func(from, to *face.DummyFace) {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if pkt, err := from.Consume(); err == nil {
+				to.FeedPacket(pkt)
+			}
+		}
+	}
+	go pump(a, b)
+	go pump(b, a)
+	t.Cleanup(func() { close(done) })
+}
+
+// Creates a repo with a memory store, linked to a producer client
+// that receives every Interest sent by the repo.
+func newTestRepo(t *testing.T) (*Repo, ndn.Client) {
+	r, repoFace := newFaceRepo(t)
+
+	producerFace := face.NewDummyFace()
+	producerEngine := engine.NewBasicEngine(producerFace)
+	require.NoError(t, producerEngine.Start())
+	producer := object.NewClient(producerEngine, storage.NewMemoryStore(), nil)
+	require.NoError(t, producer.Start())
+	t.Cleanup(func() {
+		producer.Stop()
+		producerEngine.Stop()
+	})
+
+	linkFaces(t, repoFace, producerFace)
+	return r, producer
+}
+
+// Calls a command handler and returns the response.
+func runCmd(t *testing.T, handler func(reply func(enc.Wire) error)) *tlv.RepoCmdRes {
+	ch := make(chan enc.Wire, 1)
+	handler(func(wire enc.Wire) error {
+		ch <- wire
+		return nil
+	})
+
+	select {
+	case wire := <-ch:
+		return tu.NoErr(tlv.ParseRepoCmdRes(enc.NewWireView(wire), false))
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no command response")
+		return nil
+	}
+}
+
+func nameContainer(name enc.Name) *spec.NameContainer {
+	return &spec.NameContainer{Name: name}
+}
+
+func TestRepoObjCommands(t *testing.T) {
+	tu.SetT(t)
+
+	r, producer := newTestRepo(t)
+	prefix := tu.NoErr(enc.NameFromStr("/ndn/app/obj"))
+	content := make([]byte, 20000)
+	version := tu.NoErr(producer.Produce(ndn.ProduceArgs{
+		Name:    prefix.WithVersion(7),
+		Content: enc.Wire{content},
+	}))
+
+	// Missing names are rejected
+	res := runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjInsert(&tlv.ObjInsert{}, reply)
+	})
+	require.Equal(t, uint64(400), res.Status)
+
+	// Object is fetched into the store
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjInsert(&tlv.ObjInsert{Name: nameContainer(prefix)}, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Equal(t, version, res.Name.Name)
+	segments := res.Count.Unwrap()
+	require.Greater(t, segments, uint64(1))
+
+	// Data is stored by the engine hook, including the metadata
+	stored := segments + 1
+	require.Eventually(t, func() bool {
+		count := uint64(0)
+		wires, _ := r.store.Iter(prefix)
+		for range wires {
+			count++
+		}
+		return count == stored
+	}, time.Second, 10*time.Millisecond)
+
+	// Status reports the latest version
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjStatus(&tlv.ObjStatus{Name: nameContainer(prefix)}, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Equal(t, version, res.Name.Name)
+	require.Equal(t, stored, res.Count.Unwrap())
+
+	// Unknown objects are not found
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjStatus(&tlv.ObjStatus{Name: nameContainer(tu.NoErr(enc.NameFromStr("/ndn/app/none")))}, reply)
+	})
+	require.Equal(t, uint64(404), res.Status)
+
+	// Objects that cannot be fetched fail
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjInsert(&tlv.ObjInsert{Name: nameContainer(tu.NoErr(enc.NameFromStr("/ndn/app/none")))}, reply)
+	})
+	require.Equal(t, uint64(502), res.Status)
+
+	// Deletion is disabled by default
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjDelete(&tlv.ObjDelete{Name: nameContainer(prefix)}, reply)
+	})
+	require.Equal(t, uint64(403), res.Status)
+	require.NotNil(t, tu.NoErr(r.store.Get(version, true)))
+	r.config.AllowDelete = true
+
+	// State of the repo cannot be deleted
+	require.NoError(t, r.store.Put(r.groupStateName(prefix), []byte{0x06, 0x00}))
+	for _, name := range []string{"/ndn/repo", "/ndn", "/localhost/repo/ndn/repo/32=groups", "/localhost"} {
+		res = runCmd(t, func(reply func(enc.Wire) error) {
+			r.handleObjDelete(&tlv.ObjDelete{Name: nameContainer(tu.NoErr(enc.NameFromStr(name))), Prefix: true}, reply)
+		})
+		require.Equal(t, uint64(403), res.Status)
+	}
+	require.NotNil(t, tu.NoErr(r.store.Get(r.groupStateName(prefix), false)))
+
+	// Version is deleted with all segments and its metadata
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjDelete(&tlv.ObjDelete{Name: nameContainer(version)}, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Equal(t, stored, res.Count.Unwrap())
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleObjStatus(&tlv.ObjStatus{Name: nameContainer(prefix)}, reply)
+	})
+	require.Equal(t, uint64(404), res.Status)
+}
+
+func TestRepoBlobFetch(t *testing.T) {
+	tu.SetT(t)
+
+	r, _ := newTestRepo(t)
+	signer := sig.NewSha256Signer()
+	makeData := func(name string) []byte {
+		data := tu.NoErr(spec.Spec{}.MakeData(tu.NoErr(enc.NameFromStr(name)), &ndn.DataConfig{}, enc.Wire{[]byte(name)}, signer))
+		return data.Wire.Join()
+	}
+
+	// Missing names and data are rejected
+	res := runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleBlobFetch(&tlv.BlobFetch{}, reply)
+	})
+	require.Equal(t, uint64(400), res.Status)
+
+	// Attached Data packets are stored
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleBlobFetch(&tlv.BlobFetch{Data: [][]byte{
+			makeData("/ndn/blob/a"),
+			makeData("/ndn/blob/b"),
+		}}, reply)
+	})
+	require.Equal(t, uint64(200), res.Status)
+	require.Equal(t, uint64(2), res.Count.Unwrap())
+	require.NotNil(t, tu.NoErr(r.store.Get(tu.NoErr(enc.NameFromStr("/ndn/blob/b")), false)))
+
+	// Invalid packets are reported, valid packets are still stored
+	res = runCmd(t, func(reply func(enc.Wire) error) {
+		r.handleBlobFetch(&tlv.BlobFetch{Data: [][]byte{
+			{0x06, 0x01, 0x00},
+			makeData("/ndn/blob/c"),
+		}}, reply)
+	})
+	require.Equal(t, uint64(400), res.Status)
+	require.Equal(t, uint64(1), res.Count.Unwrap())
+	require.NotNil(t, tu.NoErr(r.store.Get(tu.NoErr(enc.NameFromStr("/ndn/blob/c")), false)))
+}
@@ -0,0 +1,184 @@
+package repo
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/trust_schema"
+)
+
+// repoTrust is the trust configuration of an application prefix.
+type repoTrust struct {
+	prefix enc.Name
+	// client validates with the trust schema of the prefix.
+	// This client is never started, the main client serves all data.
+	client ndn.Client
+}
+
+// Adds or replaces the LVS trust schema and anchors used to validate data under a prefix.
+func (r *Repo) addTrust(prefix enc.Name, schemaWire []byte, anchors []enc.Name) error {
+	entry, err := r.newTrust(prefix, schemaWire, anchors)
+	if err != nil {
+		return err
+	}
+	r.setTrust(entry)
+
+	log.Info(r, "Added trust schema", "prefix", prefix, "anchors", len(anchors))
+	return nil
+}
+
+// Creates the trust configuration of a prefix without using it.
+func (r *Repo) newTrust(prefix enc.Name, schemaWire []byte, anchors []enc.Name) (*repoTrust, error) {
+	schema, err := trust_schema.NewLvsSchema(schemaWire)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trust schema for %s: %w", prefix, err)
+	}
+
+	trust, err := sec.NewTrustConfig(r.keychain, schema, anchors)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trust config for %s: %w", prefix, err)
+	}
+	trust.UseDataNameFwHint = true
+
+	return &repoTrust{
+		prefix: prefix.Clone(),
+		client: object.NewClient(r.engine, r.store, trust),
+	}, nil
+}
+
+// Uses a trust configuration for its prefix, returning the configuration it replaces, if any.
+func (r *Repo) setTrust(entry *repoTrust) (prev *repoTrust) {
+	r.trustMutex.Lock()
+	defer r.trustMutex.Unlock()
+
+	for i, t := range r.trust {
+		if t.prefix.Equal(entry.prefix) {
+			r.trust[i] = entry
+			return t
+		}
+	}
+	r.trust = append(r.trust, entry)
+	return nil
+}
+
+// Removes a trust configuration added by setTrust, restoring the configuration it replaced.
+func (r *Repo) resetTrust(entry *repoTrust, prev *repoTrust) {
+	r.trustMutex.Lock()
+	defer r.trustMutex.Unlock()
+
+	for i, t := range r.trust {
+		if t != entry {
+			continue
+		}
+		if prev != nil {
+			r.trust[i] = prev
+		} else {
+			r.trust = slices.Delete(r.trust, i, i+1)
+		}
+		return
+	}
+}
+
+// Creates the trust configuration carried in a SyncJoin command for the
+// group prefix, or nil if the command has no trust schema. Prefixes that
+// have a trust schema in the configuration cannot be changed by commands.
+//
+// The trust anchors in the command are added to the public store of the
+// keychain and returned, so they can be removed if the group is not started.
+// Anchors never replace a different certificate with the same name, and
+// are not added to the keychain itself, so that they are not persisted
+// and cannot be used for any other prefix.
+func (r *Repo) groupTrust(cmd *tlv.SyncJoin) (*repoTrust, []enc.Name, error) {
+	if len(cmd.TrustSchema) == 0 {
+		return nil, nil, nil
+	}
+	if cmd.Group == nil || len(cmd.Group.Name) == 0 {
+		return nil, nil, fmt.Errorf("missing group name")
+	}
+	group := cmd.Group.Name
+
+	for _, domain := range r.config.Trust {
+		if domain.PrefixN.IsPrefix(group) {
+			return nil, nil, fmt.Errorf("trust schema for %s is set by the configuration", group)
+		}
+	}
+
+	store := r.keychain.Store()
+	anchors := make([]enc.Name, 0, len(cmd.TrustAnchors))
+	added := make([]enc.Name, 0, len(cmd.TrustAnchors))
+
+	for _, wire := range cmd.TrustAnchors {
+		cert, _, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+		if err != nil {
+			r.removeAnchors(added)
+			return nil, nil, fmt.Errorf("invalid trust anchor: %w", err)
+		}
+		if t, ok := cert.ContentType().Get(); !ok || t != ndn.ContentTypeKey {
+			r.removeAnchors(added)
+			return nil, nil, fmt.Errorf("invalid trust anchor: %s is not a certificate", cert.Name())
+		}
+
+		name := cert.Name()
+		anchors = append(anchors, name)
+		if existing, _ := store.Get(name, false); existing != nil {
+			if !bytes.Equal(existing, wire) {
+				r.removeAnchors(added)
+				return nil, nil, fmt.Errorf("trust anchor conflicts with existing certificate: %s", name)
+			}
+			continue
+		}
+		if err := store.Put(name, wire); err != nil {
+			r.removeAnchors(added)
+			return nil, nil, fmt.Errorf("failed to store trust anchor: %w", err)
+		}
+		added = append(added, name)
+	}
+
+	entry, err := r.newTrust(group, cmd.TrustSchema, anchors)
+	if err != nil {
+		r.removeAnchors(added)
+		return nil, nil, err
+	}
+	return entry, added, nil
+}
+
+// Removes trust anchors added by groupTrust.
+func (r *Repo) removeAnchors(names []enc.Name) {
+	for _, name := range names {
+		if err := r.keychain.Store().Remove(name); err != nil {
+			log.Warn(r, "Failed to remove trust anchor", "name", name, "err", err)
+		}
+	}
+}
+
+// Returns the client that validates data under a name, and whether a trust schema is configured for the name.
+// The main client is returned if no trust schema matches.
+func (r *Repo) clientFor(name enc.Name) (ndn.Client, bool) {
+	r.trustMutex.RLock()
+	defer r.trustMutex.RUnlock()
+
+	var match *repoTrust
+	for _, t := range r.trust {
+		if t.prefix.IsPrefix(name) && (match == nil || len(t.prefix) > len(match.prefix)) {
+			match = t
+		}
+	}
+	if match == nil {
+		return r.client, false
+	}
+	return match.client, true
+}
+
+// Logs and counts a Data packet that was not stored because it failed validation.
+func (r *Repo) rejectData(name enc.Name, err error) {
+	r.rejected.Add(1)
+	log.Warn(r, "Rejected data failing validation", "name", name, "err", err)
+}
@@ -0,0 +1,125 @@
+package repo
+
+import (
+	"crypto/elliptic"
+	_ "embed"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sec "github.com/named-data/ndnd/std/security"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed repo_trust_test_lvs.tlv
+var testLvsSchema []byte
+
+// failGroup is a group that cannot be started.
+type failGroup struct{ stopGroup }
+
+func (g *failGroup) Start() error { return fmt.Errorf("failed to start") }
+
+// Creates a self-signed trust anchor for a key name.
+func testAnchor(t *testing.T, keyName string) (enc.Name, []byte) {
+	key := tu.NoErr(sig.KeygenEcc(tu.NoErr(enc.NameFromStr(keyName)), elliptic.P256()))
+	wire := tu.NoErr(sec.SelfSign(sec.SignCertArgs{
+		Signer:    key,
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	})).Join()
+	cert, _, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+	require.NoError(t, err)
+	return cert.Name(), wire
+}
+
+func trustJoin(group enc.Name, anchors ...[]byte) *tlv.SyncJoin {
+	cmd := syncJoin(group, tlv.SyncProtocolSvsV3)
+	cmd.TrustSchema = testLvsSchema
+	cmd.TrustAnchors = anchors
+	return cmd
+}
+
+func TestRepoGroupTrust(t *testing.T) {
+	tu.SetT(t)
+
+	r, _ := newTestRepo(t)
+	configured := tu.NoErr(enc.NameFromStr("/ndn/app"))
+	r.config.Trust = []*TrustDomainConfig{{PrefixN: configured}}
+	group := tu.NoErr(enc.NameFromStr("/ndn/other/group"))
+	anchorName, anchor := testAnchor(t, "/ndn/other/KEY/1")
+
+	join := func(cmd *tlv.SyncJoin) *tlv.RepoCmdRes {
+		return runCmd(t, func(reply func(enc.Wire) error) {
+			r.handleSyncJoin(cmd, reply)
+		})
+	}
+	stored := func(name enc.Name) []byte {
+		return tu.NoErr(r.store.Get(name, false))
+	}
+
+	// Schemas of configured prefixes cannot be changed by commands
+	covered := configured.Append(enc.NewGenericComponent("group"))
+	require.Equal(t, uint64(400), join(trustJoin(covered, anchor)).Status)
+	require.Equal(t, uint64(400), join(trustJoin(configured, anchor)).Status)
+	require.False(t, r.joined(covered))
+	require.Empty(t, r.trust)
+	require.Nil(t, stored(anchorName))
+
+	// Invalid anchors are rejected
+	require.Equal(t, uint64(400), join(trustJoin(group, anchor, []byte{0x06, 0x00})).Status)
+	require.Nil(t, stored(anchorName))
+
+	// Anchors do not replace existing certificates
+	_, other := testAnchor(t, "/ndn/other/KEY/1")
+	require.NoError(t, r.store.Put(anchorName, other))
+	require.Equal(t, uint64(400), join(trustJoin(group, anchor)).Status)
+	require.Equal(t, other, stored(anchorName))
+	require.NoError(t, r.store.Remove(anchorName))
+
+	// Trust is not changed if the group cannot be started
+	trust, anchors, err := r.groupTrust(trustJoin(group, anchor))
+	require.NoError(t, err)
+	require.Equal(t, []enc.Name{anchorName}, anchors)
+	fail := func() RepoGroup { return &failGroup{} }
+	require.Error(t, r.startGroup(trustJoin(group, anchor), fail, trust))
+	r.removeAnchors(anchors)
+	require.Empty(t, r.trust)
+	require.Nil(t, stored(anchorName))
+
+	// Group is joined with the schema of the command
+	require.Equal(t, uint64(200), join(trustJoin(group, anchor)).Status)
+	require.True(t, r.joined(group))
+	client, ok := r.clientFor(group.Append(enc.NewGenericComponent("data")))
+	require.True(t, ok)
+	require.NotEqual(t, r.client, client)
+	require.Equal(t, anchor, stored(anchorName))
+
+	// Joining again does not change the schema of the running group
+	_, anchor2 := testAnchor(t, "/ndn/other/KEY/2")
+	require.Equal(t, uint64(200), join(trustJoin(group, anchor2)).Status)
+	client2, _ := r.clientFor(group)
+	require.Equal(t, client, client2)
+
+	// The previous schema of a prefix is restored if a group fails
+	require.True(t, r.stopGroup(group))
+	trust, _, err = r.groupTrust(trustJoin(group, anchor))
+	require.NoError(t, err)
+	require.Error(t, r.startGroup(trustJoin(group, anchor), fail, trust))
+	client2, _ = r.clientFor(group)
+	require.Equal(t, client, client2)
+
+	// Schema and anchors are restored with the persisted group
+	restarted, _ := newTestRepo(t)
+	restarted.store = r.store
+	restarted.keychain = r.keychain
+	restarted.restoreGroups()
+	require.True(t, restarted.joined(group))
+	_, ok = restarted.clientFor(group)
+	require.True(t, ok)
+	require.True(t, restarted.stopGroup(group))
+}
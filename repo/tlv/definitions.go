@@ -4,6 +4,7 @@ package tlv
 import (
 	enc "github.com/named-data/ndnd/std/encoding"
 	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
 )
 
 var SyncProtocolSvsV3 = enc.Name{
@@ -12,11 +13,24 @@ var SyncProtocolSvsV3 = enc.Name{
 	enc.NewVersionComponent(3),
 }
 
+var SyncProtocolPSync = enc.Name{
+	enc.NewKeywordComponent("ndn"),
+	enc.NewKeywordComponent("psync"),
+}
+
 type RepoCmd struct {
 	//+field:struct:SyncJoin
 	SyncJoin *SyncJoin `tlv:"0x1DB0"`
 	//+field:struct:BlobFetch
 	BlobFetch *BlobFetch `tlv:"0x1DB2"`
+	//+field:struct:ObjInsert
+	ObjInsert *ObjInsert `tlv:"0x1DB4"`
+	//+field:struct:ObjDelete
+	ObjDelete *ObjDelete `tlv:"0x1DB6"`
+	//+field:struct:ObjStatus
+	ObjStatus *ObjStatus `tlv:"0x1DB8"`
+	//+field:struct:SyncLeave
+	SyncLeave *SyncLeave `tlv:"0x1DBA"`
 }
 
 type RepoCmdRes struct {
@@ -24,6 +38,10 @@ type RepoCmdRes struct {
 	Status uint64 `tlv:"0x291"`
 	//+field:string
 	Message string `tlv:"0x292"`
+	//+field:struct:spec.NameContainer
+	Name *spec.NameContainer `tlv:"0x293"`
+	//+field:natural:optional
+	Count optional.Optional[uint64] `tlv:"0x294"`
 }
 
 type SyncJoin struct {
@@ -35,6 +53,17 @@ type SyncJoin struct {
 	MulticastPrefix *spec.NameContainer `tlv:"0x194"`
 	//+field:struct:HistorySnapshotConfig
 	HistorySnapshot *HistorySnapshotConfig `tlv:"0x1A4"`
+	//+field:binary
+	TrustSchema []byte `tlv:"0x1A7"`
+	//+field:sequence:[]byte:binary:[]byte
+	TrustAnchors [][]byte `tlv:"0x1A9"`
+}
+
+type SyncLeave struct {
+	//+field:struct:spec.NameContainer
+	Group *spec.NameContainer `tlv:"0x193"`
+	//+field:bool
+	DeleteData bool `tlv:"0x195"`
 }
 
 type HistorySnapshotConfig struct {
@@ -48,3 +77,81 @@ type BlobFetch struct {
 	//+field:sequence:[]byte:binary:[]byte
 	Data [][]byte `tlv:"0x1BA"`
 }
+
+type ObjInsert struct {
+	//+field:struct:spec.NameContainer
+	Name *spec.NameContainer `tlv:"0x1BC"`
+}
+
+type ObjDelete struct {
+	//+field:struct:spec.NameContainer
+	Name *spec.NameContainer `tlv:"0x1BE"`
+	//+field:bool
+	Prefix bool `tlv:"0x1C0"`
+}
+
+type ObjStatus struct {
+	//+field:struct:spec.NameContainer
+	Name *spec.NameContainer `tlv:"0x1C2"`
+}
+
+type ClusterUpdate struct {
+	//+field:sequence:*spec.NameContainer:struct:spec.NameContainer
+	Stored []*spec.NameContainer `tlv:"0x1D1"`
+	//+field:sequence:*spec.NameContainer:struct:spec.NameContainer
+	Removed []*spec.NameContainer `tlv:"0x1D3"`
+	//+field:sequence:*SyncJoin:struct:SyncJoin
+	Joined []*SyncJoin `tlv:"0x1D5"`
+	//+field:sequence:*SyncLeave:struct:SyncLeave
+	Left []*SyncLeave `tlv:"0x1D7"`
+}
+
+type RepoStatus struct {
+	//+field:struct:spec.NameContainer
+	Name *spec.NameContainer `tlv:"0x2A1"`
+	//+field:natural
+	StartTimestamp uint64 `tlv:"0x2A3"`
+	//+field:natural
+	CurrentTimestamp uint64 `tlv:"0x2A5"`
+	//+field:sequence:*GroupStatus:struct:GroupStatus
+	Groups []*GroupStatus `tlv:"0x2A7"`
+	//+field:sequence:*PrefixStatus:struct:PrefixStatus
+	Prefixes []*PrefixStatus `tlv:"0x2A9"`
+	//+field:struct:StoreStatus
+	Store *StoreStatus `tlv:"0x2AB"`
+	//+field:natural
+	RejectedData uint64 `tlv:"0x2AD"`
+}
+
+type GroupStatus struct {
+	//+field:struct:spec.NameContainer
+	Group *spec.NameContainer `tlv:"0x193"`
+	//+field:struct:spec.NameContainer
+	Protocol *spec.NameContainer `tlv:"0x191"`
+	//+field:natural
+	StateVectorSize uint64 `tlv:"0x2B1"`
+	//+field:natural:optional
+	LastPublication optional.Optional[uint64] `tlv:"0x2B3"`
+}
+
+type PrefixStatus struct {
+	//+field:struct:spec.NameContainer
+	Prefix *spec.NameContainer `tlv:"0x2B5"`
+	//+field:natural
+	Objects uint64 `tlv:"0x2B7"`
+	//+field:natural
+	Packets uint64 `tlv:"0x2B9"`
+	//+field:natural
+	Size uint64 `tlv:"0x2BB"`
+}
+
+type StoreStatus struct {
+	//+field:bool
+	Healthy bool `tlv:"0x2C3"`
+	//+field:string
+	Error string `tlv:"0x2C5"`
+	//+field:natural
+	Packets uint64 `tlv:"0x2C7"`
+	//+field:natural
+	Size uint64 `tlv:"0x2C9"`
+}
@@ -15,11 +15,19 @@ type RepoCmdEncoder struct {
 
 	SyncJoin_encoder  SyncJoinEncoder
 	BlobFetch_encoder BlobFetchEncoder
+	ObjInsert_encoder ObjInsertEncoder
+	ObjDelete_encoder ObjDeleteEncoder
+	ObjStatus_encoder ObjStatusEncoder
+	SyncLeave_encoder SyncLeaveEncoder
 }
 
 type RepoCmdParsingContext struct {
 	SyncJoin_context  SyncJoinParsingContext
 	BlobFetch_context BlobFetchParsingContext
+	ObjInsert_context ObjInsertParsingContext
+	ObjDelete_context ObjDeleteParsingContext
+	ObjStatus_context ObjStatusParsingContext
+	SyncLeave_context SyncLeaveParsingContext
 }
 
 // Initializes the encoder with the provided RepoCmd value, setting up sub-encoders for SyncJoin and BlobFetch if present, and calculates the total encoded length by summing fixed overhead, length metadata, and payload sizes for each field.
@@ -30,6 +38,18 @@ func (encoder *RepoCmdEncoder) Init(value *RepoCmd) {
 	if value.BlobFetch != nil {
 		encoder.BlobFetch_encoder.Init(value.BlobFetch)
 	}
+	if value.ObjInsert != nil {
+		encoder.ObjInsert_encoder.Init(value.ObjInsert)
+	}
+	if value.ObjDelete != nil {
+		encoder.ObjDelete_encoder.Init(value.ObjDelete)
+	}
+	if value.ObjStatus != nil {
+		encoder.ObjStatus_encoder.Init(value.ObjStatus)
+	}
+	if value.SyncLeave != nil {
+		encoder.SyncLeave_encoder.Init(value.SyncLeave)
+	}
 
 	l := uint(0)
 	if value.SyncJoin != nil {
@@ -42,6 +62,26 @@ func (encoder *RepoCmdEncoder) Init(value *RepoCmd) {
 		l += uint(enc.TLNum(encoder.BlobFetch_encoder.Length).EncodingLength())
 		l += encoder.BlobFetch_encoder.Length
 	}
+	if value.ObjInsert != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.ObjInsert_encoder.Length).EncodingLength())
+		l += encoder.ObjInsert_encoder.Length
+	}
+	if value.ObjDelete != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.ObjDelete_encoder.Length).EncodingLength())
+		l += encoder.ObjDelete_encoder.Length
+	}
+	if value.ObjStatus != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.ObjStatus_encoder.Length).EncodingLength())
+		l += encoder.ObjStatus_encoder.Length
+	}
+	if value.SyncLeave != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.SyncLeave_encoder.Length).EncodingLength())
+		l += encoder.SyncLeave_encoder.Length
+	}
 	encoder.Length = l
 
 }
@@ -50,6 +90,10 @@ func (encoder *RepoCmdEncoder) Init(value *RepoCmd) {
 func (context *RepoCmdParsingContext) Init() {
 	context.SyncJoin_context.Init()
 	context.BlobFetch_context.Init()
+	context.ObjInsert_context.Init()
+	context.ObjDelete_context.Init()
+	context.ObjStatus_context.Init()
+	context.SyncLeave_context.Init()
 }
 
 // Encodes a RepoCmd object into a binary buffer using TLV (Type-Length-Value) format, handling SyncJoin and BlobFetch commands with specific type numbers (7600 and 7602) by serializing their encoded lengths and values sequentially.
@@ -77,6 +121,46 @@ func (encoder *RepoCmdEncoder) EncodeInto(value *RepoCmd, buf []byte) {
 			pos += encoder.BlobFetch_encoder.Length
 		}
 	}
+	if value.ObjInsert != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(7604))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.ObjInsert_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.ObjInsert_encoder.Length > 0 {
+			encoder.ObjInsert_encoder.EncodeInto(value.ObjInsert, buf[pos:])
+			pos += encoder.ObjInsert_encoder.Length
+		}
+	}
+	if value.ObjDelete != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(7606))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.ObjDelete_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.ObjDelete_encoder.Length > 0 {
+			encoder.ObjDelete_encoder.EncodeInto(value.ObjDelete, buf[pos:])
+			pos += encoder.ObjDelete_encoder.Length
+		}
+	}
+	if value.ObjStatus != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(7608))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.ObjStatus_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.ObjStatus_encoder.Length > 0 {
+			encoder.ObjStatus_encoder.EncodeInto(value.ObjStatus, buf[pos:])
+			pos += encoder.ObjStatus_encoder.Length
+		}
+	}
+	if value.SyncLeave != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(7610))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.SyncLeave_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.SyncLeave_encoder.Length > 0 {
+			encoder.SyncLeave_encoder.EncodeInto(value.SyncLeave, buf[pos:])
+			pos += encoder.SyncLeave_encoder.Length
+		}
+	}
 }
 
 // Encodes a RepoCmd into a binary wire format using the encoder's predefined length, returning a slice containing the serialized byte data.
@@ -95,6 +179,10 @@ func (context *RepoCmdParsingContext) Parse(reader enc.WireView, ignoreCritical
 
 	var handled_SyncJoin bool = false
 	var handled_BlobFetch bool = false
+	var handled_ObjInsert bool = false
+	var handled_ObjDelete bool = false
+	var handled_ObjStatus bool = false
+	var handled_SyncLeave bool = false
 
 	progress := -1
 	_ = progress
@@ -133,6 +221,30 @@ func (context *RepoCmdParsingContext) Parse(reader enc.WireView, ignoreCritical
 					handled_BlobFetch = true
 					value.BlobFetch, err = context.BlobFetch_context.Parse(reader.Delegate(int(l)), ignoreCritical)
 				}
+			case 7604:
+				if true {
+					handled = true
+					handled_ObjInsert = true
+					value.ObjInsert, err = context.ObjInsert_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 7606:
+				if true {
+					handled = true
+					handled_ObjDelete = true
+					value.ObjDelete, err = context.ObjDelete_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 7608:
+				if true {
+					handled = true
+					handled_ObjStatus = true
+					value.ObjStatus, err = context.ObjStatus_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 7610:
+				if true {
+					handled = true
+					handled_SyncLeave = true
+					value.SyncLeave, err = context.SyncLeave_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -157,6 +269,18 @@ func (context *RepoCmdParsingContext) Parse(reader enc.WireView, ignoreCritical
 	if !handled_BlobFetch && err == nil {
 		value.BlobFetch = nil
 	}
+	if !handled_ObjInsert && err == nil {
+		value.ObjInsert = nil
+	}
+	if !handled_ObjDelete && err == nil {
+		value.ObjDelete = nil
+	}
+	if !handled_ObjStatus && err == nil {
+		value.ObjStatus = nil
+	}
+	if !handled_SyncLeave && err == nil {
+		value.SyncLeave = nil
+	}
 
 	if err != nil {
 		return nil, err
@@ -187,20 +311,36 @@ func ParseRepoCmd(reader enc.WireView, ignoreCritical bool) (*RepoCmd, error) {
 
 type RepoCmdResEncoder struct {
 	Length uint
+
+	Name_encoder spec.NameContainerEncoder
 }
 
 type RepoCmdResParsingContext struct {
+	Name_context spec.NameContainerParsingContext
 }
 
 // Calculates the total encoded length of a RepoCmdRes message (including TLV overhead for status and message fields) and sets it on the encoder.
 func (encoder *RepoCmdResEncoder) Init(value *RepoCmdRes) {
 
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
 	l := uint(0)
 	l += 3
 	l += uint(1 + enc.Nat(value.Status).EncodingLength())
 	l += 3
 	l += uint(enc.TLNum(len(value.Message)).EncodingLength())
 	l += uint(len(value.Message))
+	if value.Name != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	if optval, ok := value.Count.Get(); ok {
+		l += 3
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
 	encoder.Length = l
 
 }
@@ -208,6 +348,8 @@ func (encoder *RepoCmdResEncoder) Init(value *RepoCmdRes) {
 // Initializes the repository command response parsing context, preparing it for processing command responses.
 func (context *RepoCmdResParsingContext) Init() {
 
+	context.Name_context.Init()
+
 }
 
 // Encodes a RepoCmdRes object into a binary TLV format in the provided buffer, including a status code (TLV type 657) and an optional message (TLV type 658).
@@ -227,6 +369,25 @@ func (encoder *RepoCmdResEncoder) EncodeInto(value *RepoCmdRes, buf []byte) {
 	pos += uint(enc.TLNum(len(value.Message)).EncodeInto(buf[pos:]))
 	copy(buf[pos:], value.Message)
 	pos += uint(len(value.Message))
+	if value.Name != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(659))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+	if optval, ok := value.Count.Get(); ok {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(660))
+		pos += 3
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
 }
 
 // Encodes a RepoCmdRes object into a pre-allocated byte buffer of the encoder's specified length and returns it as a single-element enc.Wire slice.
@@ -245,6 +406,8 @@ func (context *RepoCmdResParsingContext) Parse(reader enc.WireView, ignoreCritic
 
 	var handled_Status bool = false
 	var handled_Message bool = false
+	var handled_Name bool = false
+	var handled_Count bool = false
 
 	progress := -1
 	_ = progress
@@ -302,6 +465,35 @@ func (context *RepoCmdResParsingContext) Parse(reader enc.WireView, ignoreCritic
 						}
 					}
 				}
+			case 659:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 660:
+				if true {
+					handled = true
+					handled_Count = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.Count.Set(optval)
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -326,6 +518,12 @@ func (context *RepoCmdResParsingContext) Parse(reader enc.WireView, ignoreCritic
 	if !handled_Message && err == nil {
 		err = enc.ErrSkipRequired{Name: "Message", TypeNum: 658}
 	}
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Count && err == nil {
+		value.Count.Unset()
+	}
 
 	if err != nil {
 		return nil, err
@@ -360,6 +558,9 @@ type SyncJoinEncoder struct {
 	Group_encoder           spec.NameContainerEncoder
 	MulticastPrefix_encoder spec.NameContainerEncoder
 	HistorySnapshot_encoder HistorySnapshotConfigEncoder
+
+	TrustAnchors_subencoder []struct {
+	}
 }
 
 type SyncJoinParsingContext struct {
@@ -384,6 +585,27 @@ func (encoder *SyncJoinEncoder) Init(value *SyncJoin) {
 		encoder.HistorySnapshot_encoder.Init(value.HistorySnapshot)
 	}
 
+	{
+		TrustAnchors_l := len(value.TrustAnchors)
+		encoder.TrustAnchors_subencoder = make([]struct {
+		}, TrustAnchors_l)
+		for i := 0; i < TrustAnchors_l; i++ {
+			pseudoEncoder := &encoder.TrustAnchors_subencoder[i]
+			pseudoValue := struct {
+				TrustAnchors []byte
+			}{
+				TrustAnchors: value.TrustAnchors[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
 	l := uint(0)
 	if value.Protocol != nil {
 		l += 3
@@ -405,6 +627,32 @@ func (encoder *SyncJoinEncoder) Init(value *SyncJoin) {
 		l += uint(enc.TLNum(encoder.HistorySnapshot_encoder.Length).EncodingLength())
 		l += encoder.HistorySnapshot_encoder.Length
 	}
+	if value.TrustSchema != nil {
+		l += 3
+		l += uint(enc.TLNum(len(value.TrustSchema)).EncodingLength())
+		l += uint(len(value.TrustSchema))
+	}
+	if value.TrustAnchors != nil {
+		for seq_i, seq_v := range value.TrustAnchors {
+			pseudoEncoder := &encoder.TrustAnchors_subencoder[seq_i]
+			pseudoValue := struct {
+				TrustAnchors []byte
+			}{
+				TrustAnchors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.TrustAnchors != nil {
+					l += 3
+					l += uint(enc.TLNum(len(value.TrustAnchors)).EncodingLength())
+					l += uint(len(value.TrustAnchors))
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
 	encoder.Length = l
 
 }
@@ -415,6 +663,7 @@ func (context *SyncJoinParsingContext) Init() {
 	context.Group_context.Init()
 	context.MulticastPrefix_context.Init()
 	context.HistorySnapshot_context.Init()
+
 }
 
 // Encodes a SyncJoin object into a TLV (Type-Length-Value)-formatted byte slice, writing non-nil fields (Protocol, Group, MulticastPrefix, HistorySnapshot) with their respective type codes and encoded values into the provided buffer.
@@ -462,6 +711,38 @@ func (encoder *SyncJoinEncoder) EncodeInto(value *SyncJoin, buf []byte) {
 			pos += encoder.HistorySnapshot_encoder.Length
 		}
 	}
+	if value.TrustSchema != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(423))
+		pos += 3
+		pos += uint(enc.TLNum(len(value.TrustSchema)).EncodeInto(buf[pos:]))
+		copy(buf[pos:], value.TrustSchema)
+		pos += uint(len(value.TrustSchema))
+	}
+	if value.TrustAnchors != nil {
+		for seq_i, seq_v := range value.TrustAnchors {
+			pseudoEncoder := &encoder.TrustAnchors_subencoder[seq_i]
+			pseudoValue := struct {
+				TrustAnchors []byte
+			}{
+				TrustAnchors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.TrustAnchors != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(425))
+					pos += 3
+					pos += uint(enc.TLNum(len(value.TrustAnchors)).EncodeInto(buf[pos:]))
+					copy(buf[pos:], value.TrustAnchors)
+					pos += uint(len(value.TrustAnchors))
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
 }
 
 // Encodes a SyncJoin object into a byte slice of the encoder's specified length and returns it as a Wire.
@@ -482,6 +763,8 @@ func (context *SyncJoinParsingContext) Parse(reader enc.WireView, ignoreCritical
 	var handled_Group bool = false
 	var handled_MulticastPrefix bool = false
 	var handled_HistorySnapshot bool = false
+	var handled_TrustSchema bool = false
+	var handled_TrustAnchors bool = false
 
 	progress := -1
 	_ = progress
@@ -532,6 +815,34 @@ func (context *SyncJoinParsingContext) Parse(reader enc.WireView, ignoreCritical
 					handled_HistorySnapshot = true
 					value.HistorySnapshot, err = context.HistorySnapshot_context.Parse(reader.Delegate(int(l)), ignoreCritical)
 				}
+			case 423:
+				if true {
+					handled = true
+					handled_TrustSchema = true
+					value.TrustSchema = make([]byte, l)
+					_, err = reader.ReadFull(value.TrustSchema)
+				}
+			case 425:
+				if true {
+					handled = true
+					handled_TrustAnchors = true
+					if value.TrustAnchors == nil {
+						value.TrustAnchors = make([][]byte, 0)
+					}
+					{
+						pseudoValue := struct {
+							TrustAnchors []byte
+						}{}
+						{
+							value := &pseudoValue
+							value.TrustAnchors = make([]byte, l)
+							_, err = reader.ReadFull(value.TrustAnchors)
+							_ = value
+						}
+						value.TrustAnchors = append(value.TrustAnchors, pseudoValue.TrustAnchors)
+					}
+					progress--
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -562,6 +873,12 @@ func (context *SyncJoinParsingContext) Parse(reader enc.WireView, ignoreCritical
 	if !handled_HistorySnapshot && err == nil {
 		value.HistorySnapshot = nil
 	}
+	if !handled_TrustSchema && err == nil {
+		value.TrustSchema = nil
+	}
+	if !handled_TrustAnchors && err == nil {
+		// sequence - skip
+	}
 
 	if err != nil {
 		return nil, err
@@ -589,43 +906,64 @@ func ParseSyncJoin(reader enc.WireView, ignoreCritical bool) (*SyncJoin, error)
 	return context.Parse(reader, ignoreCritical)
 }
 
-type HistorySnapshotConfigEncoder struct {
+type SyncLeaveEncoder struct {
 	Length uint
+
+	Group_encoder spec.NameContainerEncoder
 }
 
-type HistorySnapshotConfigParsingContext struct {
+type SyncLeaveParsingContext struct {
+	Group_context spec.NameContainerParsingContext
 }
 
-// Initializes the encoder by calculating the total encoded length of the HistorySnapshotConfig, combining a fixed 3-byte overhead with the variable-length encoding of the Threshold field (1 byte for type plus the size needed to encode the threshold value as a natural number).
-func (encoder *HistorySnapshotConfigEncoder) Init(value *HistorySnapshotConfig) {
+func (encoder *SyncLeaveEncoder) Init(value *SyncLeave) {
+	if value.Group != nil {
+		encoder.Group_encoder.Init(value.Group)
+	}
 
 	l := uint(0)
-	l += 3
-	l += uint(1 + enc.Nat(value.Threshold).EncodingLength())
+	if value.Group != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Group_encoder.Length).EncodingLength())
+		l += encoder.Group_encoder.Length
+	}
+	if value.DeleteData {
+		l += 3
+		l += 1
+	}
 	encoder.Length = l
 
 }
 
-// Initializes the HistorySnapshotConfigParsingContext, preparing it for parsing configuration data.
-func (context *HistorySnapshotConfigParsingContext) Init() {
+func (context *SyncLeaveParsingContext) Init() {
+	context.Group_context.Init()
 
 }
 
-// Encodes a HistorySnapshotConfig into a binary buffer using a TLV-like format, writing a fixed type (253) and length (421) header followed by the Threshold field encoded as a variable-length natural number.
-func (encoder *HistorySnapshotConfigEncoder) EncodeInto(value *HistorySnapshotConfig, buf []byte) {
+func (encoder *SyncLeaveEncoder) EncodeInto(value *SyncLeave, buf []byte) {
 
 	pos := uint(0)
 
-	buf[pos] = 253
-	binary.BigEndian.PutUint16(buf[pos+1:], uint16(421))
-	pos += 3
-
-	buf[pos] = byte(enc.Nat(value.Threshold).EncodeInto(buf[pos+1:]))
-	pos += uint(1 + buf[pos])
+	if value.Group != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(403))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Group_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Group_encoder.Length > 0 {
+			encoder.Group_encoder.EncodeInto(value.Group, buf[pos:])
+			pos += encoder.Group_encoder.Length
+		}
+	}
+	if value.DeleteData {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(405))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
 }
 
-// Encodes a HistorySnapshotConfig into a wire-format byte slice using the encoder's precomputed length to allocate the buffer.
-func (encoder *HistorySnapshotConfigEncoder) Encode(value *HistorySnapshotConfig) enc.Wire {
+func (encoder *SyncLeaveEncoder) Encode(value *SyncLeave) enc.Wire {
 
 	wire := make(enc.Wire, 1)
 	wire[0] = make([]byte, encoder.Length)
@@ -635,15 +973,15 @@ func (encoder *HistorySnapshotConfigEncoder) Encode(value *HistorySnapshotConfig
 	return wire
 }
 
-// Parses a TLV-encoded HistorySnapshotConfig, extracting the required Threshold field (type 421) and handling critical/unrecognized fields according to the ignoreCritical flag.
-func (context *HistorySnapshotConfigParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*HistorySnapshotConfig, error) {
+func (context *SyncLeaveParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*SyncLeave, error) {
 
-	var handled_Threshold bool = false
+	var handled_Group bool = false
+	var handled_DeleteData bool = false
 
 	progress := -1
 	_ = progress
 
-	value := &HistorySnapshotConfig{}
+	value := &SyncLeave{}
 	var err error
 	var startPos int
 	for {
@@ -665,24 +1003,18 @@ func (context *HistorySnapshotConfigParsingContext) Parse(reader enc.WireView, i
 		err = nil
 		if handled := false; true {
 			switch typ {
-			case 421:
+			case 403:
 				if true {
 					handled = true
-					handled_Threshold = true
-					value.Threshold = uint64(0)
-					{
-						for i := 0; i < int(l); i++ {
-							x := byte(0)
-							x, err = reader.ReadByte()
-							if err != nil {
-								if err == io.EOF {
-									err = io.ErrUnexpectedEOF
-								}
-								break
-							}
-							value.Threshold = uint64(value.Threshold<<8) | uint64(x)
-						}
-					}
+					handled_Group = true
+					value.Group, err = context.Group_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 405:
+				if true {
+					handled = true
+					handled_DeleteData = true
+					value.DeleteData = true
+					err = reader.Skip(int(l))
 				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
@@ -702,8 +1034,11 @@ func (context *HistorySnapshotConfigParsingContext) Parse(reader enc.WireView, i
 	startPos = reader.Pos()
 	err = nil
 
-	if !handled_Threshold && err == nil {
-		err = enc.ErrSkipRequired{Name: "Threshold", TypeNum: 421}
+	if !handled_Group && err == nil {
+		value.Group = nil
+	}
+	if !handled_DeleteData && err == nil {
+		value.DeleteData = false
 	}
 
 	if err != nil {
@@ -713,41 +1048,181 @@ func (context *HistorySnapshotConfigParsingContext) Parse(reader enc.WireView, i
 	return value, nil
 }
 
-// Encodes the HistorySnapshotConfig into its wire format representation using a dedicated encoder.
-func (value *HistorySnapshotConfig) Encode() enc.Wire {
-	encoder := HistorySnapshotConfigEncoder{}
+func (value *SyncLeave) Encode() enc.Wire {
+	encoder := SyncLeaveEncoder{}
 	encoder.Init(value)
 	return encoder.Encode(value)
 }
 
-// Encodes the HistorySnapshotConfig into a byte slice by joining its encoded components.
-func (value *HistorySnapshotConfig) Bytes() []byte {
+func (value *SyncLeave) Bytes() []byte {
 	return value.Encode().Join()
 }
 
-// Parses a HistorySnapshotConfig from the provided wire format reader, with an option to ignore critical parsing errors.
-func ParseHistorySnapshotConfig(reader enc.WireView, ignoreCritical bool) (*HistorySnapshotConfig, error) {
-	context := HistorySnapshotConfigParsingContext{}
+func ParseSyncLeave(reader enc.WireView, ignoreCritical bool) (*SyncLeave, error) {
+	context := SyncLeaveParsingContext{}
 	context.Init()
 	return context.Parse(reader, ignoreCritical)
 }
 
-type BlobFetchEncoder struct {
+type HistorySnapshotConfigEncoder struct {
 	Length uint
-
-	Name_encoder    spec.NameContainerEncoder
-	Data_subencoder []struct {
-	}
 }
 
-type BlobFetchParsingContext struct {
-	Name_context spec.NameContainerParsingContext
+type HistorySnapshotConfigParsingContext struct {
 }
 
-// Initializes the BlobFetchEncoder with the provided BlobFetch's name and byte slice data, calculating the total encoded length by summing TLV header overhead, length field sizes, and payload lengths for each component.
-func (encoder *BlobFetchEncoder) Init(value *BlobFetch) {
-	if value.Name != nil {
-		encoder.Name_encoder.Init(value.Name)
+// Initializes the encoder by calculating the total encoded length of the HistorySnapshotConfig, combining a fixed 3-byte overhead with the variable-length encoding of the Threshold field (1 byte for type plus the size needed to encode the threshold value as a natural number).
+func (encoder *HistorySnapshotConfigEncoder) Init(value *HistorySnapshotConfig) {
+
+	l := uint(0)
+	l += 3
+	l += uint(1 + enc.Nat(value.Threshold).EncodingLength())
+	encoder.Length = l
+
+}
+
+// Initializes the HistorySnapshotConfigParsingContext, preparing it for parsing configuration data.
+func (context *HistorySnapshotConfigParsingContext) Init() {
+
+}
+
+// Encodes a HistorySnapshotConfig into a binary buffer using a TLV-like format, writing a fixed type (253) and length (421) header followed by the Threshold field encoded as a variable-length natural number.
+func (encoder *HistorySnapshotConfigEncoder) EncodeInto(value *HistorySnapshotConfig, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(421))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Threshold).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+// Encodes a HistorySnapshotConfig into a wire-format byte slice using the encoder's precomputed length to allocate the buffer.
+func (encoder *HistorySnapshotConfigEncoder) Encode(value *HistorySnapshotConfig) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+// Parses a TLV-encoded HistorySnapshotConfig, extracting the required Threshold field (type 421) and handling critical/unrecognized fields according to the ignoreCritical flag.
+func (context *HistorySnapshotConfigParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*HistorySnapshotConfig, error) {
+
+	var handled_Threshold bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &HistorySnapshotConfig{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 421:
+				if true {
+					handled = true
+					handled_Threshold = true
+					value.Threshold = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Threshold = uint64(value.Threshold<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Threshold && err == nil {
+		err = enc.ErrSkipRequired{Name: "Threshold", TypeNum: 421}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Encodes the HistorySnapshotConfig into its wire format representation using a dedicated encoder.
+func (value *HistorySnapshotConfig) Encode() enc.Wire {
+	encoder := HistorySnapshotConfigEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+// Encodes the HistorySnapshotConfig into a byte slice by joining its encoded components.
+func (value *HistorySnapshotConfig) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+// Parses a HistorySnapshotConfig from the provided wire format reader, with an option to ignore critical parsing errors.
+func ParseHistorySnapshotConfig(reader enc.WireView, ignoreCritical bool) (*HistorySnapshotConfig, error) {
+	context := HistorySnapshotConfigParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type BlobFetchEncoder struct {
+	Length uint
+
+	Name_encoder    spec.NameContainerEncoder
+	Data_subencoder []struct {
+	}
+}
+
+type BlobFetchParsingContext struct {
+	Name_context spec.NameContainerParsingContext
+}
+
+// Initializes the BlobFetchEncoder with the provided BlobFetch's name and byte slice data, calculating the total encoded length by summing TLV header overhead, length field sizes, and payload lengths for each component.
+func (encoder *BlobFetchEncoder) Init(value *BlobFetch) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
 	}
 	{
 		Data_l := len(value.Data)
@@ -967,3 +1442,2073 @@ func ParseBlobFetch(reader enc.WireView, ignoreCritical bool) (*BlobFetch, error
 	context.Init()
 	return context.Parse(reader, ignoreCritical)
 }
+
+type ObjInsertEncoder struct {
+	Length uint
+
+	Name_encoder spec.NameContainerEncoder
+}
+
+type ObjInsertParsingContext struct {
+	Name_context spec.NameContainerParsingContext
+}
+
+func (encoder *ObjInsertEncoder) Init(value *ObjInsert) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	encoder.Length = l
+
+}
+
+func (context *ObjInsertParsingContext) Init() {
+	context.Name_context.Init()
+}
+
+func (encoder *ObjInsertEncoder) EncodeInto(value *ObjInsert, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(444))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+}
+
+func (encoder *ObjInsertEncoder) Encode(value *ObjInsert) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *ObjInsertParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*ObjInsert, error) {
+
+	var handled_Name bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &ObjInsert{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 444:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *ObjInsert) Encode() enc.Wire {
+	encoder := ObjInsertEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *ObjInsert) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseObjInsert(reader enc.WireView, ignoreCritical bool) (*ObjInsert, error) {
+	context := ObjInsertParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type ObjDeleteEncoder struct {
+	Length uint
+
+	Name_encoder spec.NameContainerEncoder
+}
+
+type ObjDeleteParsingContext struct {
+	Name_context spec.NameContainerParsingContext
+}
+
+func (encoder *ObjDeleteEncoder) Init(value *ObjDelete) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	if value.Prefix {
+		l += 3
+		l += 1
+	}
+	encoder.Length = l
+
+}
+
+func (context *ObjDeleteParsingContext) Init() {
+	context.Name_context.Init()
+
+}
+
+func (encoder *ObjDeleteEncoder) EncodeInto(value *ObjDelete, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(446))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+	if value.Prefix {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(448))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+}
+
+func (encoder *ObjDeleteEncoder) Encode(value *ObjDelete) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *ObjDeleteParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*ObjDelete, error) {
+
+	var handled_Name bool = false
+	var handled_Prefix bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &ObjDelete{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 446:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 448:
+				if true {
+					handled = true
+					handled_Prefix = true
+					value.Prefix = true
+					err = reader.Skip(int(l))
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Prefix && err == nil {
+		value.Prefix = false
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *ObjDelete) Encode() enc.Wire {
+	encoder := ObjDeleteEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *ObjDelete) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseObjDelete(reader enc.WireView, ignoreCritical bool) (*ObjDelete, error) {
+	context := ObjDeleteParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type ObjStatusEncoder struct {
+	Length uint
+
+	Name_encoder spec.NameContainerEncoder
+}
+
+type ObjStatusParsingContext struct {
+	Name_context spec.NameContainerParsingContext
+}
+
+func (encoder *ObjStatusEncoder) Init(value *ObjStatus) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	encoder.Length = l
+
+}
+
+func (context *ObjStatusParsingContext) Init() {
+	context.Name_context.Init()
+}
+
+func (encoder *ObjStatusEncoder) EncodeInto(value *ObjStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(450))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+}
+
+func (encoder *ObjStatusEncoder) Encode(value *ObjStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *ObjStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*ObjStatus, error) {
+
+	var handled_Name bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &ObjStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 450:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *ObjStatus) Encode() enc.Wire {
+	encoder := ObjStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *ObjStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseObjStatus(reader enc.WireView, ignoreCritical bool) (*ObjStatus, error) {
+	context := ObjStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type ClusterUpdateEncoder struct {
+	Length uint
+
+	Stored_subencoder []struct {
+		Stored_encoder spec.NameContainerEncoder
+	}
+	Removed_subencoder []struct {
+		Removed_encoder spec.NameContainerEncoder
+	}
+	Joined_subencoder []struct {
+		Joined_encoder SyncJoinEncoder
+	}
+	Left_subencoder []struct {
+		Left_encoder SyncLeaveEncoder
+	}
+}
+
+type ClusterUpdateParsingContext struct {
+	Stored_context  spec.NameContainerParsingContext
+	Removed_context spec.NameContainerParsingContext
+	Joined_context  SyncJoinParsingContext
+	Left_context    SyncLeaveParsingContext
+}
+
+func (encoder *ClusterUpdateEncoder) Init(value *ClusterUpdate) {
+	{
+		Stored_l := len(value.Stored)
+		encoder.Stored_subencoder = make([]struct {
+			Stored_encoder spec.NameContainerEncoder
+		}, Stored_l)
+		for i := 0; i < Stored_l; i++ {
+			pseudoEncoder := &encoder.Stored_subencoder[i]
+			pseudoValue := struct {
+				Stored *spec.NameContainer
+			}{
+				Stored: value.Stored[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Stored != nil {
+					encoder.Stored_encoder.Init(value.Stored)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Removed_l := len(value.Removed)
+		encoder.Removed_subencoder = make([]struct {
+			Removed_encoder spec.NameContainerEncoder
+		}, Removed_l)
+		for i := 0; i < Removed_l; i++ {
+			pseudoEncoder := &encoder.Removed_subencoder[i]
+			pseudoValue := struct {
+				Removed *spec.NameContainer
+			}{
+				Removed: value.Removed[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Removed != nil {
+					encoder.Removed_encoder.Init(value.Removed)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Joined_l := len(value.Joined)
+		encoder.Joined_subencoder = make([]struct {
+			Joined_encoder SyncJoinEncoder
+		}, Joined_l)
+		for i := 0; i < Joined_l; i++ {
+			pseudoEncoder := &encoder.Joined_subencoder[i]
+			pseudoValue := struct {
+				Joined *SyncJoin
+			}{
+				Joined: value.Joined[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Joined != nil {
+					encoder.Joined_encoder.Init(value.Joined)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Left_l := len(value.Left)
+		encoder.Left_subencoder = make([]struct {
+			Left_encoder SyncLeaveEncoder
+		}, Left_l)
+		for i := 0; i < Left_l; i++ {
+			pseudoEncoder := &encoder.Left_subencoder[i]
+			pseudoValue := struct {
+				Left *SyncLeave
+			}{
+				Left: value.Left[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Left != nil {
+					encoder.Left_encoder.Init(value.Left)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Stored != nil {
+		for seq_i, seq_v := range value.Stored {
+			pseudoEncoder := &encoder.Stored_subencoder[seq_i]
+			pseudoValue := struct {
+				Stored *spec.NameContainer
+			}{
+				Stored: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Stored != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Stored_encoder.Length).EncodingLength())
+					l += encoder.Stored_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Removed != nil {
+		for seq_i, seq_v := range value.Removed {
+			pseudoEncoder := &encoder.Removed_subencoder[seq_i]
+			pseudoValue := struct {
+				Removed *spec.NameContainer
+			}{
+				Removed: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Removed != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Removed_encoder.Length).EncodingLength())
+					l += encoder.Removed_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Joined != nil {
+		for seq_i, seq_v := range value.Joined {
+			pseudoEncoder := &encoder.Joined_subencoder[seq_i]
+			pseudoValue := struct {
+				Joined *SyncJoin
+			}{
+				Joined: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Joined != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Joined_encoder.Length).EncodingLength())
+					l += encoder.Joined_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Left != nil {
+		for seq_i, seq_v := range value.Left {
+			pseudoEncoder := &encoder.Left_subencoder[seq_i]
+			pseudoValue := struct {
+				Left *SyncLeave
+			}{
+				Left: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Left != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Left_encoder.Length).EncodingLength())
+					l += encoder.Left_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *ClusterUpdateParsingContext) Init() {
+	context.Stored_context.Init()
+	context.Removed_context.Init()
+	context.Joined_context.Init()
+	context.Left_context.Init()
+}
+
+func (encoder *ClusterUpdateEncoder) EncodeInto(value *ClusterUpdate, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Stored != nil {
+		for seq_i, seq_v := range value.Stored {
+			pseudoEncoder := &encoder.Stored_subencoder[seq_i]
+			pseudoValue := struct {
+				Stored *spec.NameContainer
+			}{
+				Stored: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Stored != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(465))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Stored_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Stored_encoder.Length > 0 {
+						encoder.Stored_encoder.EncodeInto(value.Stored, buf[pos:])
+						pos += encoder.Stored_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Removed != nil {
+		for seq_i, seq_v := range value.Removed {
+			pseudoEncoder := &encoder.Removed_subencoder[seq_i]
+			pseudoValue := struct {
+				Removed *spec.NameContainer
+			}{
+				Removed: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Removed != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(467))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Removed_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Removed_encoder.Length > 0 {
+						encoder.Removed_encoder.EncodeInto(value.Removed, buf[pos:])
+						pos += encoder.Removed_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Joined != nil {
+		for seq_i, seq_v := range value.Joined {
+			pseudoEncoder := &encoder.Joined_subencoder[seq_i]
+			pseudoValue := struct {
+				Joined *SyncJoin
+			}{
+				Joined: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Joined != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(469))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Joined_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Joined_encoder.Length > 0 {
+						encoder.Joined_encoder.EncodeInto(value.Joined, buf[pos:])
+						pos += encoder.Joined_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Left != nil {
+		for seq_i, seq_v := range value.Left {
+			pseudoEncoder := &encoder.Left_subencoder[seq_i]
+			pseudoValue := struct {
+				Left *SyncLeave
+			}{
+				Left: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Left != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(471))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Left_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Left_encoder.Length > 0 {
+						encoder.Left_encoder.EncodeInto(value.Left, buf[pos:])
+						pos += encoder.Left_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *ClusterUpdateEncoder) Encode(value *ClusterUpdate) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *ClusterUpdateParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*ClusterUpdate, error) {
+
+	var handled_Stored bool = false
+	var handled_Removed bool = false
+	var handled_Joined bool = false
+	var handled_Left bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &ClusterUpdate{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 465:
+				if true {
+					handled = true
+					handled_Stored = true
+					if value.Stored == nil {
+						value.Stored = make([]*spec.NameContainer, 0)
+					}
+					{
+						pseudoValue := struct {
+							Stored *spec.NameContainer
+						}{}
+						{
+							value := &pseudoValue
+							value.Stored, err = context.Stored_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Stored = append(value.Stored, pseudoValue.Stored)
+					}
+					progress--
+				}
+			case 467:
+				if true {
+					handled = true
+					handled_Removed = true
+					if value.Removed == nil {
+						value.Removed = make([]*spec.NameContainer, 0)
+					}
+					{
+						pseudoValue := struct {
+							Removed *spec.NameContainer
+						}{}
+						{
+							value := &pseudoValue
+							value.Removed, err = context.Removed_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Removed = append(value.Removed, pseudoValue.Removed)
+					}
+					progress--
+				}
+			case 469:
+				if true {
+					handled = true
+					handled_Joined = true
+					if value.Joined == nil {
+						value.Joined = make([]*SyncJoin, 0)
+					}
+					{
+						pseudoValue := struct {
+							Joined *SyncJoin
+						}{}
+						{
+							value := &pseudoValue
+							value.Joined, err = context.Joined_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Joined = append(value.Joined, pseudoValue.Joined)
+					}
+					progress--
+				}
+			case 471:
+				if true {
+					handled = true
+					handled_Left = true
+					if value.Left == nil {
+						value.Left = make([]*SyncLeave, 0)
+					}
+					{
+						pseudoValue := struct {
+							Left *SyncLeave
+						}{}
+						{
+							value := &pseudoValue
+							value.Left, err = context.Left_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Left = append(value.Left, pseudoValue.Left)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Stored && err == nil {
+		// sequence - skip
+	}
+	if !handled_Removed && err == nil {
+		// sequence - skip
+	}
+	if !handled_Joined && err == nil {
+		// sequence - skip
+	}
+	if !handled_Left && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *ClusterUpdate) Encode() enc.Wire {
+	encoder := ClusterUpdateEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *ClusterUpdate) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseClusterUpdate(reader enc.WireView, ignoreCritical bool) (*ClusterUpdate, error) {
+	context := ClusterUpdateParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RepoStatusEncoder struct {
+	Length uint
+
+	Name_encoder spec.NameContainerEncoder
+
+	Groups_subencoder []struct {
+		Groups_encoder GroupStatusEncoder
+	}
+	Prefixes_subencoder []struct {
+		Prefixes_encoder PrefixStatusEncoder
+	}
+	Store_encoder StoreStatusEncoder
+}
+
+type RepoStatusParsingContext struct {
+	Name_context spec.NameContainerParsingContext
+
+	Groups_context   GroupStatusParsingContext
+	Prefixes_context PrefixStatusParsingContext
+	Store_context    StoreStatusParsingContext
+}
+
+func (encoder *RepoStatusEncoder) Init(value *RepoStatus) {
+	if value.Name != nil {
+		encoder.Name_encoder.Init(value.Name)
+	}
+
+	{
+		Groups_l := len(value.Groups)
+		encoder.Groups_subencoder = make([]struct {
+			Groups_encoder GroupStatusEncoder
+		}, Groups_l)
+		for i := 0; i < Groups_l; i++ {
+			pseudoEncoder := &encoder.Groups_subencoder[i]
+			pseudoValue := struct {
+				Groups *GroupStatus
+			}{
+				Groups: value.Groups[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Groups != nil {
+					encoder.Groups_encoder.Init(value.Groups)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	{
+		Prefixes_l := len(value.Prefixes)
+		encoder.Prefixes_subencoder = make([]struct {
+			Prefixes_encoder PrefixStatusEncoder
+		}, Prefixes_l)
+		for i := 0; i < Prefixes_l; i++ {
+			pseudoEncoder := &encoder.Prefixes_subencoder[i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: value.Prefixes[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					encoder.Prefixes_encoder.Init(value.Prefixes)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Store != nil {
+		encoder.Store_encoder.Init(value.Store)
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Name_encoder.Length).EncodingLength())
+		l += encoder.Name_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.StartTimestamp).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.CurrentTimestamp).EncodingLength())
+	if value.Groups != nil {
+		for seq_i, seq_v := range value.Groups {
+			pseudoEncoder := &encoder.Groups_subencoder[seq_i]
+			pseudoValue := struct {
+				Groups *GroupStatus
+			}{
+				Groups: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Groups != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Groups_encoder.Length).EncodingLength())
+					l += encoder.Groups_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodingLength())
+					l += encoder.Prefixes_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Store != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Store_encoder.Length).EncodingLength())
+		l += encoder.Store_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.RejectedData).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *RepoStatusParsingContext) Init() {
+	context.Name_context.Init()
+
+	context.Groups_context.Init()
+	context.Prefixes_context.Init()
+	context.Store_context.Init()
+
+}
+
+func (encoder *RepoStatusEncoder) EncodeInto(value *RepoStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(673))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Name_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Name_encoder.Length > 0 {
+			encoder.Name_encoder.EncodeInto(value.Name, buf[pos:])
+			pos += encoder.Name_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(675))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.StartTimestamp).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(677))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.CurrentTimestamp).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Groups != nil {
+		for seq_i, seq_v := range value.Groups {
+			pseudoEncoder := &encoder.Groups_subencoder[seq_i]
+			pseudoValue := struct {
+				Groups *GroupStatus
+			}{
+				Groups: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Groups != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(679))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Groups_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Groups_encoder.Length > 0 {
+						encoder.Groups_encoder.EncodeInto(value.Groups, buf[pos:])
+						pos += encoder.Groups_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(681))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Prefixes_encoder.Length > 0 {
+						encoder.Prefixes_encoder.EncodeInto(value.Prefixes, buf[pos:])
+						pos += encoder.Prefixes_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.Store != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(683))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Store_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Store_encoder.Length > 0 {
+			encoder.Store_encoder.EncodeInto(value.Store, buf[pos:])
+			pos += encoder.Store_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(685))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.RejectedData).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *RepoStatusEncoder) Encode(value *RepoStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RepoStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RepoStatus, error) {
+
+	var handled_Name bool = false
+	var handled_StartTimestamp bool = false
+	var handled_CurrentTimestamp bool = false
+	var handled_Groups bool = false
+	var handled_Prefixes bool = false
+	var handled_Store bool = false
+	var handled_RejectedData bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RepoStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 673:
+				if true {
+					handled = true
+					handled_Name = true
+					value.Name, err = context.Name_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 675:
+				if true {
+					handled = true
+					handled_StartTimestamp = true
+					value.StartTimestamp = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.StartTimestamp = uint64(value.StartTimestamp<<8) | uint64(x)
+						}
+					}
+				}
+			case 677:
+				if true {
+					handled = true
+					handled_CurrentTimestamp = true
+					value.CurrentTimestamp = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.CurrentTimestamp = uint64(value.CurrentTimestamp<<8) | uint64(x)
+						}
+					}
+				}
+			case 679:
+				if true {
+					handled = true
+					handled_Groups = true
+					if value.Groups == nil {
+						value.Groups = make([]*GroupStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Groups *GroupStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Groups, err = context.Groups_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Groups = append(value.Groups, pseudoValue.Groups)
+					}
+					progress--
+				}
+			case 681:
+				if true {
+					handled = true
+					handled_Prefixes = true
+					if value.Prefixes == nil {
+						value.Prefixes = make([]*PrefixStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Prefixes *PrefixStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Prefixes, err = context.Prefixes_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Prefixes = append(value.Prefixes, pseudoValue.Prefixes)
+					}
+					progress--
+				}
+			case 683:
+				if true {
+					handled = true
+					handled_Store = true
+					value.Store, err = context.Store_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 685:
+				if true {
+					handled = true
+					handled_RejectedData = true
+					value.RejectedData = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.RejectedData = uint64(value.RejectedData<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_StartTimestamp && err == nil {
+		err = enc.ErrSkipRequired{Name: "StartTimestamp", TypeNum: 675}
+	}
+	if !handled_CurrentTimestamp && err == nil {
+		err = enc.ErrSkipRequired{Name: "CurrentTimestamp", TypeNum: 677}
+	}
+	if !handled_Groups && err == nil {
+		// sequence - skip
+	}
+	if !handled_Prefixes && err == nil {
+		// sequence - skip
+	}
+	if !handled_Store && err == nil {
+		value.Store = nil
+	}
+	if !handled_RejectedData && err == nil {
+		err = enc.ErrSkipRequired{Name: "RejectedData", TypeNum: 685}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RepoStatus) Encode() enc.Wire {
+	encoder := RepoStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RepoStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRepoStatus(reader enc.WireView, ignoreCritical bool) (*RepoStatus, error) {
+	context := RepoStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type GroupStatusEncoder struct {
+	Length uint
+
+	Group_encoder    spec.NameContainerEncoder
+	Protocol_encoder spec.NameContainerEncoder
+}
+
+type GroupStatusParsingContext struct {
+	Group_context    spec.NameContainerParsingContext
+	Protocol_context spec.NameContainerParsingContext
+}
+
+func (encoder *GroupStatusEncoder) Init(value *GroupStatus) {
+	if value.Group != nil {
+		encoder.Group_encoder.Init(value.Group)
+	}
+	if value.Protocol != nil {
+		encoder.Protocol_encoder.Init(value.Protocol)
+	}
+
+	l := uint(0)
+	if value.Group != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Group_encoder.Length).EncodingLength())
+		l += encoder.Group_encoder.Length
+	}
+	if value.Protocol != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Protocol_encoder.Length).EncodingLength())
+		l += encoder.Protocol_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.StateVectorSize).EncodingLength())
+	if optval, ok := value.LastPublication.Get(); ok {
+		l += 3
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	encoder.Length = l
+
+}
+
+func (context *GroupStatusParsingContext) Init() {
+	context.Group_context.Init()
+	context.Protocol_context.Init()
+
+}
+
+func (encoder *GroupStatusEncoder) EncodeInto(value *GroupStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Group != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(403))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Group_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Group_encoder.Length > 0 {
+			encoder.Group_encoder.EncodeInto(value.Group, buf[pos:])
+			pos += encoder.Group_encoder.Length
+		}
+	}
+	if value.Protocol != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(401))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Protocol_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Protocol_encoder.Length > 0 {
+			encoder.Protocol_encoder.EncodeInto(value.Protocol, buf[pos:])
+			pos += encoder.Protocol_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(689))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.StateVectorSize).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if optval, ok := value.LastPublication.Get(); ok {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(691))
+		pos += 3
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+}
+
+func (encoder *GroupStatusEncoder) Encode(value *GroupStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *GroupStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*GroupStatus, error) {
+
+	var handled_Group bool = false
+	var handled_Protocol bool = false
+	var handled_StateVectorSize bool = false
+	var handled_LastPublication bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &GroupStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 403:
+				if true {
+					handled = true
+					handled_Group = true
+					value.Group, err = context.Group_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 401:
+				if true {
+					handled = true
+					handled_Protocol = true
+					value.Protocol, err = context.Protocol_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 689:
+				if true {
+					handled = true
+					handled_StateVectorSize = true
+					value.StateVectorSize = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.StateVectorSize = uint64(value.StateVectorSize<<8) | uint64(x)
+						}
+					}
+				}
+			case 691:
+				if true {
+					handled = true
+					handled_LastPublication = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.LastPublication.Set(optval)
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Group && err == nil {
+		value.Group = nil
+	}
+	if !handled_Protocol && err == nil {
+		value.Protocol = nil
+	}
+	if !handled_StateVectorSize && err == nil {
+		err = enc.ErrSkipRequired{Name: "StateVectorSize", TypeNum: 689}
+	}
+	if !handled_LastPublication && err == nil {
+		value.LastPublication.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *GroupStatus) Encode() enc.Wire {
+	encoder := GroupStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *GroupStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseGroupStatus(reader enc.WireView, ignoreCritical bool) (*GroupStatus, error) {
+	context := GroupStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixStatusEncoder struct {
+	Length uint
+
+	Prefix_encoder spec.NameContainerEncoder
+}
+
+type PrefixStatusParsingContext struct {
+	Prefix_context spec.NameContainerParsingContext
+}
+
+func (encoder *PrefixStatusEncoder) Init(value *PrefixStatus) {
+	if value.Prefix != nil {
+		encoder.Prefix_encoder.Init(value.Prefix)
+	}
+
+	l := uint(0)
+	if value.Prefix != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.Prefix_encoder.Length).EncodingLength())
+		l += encoder.Prefix_encoder.Length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.Objects).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.Packets).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.Size).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *PrefixStatusParsingContext) Init() {
+	context.Prefix_context.Init()
+
+}
+
+func (encoder *PrefixStatusEncoder) EncodeInto(value *PrefixStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Prefix != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(693))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.Prefix_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Prefix_encoder.Length > 0 {
+			encoder.Prefix_encoder.EncodeInto(value.Prefix, buf[pos:])
+			pos += encoder.Prefix_encoder.Length
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(695))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Objects).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(697))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Packets).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(699))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Size).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *PrefixStatusEncoder) Encode(value *PrefixStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+
+	var handled_Prefix bool = false
+	var handled_Objects bool = false
+	var handled_Packets bool = false
+	var handled_Size bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 693:
+				if true {
+					handled = true
+					handled_Prefix = true
+					value.Prefix, err = context.Prefix_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			case 695:
+				if true {
+					handled = true
+					handled_Objects = true
+					value.Objects = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Objects = uint64(value.Objects<<8) | uint64(x)
+						}
+					}
+				}
+			case 697:
+				if true {
+					handled = true
+					handled_Packets = true
+					value.Packets = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Packets = uint64(value.Packets<<8) | uint64(x)
+						}
+					}
+				}
+			case 699:
+				if true {
+					handled = true
+					handled_Size = true
+					value.Size = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Size = uint64(value.Size<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Prefix && err == nil {
+		value.Prefix = nil
+	}
+	if !handled_Objects && err == nil {
+		err = enc.ErrSkipRequired{Name: "Objects", TypeNum: 695}
+	}
+	if !handled_Packets && err == nil {
+		err = enc.ErrSkipRequired{Name: "Packets", TypeNum: 697}
+	}
+	if !handled_Size && err == nil {
+		err = enc.ErrSkipRequired{Name: "Size", TypeNum: 699}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixStatus) Encode() enc.Wire {
+	encoder := PrefixStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixStatus(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+	context := PrefixStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type StoreStatusEncoder struct {
+	Length uint
+}
+
+type StoreStatusParsingContext struct {
+}
+
+func (encoder *StoreStatusEncoder) Init(value *StoreStatus) {
+
+	l := uint(0)
+	if value.Healthy {
+		l += 3
+		l += 1
+	}
+	l += 3
+	l += uint(enc.TLNum(len(value.Error)).EncodingLength())
+	l += uint(len(value.Error))
+	l += 3
+	l += uint(1 + enc.Nat(value.Packets).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.Size).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *StoreStatusParsingContext) Init() {
+
+}
+
+func (encoder *StoreStatusEncoder) EncodeInto(value *StoreStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Healthy {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(707))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(709))
+	pos += 3
+	pos += uint(enc.TLNum(len(value.Error)).EncodeInto(buf[pos:]))
+	copy(buf[pos:], value.Error)
+	pos += uint(len(value.Error))
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(711))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Packets).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(713))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.Size).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *StoreStatusEncoder) Encode(value *StoreStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *StoreStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*StoreStatus, error) {
+
+	var handled_Healthy bool = false
+	var handled_Error bool = false
+	var handled_Packets bool = false
+	var handled_Size bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &StoreStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 707:
+				if true {
+					handled = true
+					handled_Healthy = true
+					value.Healthy = true
+					err = reader.Skip(int(l))
+				}
+			case 709:
+				if true {
+					handled = true
+					handled_Error = true
+					{
+						var builder strings.Builder
+						_, err = reader.CopyN(&builder, int(l))
+						if err == nil {
+							value.Error = builder.String()
+						}
+					}
+				}
+			case 711:
+				if true {
+					handled = true
+					handled_Packets = true
+					value.Packets = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Packets = uint64(value.Packets<<8) | uint64(x)
+						}
+					}
+				}
+			case 713:
+				if true {
+					handled = true
+					handled_Size = true
+					value.Size = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Size = uint64(value.Size<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Healthy && err == nil {
+		value.Healthy = false
+	}
+	if !handled_Error && err == nil {
+		err = enc.ErrSkipRequired{Name: "Error", TypeNum: 709}
+	}
+	if !handled_Packets && err == nil {
+		err = enc.ErrSkipRequired{Name: "Packets", TypeNum: 711}
+	}
+	if !handled_Size && err == nil {
+		err = enc.ErrSkipRequired{Name: "Size", TypeNum: 713}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *StoreStatus) Encode() enc.Wire {
+	encoder := StoreStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *StoreStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseStoreStatus(reader enc.WireView, ignoreCritical bool) (*StoreStatus, error) {
+	context := StoreStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
@@ -105,13 +105,13 @@ func (c Component) Append(rest ...Component) Name {
 // Returns the total number of bytes required to encode the component, summing the encoded lengths of its type, the length of its value (as a natural number), and the value itself.
 func (c Component) EncodingLength() int {
 	l := len(c.Val)
-	return c.Typ.EncodingLength() + Nat(l).EncodingLength() + l
+	return c.Typ.EncodingLength() + TLNum(l).EncodingLength() + l
 }
 
 // Encodes the component's type and variable-length value into the provided buffer, returning the total number of bytes written (type encoding + value length encoding + value data).
 func (c Component) EncodeInto(buf Buffer) int {
 	p1 := c.Typ.EncodeInto(buf)
-	p2 := Nat(len(c.Val)).EncodeInto(buf[p1:])
+	p2 := TLNum(len(c.Val)).EncodeInto(buf[p1:])
 	copy(buf[p1+p2:], c.Val)
 	return p1 + p2 + len(c.Val)
 }
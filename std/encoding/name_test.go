@@ -340,6 +340,13 @@ func TestNameBytes(t *testing.T) {
 
 	n2 := tu.NoErr(enc.NameFromBytes([]byte("\x07\x0c\x08\x01a\x08\x01b\x08\x01c\x08\x01d")))
 	require.True(t, n.Equal(n2))
+
+	// Lengths of 253 and more use the three-octet encoding
+	long := enc.Name{enc.NewGenericBytesComponent(make([]byte, 300))}
+	wire := long.Bytes()
+	require.Equal(t, []byte("\x07\xfd\x01\x30\x08\xfd\x01\x2c"), wire[:8])
+	require.Len(t, wire, 308)
+	require.True(t, long.Equal(tu.NoErr(enc.NameFromBytes(wire))))
 }
 
 // This function tests the correct appending of components to an NDN Name, ensuring that the `Append` method safely handles memory allocation to prevent unintended overwrites (as seen with Go's standard `append`) while verifying the resulting name strings and underlying array allocations.
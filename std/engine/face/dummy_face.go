@@ -2,6 +2,7 @@ package face
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -10,6 +11,7 @@ import (
 type DummyFace struct {
 	baseFace
 	sendPkts []enc.Buffer
+	mutex    sync.Mutex
 }
 
 // Constructs a new DummyFace initialized with a base face (configured for dummy mode) and an empty packet buffer, used to simulate network interactions and capture outgoing packets during testing.
@@ -50,6 +52,9 @@ func (f *DummyFace) Send(pkt enc.Wire) error {
 	if !f.running.Load() {
 		return fmt.Errorf("face is not running")
 	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(pkt) == 1 {
 		f.sendPkts = append(f.sendPkts, pkt[0])
 	} else if len(pkt) >= 2 {
@@ -83,6 +88,8 @@ func (f *DummyFace) Consume() (enc.Buffer, error) {
 	// hack: yield to wait for packet to arrive
 	time.Sleep(10 * time.Millisecond)
 
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	if len(f.sendPkts) == 0 {
 		return nil, fmt.Errorf("no packet to consume")
 	}
@@ -35,6 +35,10 @@ type Client interface {
 	// more control over the fetching process.
 	ConsumeExt(args ConsumeExtArgs)
 
+	// Subscribe watches for new versions of an object.
+	// The subscription must be cancelled when it is no longer needed.
+	Subscribe(args SubscribeArgs) Subscription
+
 	// LatestLocal returns the latest version name of an object in the store.
 	LatestLocal(name enc.Name) (enc.Name, error)
 	// GetLocal returns the object data from the store.
@@ -127,6 +131,53 @@ type ConsumeExtArgs struct {
 	NoMetadata bool
 	// IgnoreValidity ignores validity period in the validation chain
 	IgnoreValidity optional.Optional[bool]
+	// Decrypter decrypts the object content before Callback is called.
+	// [Caution] Content() must not be called in OnProgress if this is set.
+	Decrypter ContentDecrypter
+}
+
+// ContentDecrypter decrypts the content of an object after it is fetched.
+type ContentDecrypter interface {
+	// Decrypt decrypts the content of an object.
+	// The client may be used to fetch any keys required for decryption.
+	Decrypt(client Client, content enc.Wire, callback func(enc.Wire, error))
+}
+
+// SubscribeArgs are the arguments for the version subscription API.
+type SubscribeArgs struct {
+	// Name is the name of the object without a version.
+	Name enc.Name
+	// Callback is called with the name of each new version.
+	// If FetchContent is set, state is the completely fetched object,
+	// otherwise it is nil. Versions are always delivered in increasing order.
+	Callback func(version enc.Name, state ConsumeState)
+	// OnError is called when discovery or fetching fails (optional).
+	// The subscription continues after an error.
+	OnError func(err error)
+	// FetchContent fetches the content of each new version.
+	FetchContent bool
+	// NoMetadata discovers versions without RDR metadata (advanced usage).
+	NoMetadata bool
+	// Passive disables periodic discovery. New versions must be given to
+	// the subscription with Notify, e.g. from a sync group update.
+	Passive bool
+	// MinInterval is the initial discovery interval (default 1s).
+	MinInterval time.Duration
+	// MaxInterval is the maximum discovery interval for backoff (default 60s).
+	MaxInterval time.Duration
+	// Decrypter for the fetched content (optional).
+	Decrypter ContentDecrypter
+}
+
+// Subscription is a handle to an active version subscription.
+type Subscription interface {
+	// Latest returns the latest known version name, or nil.
+	Latest() enc.Name
+	// Notify tells the subscription about a new version of the object.
+	// If the name is not versioned, discovery is triggered immediately.
+	Notify(name enc.Name)
+	// Cancel stops the subscription.
+	Cancel()
 }
 
 // ExpressRArgs are the arguments for the express retry API.
@@ -0,0 +1,47 @@
+//go:generate gondn_tlv_gen
+package crdt
+
+// State is the state (or delta) of a replicated data type.
+// Each data type uses only the fields it needs.
+type State struct {
+	//+field:sequence:*Counter:struct:Counter
+	Positive []*Counter `tlv:"0xe1"`
+	//+field:sequence:*Counter:struct:Counter
+	Negative []*Counter `tlv:"0xe3"`
+	//+field:sequence:*Register:struct:Register
+	Registers []*Register `tlv:"0xe5"`
+	//+field:sequence:*Element:struct:Element
+	Elements []*Element `tlv:"0xe7"`
+	//+field:sequence:[]byte:binary:[]byte
+	Removed [][]byte `tlv:"0xe9"`
+}
+
+// Counter is the count of a single replica.
+type Counter struct {
+	//+field:string
+	Replica string `tlv:"0xeb"`
+	//+field:natural
+	Value uint64 `tlv:"0xed"`
+}
+
+// Register is a last-writer-wins value, optionally with a key.
+type Register struct {
+	//+field:binary
+	Key []byte `tlv:"0xef"`
+	//+field:binary
+	Value []byte `tlv:"0xf1"`
+	//+field:natural
+	Timestamp uint64 `tlv:"0xf3"`
+	//+field:string
+	Replica string `tlv:"0xeb"`
+	//+field:bool
+	Deleted bool `tlv:"0xf5"`
+}
+
+// Element is a uniquely tagged element of an observed-remove set.
+type Element struct {
+	//+field:binary
+	Value []byte `tlv:"0xf1"`
+	//+field:binary
+	Tag []byte `tlv:"0xf7"`
+}
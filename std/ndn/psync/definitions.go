@@ -0,0 +1,19 @@
+//go:generate gondn_tlv_gen
+package psync
+
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// SyncData is the uncompressed content of PSync Data.
+type SyncData struct {
+	//+field:struct:State
+	State *State `tlv:"0x80"`
+}
+
+// State lists the latest name (prefix with sequence number)
+// of each updated prefix.
+type State struct {
+	//+field:sequence:enc.Name:name
+	Content []enc.Name `tlv:"0x07"`
+}
@@ -1,12 +1,23 @@
 package ndn
 
-import enc "github.com/named-data/ndnd/std/encoding"
+import (
+	"iter"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
 
 type Store interface {
 	// Get returns a Data wire matching the given name
 	// prefix = return the lexicographically last Data wire with the given prefix
 	Get(name enc.Name, prefix bool) ([]byte, error)
 
+	// Iter iterates over all Data wires under a prefix in name order.
+	// The store must not be modified during the iteration.
+	// The returned function reports the error that ended the iteration early,
+	// and must be checked after the loop.
+	Iter(prefix enc.Name) (iter.Seq2[enc.Name, []byte], func() error)
+
 	// Put inserts a Data wire into the store
 	Put(name enc.Name, wire []byte) error
 
@@ -32,3 +43,23 @@ type Store interface {
 	// Rollback discards a write transaction
 	Rollback() error
 }
+
+// StoreInspector is implemented by stores that can report usage statistics.
+type StoreInspector interface {
+	// Stats returns a snapshot of the store usage.
+	Stats() StoreStats
+}
+
+// StoreStats is a snapshot of the usage of a store.
+type StoreStats struct {
+	// Number of Data wires in the store.
+	Objects int
+	// Total size of Data wires in bytes.
+	Bytes int64
+	// Number of Data wires removed to enforce quotas.
+	Evicted uint64
+	// Number of Data wires removed after their TTL expired.
+	Expired uint64
+	// Time of the last completed sweep (zero if never).
+	LastSweep time.Time
+}
@@ -29,6 +29,11 @@ func (c *Client) ConsumeExt(args ndn.ConsumeExtArgs) {
 	// clone the name for good measure
 	args.Name = args.Name.Clone()
 
+	// decrypt the object before handing it to the application
+	if args.Decrypter != nil {
+		args.Callback = c.decryptCallback(args.Decrypter, args.Callback)
+	}
+
 	// create new consume state
 	c.consumeObject(&ConsumeState{
 		args:      args,
@@ -103,6 +108,31 @@ func (c *Client) consumeObjectWithMeta(state *ConsumeState, meta *rdr.MetaData)
 	c.consumeObject(state)
 }
 
+// decryptCallback wraps a consume callback to decrypt the completed object
+func (c *Client) decryptCallback(
+	decrypter ndn.ContentDecrypter,
+	callback func(status ndn.ConsumeState),
+) func(status ndn.ConsumeState) {
+	return func(status ndn.ConsumeState) {
+		state := status.(*ConsumeState)
+		if state.err != nil {
+			callback(state)
+			return
+		}
+
+		decrypter.Decrypt(c, state.Content(), func(content enc.Wire, err error) {
+			if err != nil {
+				state.err = fmt.Errorf("%w: failed to decrypt object: %w", ndn.ErrSecurity, err)
+			} else {
+				// replace the content with the plaintext
+				state.content = content
+				state.wnd = FetchWindow{Valid: 0, Fetching: len(content), Pending: len(content)}
+			}
+			callback(state)
+		})
+	}
+}
+
 // fetchMetadata gets the RDR metadata for an object with a given name
 func (c *Client) fetchMetadata(
 	name enc.Name,
@@ -0,0 +1,264 @@
+package object
+
+import (
+	"sync"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	rdr "github.com/named-data/ndnd/std/ndn/rdr_2024"
+)
+
+// default discovery intervals for subscriptions
+const subMinInterval = time.Second
+const subMaxInterval = 60 * time.Second
+
+// subscription is the state of a version subscription
+type subscription struct {
+	client *Client
+	args   ndn.SubscribeArgs
+	mutex  sync.Mutex
+
+	// latest known version
+	latest enc.Name
+	// latest version handed to the application
+	delivered uint64
+	// current discovery interval
+	interval time.Duration
+	// cancel the scheduled discovery
+	timer func() error
+	// current interval between fetch retries
+	retryInterval time.Duration
+	// cancel the scheduled fetch retry
+	retry func() error
+	// discovery is in progress
+	discovering bool
+	// content is being fetched
+	fetching bool
+	// subscription is cancelled
+	cancelled bool
+}
+
+// Subscribe watches for new versions of an object, either by repeated
+// RDR discovery with exponential backoff, or by notifications (e.g. SVS).
+func (c *Client) Subscribe(args ndn.SubscribeArgs) ndn.Subscription {
+	args.Name = args.Name.Clone()
+	if args.MinInterval <= 0 {
+		args.MinInterval = subMinInterval
+	}
+	if args.MaxInterval < args.MinInterval {
+		args.MaxInterval = max(subMaxInterval, args.MinInterval)
+	}
+
+	s := &subscription{
+		client:        c,
+		args:          args,
+		interval:      args.MinInterval,
+		timer:         func() error { return nil },
+		retryInterval: args.MinInterval,
+		retry:         func() error { return nil },
+	}
+
+	if !args.Passive {
+		s.mutex.Lock()
+		s.discover()
+		s.mutex.Unlock()
+	}
+
+	return s
+}
+
+// Instance log identifier
+func (s *subscription) String() string {
+	return "client-sub"
+}
+
+// Latest returns the latest known version name
+func (s *subscription) Latest() enc.Name {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.latest
+}
+
+// Notify tells the subscription about a new version of the object
+func (s *subscription) Notify(name enc.Name) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.cancelled {
+		return
+	}
+
+	// version is known, no need to discover
+	if name.At(-1).IsVersion() && s.args.Name.IsPrefix(name) && len(name) == len(s.args.Name)+1 {
+		s.onVersion(name)
+		return
+	}
+
+	// unversioned notification, discover immediately
+	s.timer()
+	s.interval = s.args.MinInterval
+	s.discover()
+}
+
+// Cancel stops the subscription
+func (s *subscription) Cancel() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.cancelled = true
+	s.timer()
+	s.retry()
+}
+
+// discover starts a single round of version discovery (requires lock)
+func (s *subscription) discover() {
+	if s.cancelled || s.discovering {
+		return
+	}
+	s.discovering = true
+
+	onResult := func(meta *rdr.MetaData, err error) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.discovering = false
+
+		if s.cancelled {
+			return
+		}
+
+		found := false
+		if err != nil {
+			log.Debug(s, "Version discovery failed", "name", s.args.Name, "err", err)
+			s.onError(err)
+		} else {
+			found = s.onVersion(meta.Name)
+		}
+
+		// backoff exponentially if nothing new was found
+		if found {
+			s.interval = s.args.MinInterval
+		} else {
+			s.interval = min(s.interval*2, s.args.MaxInterval)
+		}
+
+		if !s.args.Passive {
+			s.timer = s.client.engine.Timer().Schedule(s.interval, func() {
+				s.mutex.Lock()
+				defer s.mutex.Unlock()
+				s.discover()
+			})
+		}
+	}
+
+	// discovery must always bypass the local store to get fresh data.
+	// post to the engine since errors may be reported synchronously.
+	s.client.engine.Post(func() {
+		if s.args.NoMetadata {
+			s.client.fetchDataByPrefix(s.args.Name, false, false, func(data ndn.Data, err error) {
+				if err != nil {
+					onResult(nil, err)
+					return
+				}
+				onResult(extractSegMetadata(data))
+			})
+		} else {
+			s.client.fetchMetadata(s.args.Name, false, false, onResult)
+		}
+	})
+}
+
+// onVersion handles a versioned object name (requires lock)
+// returns true if the version is newer than any known version
+func (s *subscription) onVersion(name enc.Name) bool {
+	if len(name) != len(s.args.Name)+1 || !name.At(-1).IsVersion() {
+		return false
+	}
+	if s.latest != nil && name.At(-1).NumberVal() <= s.latest.At(-1).NumberVal() {
+		return false
+	}
+
+	s.latest = name.Clone()
+	log.Debug(s, "Discovered new version", "name", s.latest)
+
+	if !s.args.FetchContent {
+		s.delivered = name.At(-1).NumberVal()
+		callback, latest := s.args.Callback, s.latest
+		s.client.engine.Post(func() { callback(latest, nil) })
+		return true
+	}
+
+	s.fetchNext()
+	return true
+}
+
+// fetchNext fetches the content of the latest version if not already in progress (requires lock)
+// intermediate versions seen during a fetch are skipped
+func (s *subscription) fetchNext() {
+	if s.cancelled || s.fetching || s.latest == nil {
+		return
+	}
+	if s.latest.At(-1).NumberVal() <= s.delivered {
+		return
+	}
+
+	s.fetching = true
+	name := s.latest
+	s.client.engine.Post(func() { s.fetch(name) })
+}
+
+// fetch the content of a single version and deliver it
+func (s *subscription) fetch(name enc.Name) {
+	s.client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:      name,
+		Decrypter: s.args.Decrypter,
+		Callback: func(state ndn.ConsumeState) {
+			err := state.Error()
+
+			s.mutex.Lock()
+			s.fetching = false
+			if err == nil {
+				s.delivered = max(s.delivered, name.At(-1).NumberVal())
+				s.retryInterval = s.args.MinInterval
+			}
+			cancelled := s.cancelled
+			s.mutex.Unlock()
+
+			if cancelled {
+				return
+			}
+
+			if err != nil {
+				s.mutex.Lock()
+				defer s.mutex.Unlock()
+				log.Debug(s, "Version fetch failed", "name", name, "err", err)
+				s.onError(err)
+
+				// the version is not delivered, retry with backoff
+				s.retry()
+				s.retry = s.client.engine.Timer().Schedule(s.retryInterval, func() {
+					s.mutex.Lock()
+					defer s.mutex.Unlock()
+					s.fetchNext()
+				})
+				s.retryInterval = min(s.retryInterval*2, s.args.MaxInterval)
+				return
+			}
+
+			s.args.Callback(name, state)
+
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			s.fetchNext()
+		},
+	})
+}
+
+// onError calls the error callback if set (requires lock)
+func (s *subscription) onError(err error) {
+	if s.args.OnError != nil {
+		onError := s.args.OnError
+		s.client.engine.Post(func() { onError(err) })
+	}
+}
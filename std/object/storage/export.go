@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// TLV type of a Data packet
+const tlvTypeData enc.TLNum = 0x06
+
+// ExportStore writes all Data wires under a prefix to a TLV stream.
+// The stream is a plain concatenation of Data packets in name order.
+// Returns the number of Data packets written; if reading the store fails,
+// the stream is incomplete and the error is returned.
+func ExportStore(store ndn.Store, prefix enc.Name, w io.Writer) (count int, err error) {
+	bw := bufio.NewWriter(w)
+	wires, iterErr := store.Iter(prefix)
+	for _, wire := range wires {
+		if _, err = bw.Write(wire); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err = iterErr(); err != nil {
+		return count, fmt.Errorf("failed to read store: %w", err)
+	}
+	return count, bw.Flush()
+}
+
+// number of Data packets committed in a single import transaction
+// (at most ~4.5MB, well below the transaction limits of badger)
+const importBatchSize = 512
+
+// ImportStore reads a TLV stream of Data packets into a store.
+// Packets are committed in batches; if an error occurs, previously committed
+// batches remain in the store. Returns the number of Data packets committed.
+func ImportStore(store ndn.Store, r io.Reader) (count int, err error) {
+	var tx ndn.Store
+	pending := 0
+
+	br := bufio.NewReader(r)
+	for {
+		wire, err := readTlvPacket(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, rollback(tx, err)
+		}
+
+		data, _, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+		if err != nil {
+			return count, rollback(tx, fmt.Errorf("invalid data packet at index %d: %w", count+pending, err))
+		}
+
+		if tx == nil {
+			if tx, err = store.Begin(); err != nil {
+				return count, err
+			}
+		}
+		if err = tx.Put(data.Name(), wire); err != nil {
+			return count, rollback(tx, err)
+		}
+
+		if pending++; pending >= importBatchSize {
+			if err = tx.Commit(); err != nil {
+				return count, err
+			}
+			count, pending, tx = count+pending, 0, nil
+		}
+	}
+
+	if tx != nil {
+		if err = tx.Commit(); err != nil {
+			return count, err
+		}
+		count += pending
+	}
+	return count, nil
+}
+
+// rollback aborts a transaction if one is open, and returns err.
+func rollback(tx ndn.Store, err error) error {
+	if tx != nil {
+		tx.Rollback()
+	}
+	return err
+}
+
+// readTlvPacket reads a single TLV element from the stream.
+// Returns io.EOF if the stream ends cleanly before the element.
+func readTlvPacket(br *bufio.Reader) ([]byte, error) {
+	head := make([]byte, 0, 18)
+
+	typ, err := readTlNum(br, &head)
+	if err != nil {
+		return nil, err
+	}
+	if typ != tlvTypeData {
+		return nil, ndn.ErrInvalidValue{Item: "TLV type", Value: typ}
+	}
+
+	length, err := readTlNum(br, &head)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+	if length > ndn.MaxNDNPacketSize {
+		return nil, ndn.ErrInvalidValue{Item: "TLV length", Value: length}
+	}
+
+	wire := make([]byte, len(head)+int(length))
+	copy(wire, head)
+	if _, err := io.ReadFull(br, wire[len(head):]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return wire, nil
+}
+
+// readTlNum reads a TL number from the stream, appending the raw bytes to head.
+func readTlNum(br *bufio.Reader, head *[]byte) (enc.TLNum, error) {
+	first, err := br.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	*head = append(*head, first)
+
+	size := 0
+	switch first {
+	case 0xfd:
+		size = 2
+	case 0xfe:
+		size = 4
+	case 0xff:
+		size = 8
+	default:
+		return enc.TLNum(first), nil
+	}
+
+	start := len(*head) - 1
+	*head = append(*head, make([]byte, size)...)
+	if _, err := io.ReadFull(br, (*head)[start+1:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	val, _ := enc.ParseTLNum((*head)[start:])
+	return val, nil
+}
@@ -0,0 +1,313 @@
+package storage
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/priority_queue"
+)
+
+// EvictionOrder is the order in which Data is evicted when a quota is exceeded.
+type EvictionOrder int
+
+const (
+	// EvictLru evicts the least recently used (inserted or read) Data first.
+	EvictLru EvictionOrder = iota
+	// EvictFifo evicts the oldest inserted Data first.
+	EvictFifo
+)
+
+// default interval of the background sweeper
+const defaultSweepInterval = 10 * time.Second
+
+// TtlRule sets the lifetime of Data under a name prefix.
+type TtlRule struct {
+	// Prefix of the Data this rule applies to.
+	Prefix enc.Name
+	// TTL is the lifetime after insertion.
+	TTL time.Duration
+	// UseFreshness uses the FreshnessPeriod of the Data as the lifetime
+	// if present, falling back to TTL otherwise.
+	UseFreshness bool
+}
+
+// StorePolicy configures garbage collection for a store.
+// The zero value disables all limits.
+type StorePolicy struct {
+	// MaxBytes is the maximum total size of Data wires (0 = unlimited).
+	MaxBytes int64
+	// MaxObjects is the maximum number of Data wires (0 = unlimited).
+	MaxObjects int
+	// TTL rules, the longest matching prefix applies.
+	TTL []TtlRule
+	// Eviction order when a quota is exceeded.
+	Eviction EvictionOrder
+	// SweepInterval is the interval of the background sweeper (default 10s).
+	SweepInterval time.Duration
+}
+
+// IsSet returns true if the policy has any limits.
+func (p StorePolicy) IsSet() bool {
+	return p.MaxBytes > 0 || p.MaxObjects > 0 || len(p.TTL) > 0
+}
+
+// storePolicy tracks Data wires in a store to enforce a StorePolicy.
+// Entries are keyed by the TLV encoding of the name.
+type storePolicy struct {
+	policy StorePolicy
+	mutex  sync.Mutex
+
+	// all tracked entries
+	entries map[string]*policyEntry
+	// eviction order (front is evicted first)
+	order *list.List
+	// expiry queue (lazy deletion)
+	expiry priority_queue.Queue[policyExpiry, int64]
+
+	// statistics
+	bytes     int64
+	evicted   uint64
+	expired   uint64
+	lastSweep time.Time
+
+	// stop the background sweeper
+	stop chan struct{}
+}
+
+type policyEntry struct {
+	key     string
+	size    int64
+	expires int64 // unix nanos, 0 = never
+	elem    *list.Element
+}
+
+type policyExpiry struct {
+	key     string
+	expires int64
+}
+
+// newStorePolicy creates a new policy tracker.
+func newStorePolicy(policy StorePolicy) *storePolicy {
+	if policy.SweepInterval <= 0 {
+		policy.SweepInterval = defaultSweepInterval
+	}
+	return &storePolicy{
+		policy:  policy,
+		entries: make(map[string]*policyEntry),
+		order:   list.New(),
+		expiry:  priority_queue.New[policyExpiry, int64](),
+	}
+}
+
+// start runs the background sweeper, calling remove for each key to delete.
+func (p *storePolicy) start(remove func(keys []string)) {
+	stop := make(chan struct{})
+	p.stop = stop
+	go func() {
+		ticker := time.NewTicker(p.policy.SweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if keys := p.sweep(time.Now()); len(keys) > 0 {
+					remove(keys)
+				}
+			}
+		}
+	}()
+}
+
+// close stops the background sweeper.
+func (p *storePolicy) close() {
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+}
+
+// put records the insertion of a Data wire.
+func (p *storePolicy) put(key string, wire []byte, now time.Time) {
+	expires := p.expiresAt(key, wire, now)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if e := p.entries[key]; e != nil {
+		p.bytes -= e.size
+		e.size = int64(len(wire))
+		e.expires = expires
+		p.order.MoveToBack(e.elem)
+	} else {
+		e = &policyEntry{key: key, size: int64(len(wire)), expires: expires}
+		e.elem = p.order.PushBack(e)
+		p.entries[key] = e
+	}
+	p.bytes += int64(len(wire))
+
+	if expires != 0 {
+		p.expiry.Push(policyExpiry{key: key, expires: expires}, expires)
+	}
+}
+
+// get records a read of a Data wire.
+func (p *storePolicy) get(key string) {
+	if p.policy.Eviction != EvictLru {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if e := p.entries[key]; e != nil {
+		p.order.MoveToBack(e.elem)
+	}
+}
+
+// remove records the removal of a Data wire.
+func (p *storePolicy) remove(key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.drop(key)
+}
+
+// removePrefix records the removal of all Data wires under a prefix.
+func (p *storePolicy) removePrefix(prefix string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for key := range p.entries {
+		if strings.HasPrefix(key, prefix) {
+			p.drop(key)
+		}
+	}
+}
+
+// removeFlatRange records the removal of all subtrees under a range of flat prefixes.
+func (p *storePolicy) removeFlatRange(prefix string, first string, last string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for key := range p.entries {
+		if !strings.HasPrefix(key, prefix) || len(key) == len(prefix) {
+			continue
+		}
+
+		comp, err := enc.ComponentFromTlvStr(key[len(prefix):])
+		if err != nil {
+			continue
+		}
+		if cstr := comp.TlvStr(); cstr >= first && cstr <= last {
+			p.drop(key)
+		}
+	}
+}
+
+// drop removes an entry from the tracker (requires lock).
+func (p *storePolicy) drop(key string) {
+	if e := p.entries[key]; e != nil {
+		p.order.Remove(e.elem)
+		p.bytes -= e.size
+		delete(p.entries, key)
+	}
+}
+
+// sweep removes expired entries and evicts entries over quota.
+// Returns the list of keys that must be deleted from the store.
+func (p *storePolicy) sweep(now time.Time) []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	keys := make([]string, 0)
+	nowNs := now.UnixNano()
+
+	// remove expired entries
+	for p.expiry.Len() > 0 && p.expiry.PeekPriority() <= nowNs {
+		exp := p.expiry.Pop()
+		if e := p.entries[exp.key]; e != nil && e.expires == exp.expires {
+			keys = append(keys, exp.key)
+			p.drop(exp.key)
+			p.expired++
+		}
+	}
+
+	// evict entries over quota
+	for p.order.Len() > 0 && p.overQuota() {
+		e := p.order.Front().Value.(*policyEntry)
+		keys = append(keys, e.key)
+		p.drop(e.key)
+		p.evicted++
+	}
+
+	p.lastSweep = now
+	return keys
+}
+
+// untracked filters out keys selected by sweep that are tracked again.
+// Such keys were inserted again before the store removed them, and must
+// be kept. The store must hold its write lock while calling this.
+func (p *storePolicy) untracked(keys []string) []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return slices.DeleteFunc(keys, func(key string) bool {
+		return p.entries[key] != nil
+	})
+}
+
+// overQuota checks if the tracked entries exceed a quota (requires lock).
+func (p *storePolicy) overQuota() bool {
+	return (p.policy.MaxBytes > 0 && p.bytes > p.policy.MaxBytes) ||
+		(p.policy.MaxObjects > 0 && len(p.entries) > p.policy.MaxObjects)
+}
+
+// stats returns the current statistics.
+func (p *storePolicy) stats() ndn.StoreStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return ndn.StoreStats{
+		Objects:   len(p.entries),
+		Bytes:     p.bytes,
+		Evicted:   p.evicted,
+		Expired:   p.expired,
+		LastSweep: p.lastSweep,
+	}
+}
+
+// expiresAt computes the expiry time of a Data wire using the longest matching TTL rule.
+func (p *storePolicy) expiresAt(key string, wire []byte, now time.Time) int64 {
+	var rule *TtlRule
+	ruleLen := -1
+	for i := range p.policy.TTL {
+		pfx := p.policy.TTL[i].Prefix.TlvStr()
+		if strings.HasPrefix(key, pfx) && len(pfx) > ruleLen {
+			rule, ruleLen = &p.policy.TTL[i], len(pfx)
+		}
+	}
+	if rule == nil {
+		return 0
+	}
+
+	ttl := rule.TTL
+	if rule.UseFreshness {
+		if data, _, err := (spec.Spec{}).ReadData(enc.NewBufferView(wire)); err == nil {
+			if fresh, ok := data.Freshness().Get(); ok {
+				ttl = fresh
+			}
+		}
+	}
+	if ttl <= 0 {
+		return 0
+	}
+
+	return now.Add(ttl).UnixNano()
+}
@@ -0,0 +1,66 @@
+//go:build !js
+
+package storage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests that a name inserted again between selection and deletion by the
+// sweeper stays in the store and remains tracked by the policy.
+func testPolicySweepReput(t *testing.T, store ndn.Store, policy *storePolicy, removeKeys func([]string)) {
+	name1, _ := enc.NameFromStr("/ndn/edu/ucla/reput/1")
+	name2, _ := enc.NameFromStr("/ndn/edu/ucla/reput/2")
+	wire := []byte{0x06, 0x00}
+
+	require.NoError(t, store.Put(name1, wire))
+	require.NoError(t, store.Put(name2, wire))
+
+	// name1 is selected for eviction, then inserted again
+	keys := policy.sweep(time.Now())
+	require.Len(t, keys, 1)
+	require.NoError(t, store.Put(name1, wire))
+	removeKeys(keys)
+
+	require.Equal(t, wire, tu.NoErr(store.Get(name1, false)))
+	require.Equal(t, 2, policy.stats().Objects)
+
+	// names that were not inserted again are removed
+	keys = policy.sweep(time.Now())
+	require.Len(t, keys, 1)
+	removeKeys(keys)
+	require.Nil(t, tu.NoErr(store.Get(name2, false)))
+	require.Equal(t, 1, policy.stats().Objects)
+}
+
+func TestMemoryPolicySweepReput(t *testing.T) {
+	tu.SetT(t)
+
+	store := NewMemoryStoreWithPolicy(StorePolicy{
+		MaxObjects:    1,
+		SweepInterval: time.Hour,
+	})
+	defer store.Close()
+	testPolicySweepReput(t, store, store.policy, store.removeKeys)
+}
+
+func TestBadgerPolicySweepReput(t *testing.T) {
+	tu.SetT(t)
+	dir := "badger-test-reput"
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	store := tu.NoErr(NewBadgerStoreWithPolicy(dir, StorePolicy{
+		MaxObjects:    1,
+		SweepInterval: time.Hour,
+	}))
+	defer store.Close()
+	testPolicySweepReput(t, store, store.policy, store.removeKeys)
+}
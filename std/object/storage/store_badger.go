@@ -6,6 +6,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"iter"
+	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v4"
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -16,6 +19,13 @@ import (
 type BadgerStore struct {
 	db *badger.DB
 	tx *badger.Txn
+
+	// garbage collection policy (optional)
+	policy *storePolicy
+	// serializes sweeper deletions with puts and their policy updates
+	gcMutex *sync.RWMutex
+	// policy updates of the current transaction, applied in order on commit
+	txOps []func(now time.Time)
 }
 
 // Constructs a new BadgerStore using the specified path for the BadgerDB database.
@@ -28,11 +38,100 @@ func NewBadgerStore(path string) (*BadgerStore, error) {
 	return &BadgerStore{db: db}, nil
 }
 
+// NewBadgerStoreWithPolicy creates a BadgerStore with a background sweeper
+// enforcing the given garbage collection policy. Existing Data in the store
+// is treated as inserted when the store is opened.
+func NewBadgerStoreWithPolicy(path string, policy StorePolicy) (*BadgerStore, error) {
+	s, err := NewBadgerStore(path)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.IsSet() {
+		return s, nil
+	}
+
+	s.policy = newStorePolicy(policy)
+	s.gcMutex = &sync.RWMutex{}
+	now := time.Now()
+	err = s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			err := item.Value(func(val []byte) error {
+				s.policy.put(string(item.Key()), val, now)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.db.Close()
+		return nil, err
+	}
+
+	s.policy.start(s.removeKeys)
+	return s, nil
+}
+
 // Closes the underlying Badger database connection and returns any error encountered during closure.
 func (s *BadgerStore) Close() error {
+	if s.policy != nil {
+		s.policy.close()
+	}
 	return s.db.Close()
 }
 
+// Stats returns the usage statistics of the store.
+func (s *BadgerStore) Stats() ndn.StoreStats {
+	if s.policy != nil {
+		return s.policy.stats()
+	}
+
+	stats := ndn.StoreStats{}
+	s.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false // keys only
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			stats.Objects++
+			stats.Bytes += int64(it.Item().ValueSize())
+		}
+		return nil
+	})
+	return stats
+}
+
+// Sweep runs the garbage collection policy immediately.
+func (s *BadgerStore) Sweep() {
+	if s.policy != nil {
+		s.removeKeys(s.policy.sweep(time.Now()))
+	}
+}
+
+// removeKeys deletes keys from the database without updating the policy.
+// Keys that were inserted again since the sweep are kept.
+func (s *BadgerStore) removeKeys(keys []string) {
+	s.gcMutex.Lock()
+	defer s.gcMutex.Unlock()
+
+	wb := s.db.NewWriteBatch()
+	defer wb.Cancel()
+
+	for _, key := range s.policy.untracked(keys) {
+		if err := wb.Delete([]byte(key)); err != nil {
+			return
+		}
+	}
+	wb.Flush()
+}
+
 // Retrieves the most recent data value associated with the exact name or the longest prefix match from the BadgerDB store, returning the encoded content as a byte slice.
 func (s *BadgerStore) Get(name enc.Name, prefix bool) (wire []byte, err error) {
 	if s.tx != nil {
@@ -48,6 +147,9 @@ func (s *BadgerStore) Get(name enc.Name, prefix bool) (wire []byte, err error) {
 				return nil
 			}
 			wire, err = item.ValueCopy(nil)
+			if s.policy != nil && err == nil {
+				s.policy.get(string(key))
+			}
 			return err
 		}
 
@@ -64,33 +166,76 @@ func (s *BadgerStore) Get(name enc.Name, prefix bool) (wire []byte, err error) {
 
 		item := it.Item()
 		wire, err = item.ValueCopy(nil)
+		if s.policy != nil && err == nil {
+			s.policy.get(string(item.Key()))
+		}
 		return err
 	})
 
 	return
 }
 
+// Iter iterates over all Data wires under a prefix in name order.
+func (s *BadgerStore) Iter(prefix enc.Name) (iter.Seq2[enc.Name, []byte], func() error) {
+	var iterErr error
+	return func(yield func(enc.Name, []byte) bool) {
+		keyPfx := s.nameKey(prefix)
+		iterErr = s.db.View(func(txn *badger.Txn) error {
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer it.Close()
+
+			for it.Seek(keyPfx); it.ValidForPrefix(keyPfx); it.Next() {
+				item := it.Item()
+				name, err := enc.NameFromTlvStr(string(item.Key()))
+				if err != nil {
+					return err
+				}
+				wire, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				if !yield(name, wire) {
+					return nil
+				}
+			}
+			return nil
+		})
+	}, func() error { return iterErr }
+}
+
 // Stores the wire-encoded data associated with the given name in the Badger database using a transactional set operation.
 func (s *BadgerStore) Put(name enc.Name, wire []byte) error {
+	if s.policy != nil && s.tx == nil {
+		s.gcMutex.RLock()
+		defer s.gcMutex.RUnlock()
+	}
+
 	key := s.nameKey(name)
-	return s.update(func(txn *badger.Txn) error {
+	err := s.update(func(txn *badger.Txn) error {
 		return txn.Set(key, wire)
 	})
+	if s.policy != nil && err == nil {
+		s.track(func(now time.Time) { s.policy.put(string(key), wire, now) })
+	}
+	return err
 }
 
 // Removes the entry identified by the given name from the BadgerStore by deleting the corresponding key-value pair.
 func (s *BadgerStore) Remove(name enc.Name) error {
 	key := s.nameKey(name)
-	return s.update(func(txn *badger.Txn) error {
+	err := s.update(func(txn *badger.Txn) error {
 		return txn.Delete(key)
 	})
+	if s.policy != nil && err == nil {
+		s.track(func(time.Time) { s.policy.remove(string(key)) })
+	}
+	return err
 }
 
 // Deletes all entries in the BadgerStore that have names starting with the specified prefix.
 func (s *BadgerStore) RemovePrefix(prefix enc.Name) error {
 	keyPfx := s.nameKey(prefix)
-
-	return s.update(func(txn *badger.Txn) error {
+	err := s.update(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
 		opts.PrefetchValues = false // keys only
 		it := txn.NewIterator(opts)
@@ -105,6 +250,10 @@ func (s *BadgerStore) RemovePrefix(prefix enc.Name) error {
 
 		return nil
 	})
+	if s.policy != nil && err == nil {
+		s.track(func(time.Time) { s.policy.removePrefix(string(keyPfx)) })
+	}
+	return err
 }
 
 // Removes all entries in the Badger store whose keys are in the lexicographic range [prefix+first, prefix+last], inclusive.
@@ -115,7 +264,7 @@ func (s *BadgerStore) RemoveFlatRange(prefix enc.Name, first enc.Component, last
 		return fmt.Errorf("firstKey > lastKey")
 	}
 
-	return s.update(func(txn *badger.Txn) error {
+	err := s.update(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
 		opts.PrefetchValues = false // keys only
 		it := txn.NewIterator(opts)
@@ -138,6 +287,11 @@ func (s *BadgerStore) RemoveFlatRange(prefix enc.Name, first enc.Component, last
 			it.Next()
 		}
 	})
+	if s.policy != nil && err == nil {
+		prefixKey := string(s.nameKey(prefix))
+		s.track(func(time.Time) { s.policy.removeFlatRange(prefixKey, first.TlvStr(), last.TlvStr()) })
+	}
+	return err
 }
 
 // Starts a write transaction, returning a new BadgerStore instance bound to the transaction, and panics if called while already within a transaction.
@@ -146,7 +300,7 @@ func (s *BadgerStore) Begin() (ndn.Store, error) {
 		panic("Begin() called within a write transaction")
 	}
 	tx := s.db.NewTransaction(true)
-	return &BadgerStore{db: s.db, tx: tx}, nil
+	return &BadgerStore{db: s.db, tx: tx, policy: s.policy, gcMutex: s.gcMutex}, nil
 }
 
 // Commits the current write transaction, ensuring all pending changes are persisted to the BadgerStore.
@@ -154,7 +308,21 @@ func (s *BadgerStore) Commit() error {
 	if s.tx == nil {
 		panic("Commit() called without a write transaction")
 	}
-	return s.tx.Commit()
+	if s.policy != nil {
+		s.gcMutex.RLock()
+		defer s.gcMutex.RUnlock()
+	}
+	if err := s.tx.Commit(); err != nil {
+		return err
+	}
+	if s.policy != nil {
+		now := time.Now()
+		for _, op := range s.txOps {
+			op(now)
+		}
+		s.txOps = nil
+	}
+	return nil
 }
 
 // Aborts the current write transaction, discarding all uncommitted changes.
@@ -163,9 +331,19 @@ func (s *BadgerStore) Rollback() error {
 		panic("Rollback() called without a write transaction")
 	}
 	s.tx.Discard()
+	s.txOps = nil
 	return nil
 }
 
+// Applies a policy update now, or on commit within a write transaction.
+func (s *BadgerStore) track(op func(now time.Time)) {
+	if s.tx != nil {
+		s.txOps = append(s.txOps, op)
+	} else {
+		op(time.Now())
+	}
+}
+
 // Converts the provided `enc.Name` to its internal byte representation for use as a key in BadgerStore.
 func (s *BadgerStore) nameKey(name enc.Name) []byte {
 	return name.BytesInner()
@@ -5,6 +5,9 @@ package storage_test
 import (
 	"os"
 	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
 
 	"github.com/named-data/ndnd/std/object/storage"
 	tu "github.com/named-data/ndnd/std/utils/testutils"
@@ -23,5 +26,70 @@ func TestBadgerStore(t *testing.T) {
 	testStoreBasic(t, store)
 	testStoreRemoveRange(t, store)
 	testStoreTxn(t, store)
+	testStoreIter(t, store)
+	testStoreExport(t, storage.NewMemoryStore(), store)
 	require.NoError(t, store.Close())
 }
+
+// Tests the garbage collection policies of the BadgerStore.
+func TestBadgerStorePolicy(t *testing.T) {
+	tu.SetT(t)
+	dir := "badger-test-policy"
+	os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	store, err := storage.NewBadgerStoreWithPolicy(dir, storage.StorePolicy{
+		MaxObjects: 3,
+		Eviction:   storage.EvictLru,
+	})
+	require.NoError(t, err)
+	testStorePolicyLru(t, store)
+	testStorePolicyTxn(t, store)
+	require.NoError(t, store.Close())
+	os.RemoveAll(dir)
+
+	ttlPfx, _ := enc.NameFromStr("/ndn/edu/ucla/ttl")
+	store, err = storage.NewBadgerStoreWithPolicy(dir, storage.StorePolicy{
+		TTL: []storage.TtlRule{{Prefix: ttlPfx, TTL: 50 * time.Millisecond}},
+	})
+	require.NoError(t, err)
+	testStorePolicyTtl(t, store)
+	require.NoError(t, store.Close())
+}
+
+// Tests that removals within a transaction update the policy only on commit.
+// Run after testStorePolicyLru, which leaves the store empty.
+func testStorePolicyTxn(t *testing.T, store policyStore) {
+	name, _ := enc.NameFromStr("/ndn/edu/ucla/gc/tx")
+	wire := []byte{0x01, 0x02, 0x03}
+	require.NoError(t, store.Put(name, wire))
+	require.Equal(t, 1, store.Stats().Objects)
+
+	// removal is forgotten on rollback
+	tx, err := store.Begin()
+	require.NoError(t, err)
+	require.NoError(t, tx.Remove(name))
+	require.NoError(t, tx.RemovePrefix(name.Prefix(-1)))
+	require.Equal(t, 1, store.Stats().Objects)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 1, store.Stats().Objects)
+	require.Equal(t, int64(3), store.Stats().Bytes)
+
+	// removal is applied on commit, in order with puts
+	tx, err = store.Begin()
+	require.NoError(t, err)
+	require.NoError(t, tx.Remove(name))
+	require.NoError(t, tx.Put(name, wire[:2]))
+	require.Equal(t, int64(3), store.Stats().Bytes)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 1, store.Stats().Objects)
+	require.Equal(t, int64(2), store.Stats().Bytes)
+
+	tx, err = store.Begin()
+	require.NoError(t, err)
+	require.NoError(t, tx.RemovePrefix(name.Prefix(-1)))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 0, store.Stats().Objects)
+	data, _ := store.Get(name, false)
+	require.Equal(t, []byte(nil), data)
+}
@@ -5,6 +5,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"iter"
 	"syscall/js"
 
 	"unsafe"
@@ -95,6 +96,35 @@ func (s *JsStore) Get(name enc.Name, prefix bool) ([]byte, error) {
 	return nil, nil
 }
 
+// Iter iterates over all Data wires under a prefix in name order.
+// The JS API must implement iter(prefix) returning a promise of [name, wire][].
+func (s *JsStore) Iter(prefix enc.Name) (iter.Seq2[enc.Name, []byte], func() error) {
+	var iterErr error
+	return func(yield func(enc.Name, []byte) bool) {
+		prefix_js := jsutil.SliceToJsArray(prefix.BytesInner())
+
+		// [Uint8Array, Uint8Array][]
+		list, err := jsutil.Await(s.api.Call("iter", prefix_js))
+		if err != nil {
+			iterErr = err
+			return
+		}
+
+		for i := range list.Get("length").Int() {
+			item := list.Index(i)
+			nameBytes := jsutil.JsArrayToSlice(item.Index(0))
+			name, err := enc.NameFromTlvStr(string(nameBytes))
+			if err != nil {
+				iterErr = err
+				return
+			}
+			if !yield(name, jsutil.JsArrayToSlice(item.Index(1))) {
+				return
+			}
+		}
+	}, func() error { return iterErr }
+}
+
 // Stores data with the given name in a JavaScript-managed storage system, converting inputs to JavaScript-compatible structures, handling asynchronous operations with transaction support, and caching the entry for future retrieval.
 func (s *JsStore) Put(name enc.Name, wire []byte) error {
 	tlvBytes := name.BytesInner()
@@ -2,7 +2,11 @@ package storage
 
 import (
 	"fmt"
+	"iter"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/ndn"
@@ -18,6 +22,9 @@ type MemoryStore struct {
 	tx *memoryStoreNode
 	// transaction mutex
 	txMutex sync.Mutex
+
+	// garbage collection policy (optional)
+	policy *storePolicy
 }
 
 type memoryStoreNode struct {
@@ -36,20 +43,82 @@ func NewMemoryStore() *MemoryStore {
 	}
 }
 
+// NewMemoryStoreWithPolicy creates an in-memory store with a background
+// sweeper enforcing the given garbage collection policy.
+// The store must be closed to stop the sweeper.
+func NewMemoryStoreWithPolicy(policy StorePolicy) *MemoryStore {
+	s := NewMemoryStore()
+	if policy.IsSet() {
+		s.policy = newStorePolicy(policy)
+		s.policy.start(s.removeKeys)
+	}
+	return s
+}
+
+// Close stops the background sweeper, if any.
+func (s *MemoryStore) Close() error {
+	if s.policy != nil {
+		s.policy.close()
+	}
+	return nil
+}
+
 // Retrieves the data associated with the given name from the memory store, returning the newest matching entry if the direct entry is empty and prefix mode is enabled.
 func (s *MemoryStore) Get(name enc.Name, prefix bool) ([]byte, error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
 	if node := s.root.find(name); node != nil {
+		suffix := ""
 		if node.wire == nil && prefix {
-			node = node.findNewest()
+			node, suffix = node.findNewest()
+		}
+		if s.policy != nil && node.wire != nil {
+			s.policy.get(name.TlvStr() + suffix)
 		}
 		return node.wire, nil
 	}
 	return nil, nil
 }
 
+// Iter iterates over all Data wires under a prefix in name order.
+func (s *MemoryStore) Iter(prefix enc.Name) (iter.Seq2[enc.Name, []byte], func() error) {
+	var iterErr error
+	return func(yield func(enc.Name, []byte) bool) {
+		// collect entries first so the lock is not held while yielding
+		type entry struct {
+			key  string
+			wire []byte
+		}
+		entries := make([]entry, 0)
+
+		s.mutex.RLock()
+		if node := s.root.find(prefix); node != nil {
+			node.walkKeys(prefix.TlvStr(), func(key string, n *memoryStoreNode) {
+				if n.wire != nil {
+					entries = append(entries, entry{key, n.wire})
+				}
+			})
+		}
+		s.mutex.RUnlock()
+
+		slices.SortFunc(entries, func(a, b entry) int {
+			return strings.Compare(a.key, b.key)
+		})
+
+		for _, e := range entries {
+			name, err := enc.NameFromTlvStr(e.key)
+			if err != nil {
+				iterErr = err
+				return
+			}
+			if !yield(name, e.wire) {
+				return
+			}
+		}
+	}, func() error { return iterErr }
+}
+
 // Stores the provided wire-encoded data under the specified name in the MemoryStore, using an active transaction context if one exists.  
 
 *Example usage context:*  
@@ -64,6 +133,9 @@ func (s *MemoryStore) Put(name enc.Name, wire []byte) error {
 	}
 
 	root.insert(name, wire)
+	if s.policy != nil && s.tx == nil {
+		s.policy.put(name.TlvStr(), wire, time.Now())
+	}
 	return nil
 }
 
@@ -73,6 +145,9 @@ func (s *MemoryStore) Remove(name enc.Name) error {
 	defer s.mutex.Unlock()
 
 	s.root.remove(name, false)
+	if s.policy != nil {
+		s.policy.remove(name.TlvStr())
+	}
 	return nil
 }
 
@@ -82,6 +157,9 @@ func (s *MemoryStore) RemovePrefix(prefix enc.Name) error {
 	defer s.mutex.Unlock()
 
 	s.root.remove(prefix, true)
+	if s.policy != nil {
+		s.policy.removePrefix(prefix.TlvStr())
+	}
 	return nil
 }
 
@@ -102,6 +180,10 @@ func (s *MemoryStore) RemoveFlatRange(prefix enc.Name, first enc.Component, last
 		}
 	}
 
+	if s.policy != nil {
+		s.policy.removeFlatRange(prefix.TlvStr(), firstKey, lastKey)
+	}
+
 	return nil
 }
 
@@ -118,6 +200,14 @@ func (s *MemoryStore) Commit() error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	s.root.merge(s.tx)
+	if s.policy != nil {
+		now := time.Now()
+		s.tx.walkKeys("", func(key string, n *memoryStoreNode) {
+			if n.wire != nil {
+				s.policy.put(key, n.wire, now)
+			}
+		})
+	}
 	s.tx = nil
 	return nil
 }
@@ -138,6 +228,44 @@ func (s *MemoryStore) MemSize() int {
 	return size
 }
 
+// Stats returns the usage statistics of the store.
+func (s *MemoryStore) Stats() ndn.StoreStats {
+	if s.policy != nil {
+		return s.policy.stats()
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	stats := ndn.StoreStats{}
+	s.root.walk(func(n *memoryStoreNode) {
+		if n.wire != nil {
+			stats.Objects++
+			stats.Bytes += int64(len(n.wire))
+		}
+	})
+	return stats
+}
+
+// Sweep runs the garbage collection policy immediately.
+func (s *MemoryStore) Sweep() {
+	if s.policy != nil {
+		s.removeKeys(s.policy.sweep(time.Now()))
+	}
+}
+
+// removeKeys removes Data wires by TLV-encoded name without updating the policy.
+// Keys that were inserted again since the sweep are kept.
+func (s *MemoryStore) removeKeys(keys []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, key := range s.policy.untracked(keys) {
+		if name, err := enc.NameFromTlvStr(key); err == nil {
+			s.root.remove(name, false)
+		}
+	}
+}
+
 // Returns the deepest node matching the given name by recursively traversing the trie-like structure of name components.
 func (n *memoryStoreNode) find(name enc.Name) *memoryStoreNode {
 	if len(name) == 0 {
@@ -157,9 +285,10 @@ func (n *memoryStoreNode) find(name enc.Name) *memoryStoreNode {
 }
 
 // Returns the newest node in the subtree rooted at `n` by recursively selecting the lexicographically greatest named child until a leaf node is reached.
-func (n *memoryStoreNode) findNewest() *memoryStoreNode {
+// The TLV encoding of the name suffix from n to the returned node is also returned.
+func (n *memoryStoreNode) findNewest() (*memoryStoreNode, string) {
 	if len(n.children) == 0 {
-		return n
+		return n, ""
 	}
 
 	var newest string = ""
@@ -169,14 +298,14 @@ func (n *memoryStoreNode) findNewest() *memoryStoreNode {
 		}
 	}
 	if newest == "" {
-		return nil
+		return nil, ""
 	}
 
 	known := n.children[newest]
-	if sub := known.findNewest(); sub != nil {
-		return sub
+	if sub, suffix := known.findNewest(); sub != nil {
+		return sub, newest + suffix
 	}
-	return known
+	return known, newest
 }
 
 // Inserts the provided wire-encoded data into a memory-based trie structure, creating or traversing nodes hierarchically according to the components of the given name.
@@ -252,3 +381,11 @@ func (n *memoryStoreNode) walk(f func(*memoryStoreNode)) {
 		child.walk(f)
 	}
 }
+
+// walkKeys performs a depth-first traversal like walk, also passing the TLV-encoded name of each node.
+func (n *memoryStoreNode) walkKeys(key string, f func(string, *memoryStoreNode)) {
+	f(key, n)
+	for ckey, child := range n.children {
+		child.walkKeys(key+ckey, f)
+	}
+}
@@ -0,0 +1,206 @@
+//go:build !js
+
+package storage
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"iter"
+
+	_ "github.com/mattn/go-sqlite3"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+)
+
+// Store implementation using a single SQLite file.
+// Keys are the TLV encoding of the name, so rows are name-ordered.
+type SqliteStore struct {
+	db *sql.DB
+	tx *sql.Tx
+}
+
+// sqliteQueryer is implemented by both sql.DB and sql.Tx
+type sqliteQueryer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// NewSqliteStore opens (or creates) a SQLite store at the given file path.
+func NewSqliteStore(path string) (*SqliteStore, error) {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS store (
+		name BLOB PRIMARY KEY,
+		wire BLOB NOT NULL
+	) WITHOUT ROWID`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SqliteStore{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *SqliteStore) Close() error {
+	return s.db.Close()
+}
+
+// Get returns the Data wire with the exact name, or the lexicographically
+// last Data wire under the name if prefix is set.
+func (s *SqliteStore) Get(name enc.Name, prefix bool) (wire []byte, err error) {
+	key := name.BytesInner()
+
+	var row *sql.Row
+	if !prefix {
+		row = s.q().QueryRow("SELECT wire FROM store WHERE name=?", key)
+	} else if end := sqliteKeySuccessor(key); end != nil {
+		row = s.q().QueryRow("SELECT wire FROM store WHERE name>=? AND name<? ORDER BY name DESC LIMIT 1", key, end)
+	} else {
+		row = s.q().QueryRow("SELECT wire FROM store WHERE name>=? ORDER BY name DESC LIMIT 1", key)
+	}
+
+	err = row.Scan(&wire)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return wire, err
+}
+
+// Iter iterates over all Data wires under a prefix in name order.
+func (s *SqliteStore) Iter(prefix enc.Name) (iter.Seq2[enc.Name, []byte], func() error) {
+	var iterErr error
+	return func(yield func(enc.Name, []byte) bool) {
+		key := prefix.BytesInner()
+
+		var rows *sql.Rows
+		if end := sqliteKeySuccessor(key); end != nil {
+			rows, iterErr = s.q().Query("SELECT name, wire FROM store WHERE name>=? AND name<? ORDER BY name", key, end)
+		} else {
+			rows, iterErr = s.q().Query("SELECT name, wire FROM store WHERE name>=? ORDER BY name", key)
+		}
+		if iterErr != nil {
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var nameKey, wire []byte
+			if iterErr = rows.Scan(&nameKey, &wire); iterErr != nil {
+				return
+			}
+			name, err := enc.NameFromTlvStr(string(nameKey))
+			if err != nil {
+				iterErr = err
+				return
+			}
+			if !yield(name, wire) {
+				return
+			}
+		}
+		iterErr = rows.Err()
+	}, func() error { return iterErr }
+}
+
+// Put inserts or replaces a Data wire.
+func (s *SqliteStore) Put(name enc.Name, wire []byte) error {
+	_, err := s.q().Exec("INSERT OR REPLACE INTO store (name, wire) VALUES (?, ?)", name.BytesInner(), wire)
+	return err
+}
+
+// Remove removes the Data wire with the exact name.
+func (s *SqliteStore) Remove(name enc.Name) error {
+	_, err := s.q().Exec("DELETE FROM store WHERE name=?", name.BytesInner())
+	return err
+}
+
+// RemovePrefix removes all Data wires under a prefix.
+func (s *SqliteStore) RemovePrefix(prefix enc.Name) error {
+	key := prefix.BytesInner()
+	return s.removeRange(key, sqliteKeySuccessor(key))
+}
+
+// RemoveFlatRange removes all subtrees under a range of flat prefixes (inclusive).
+func (s *SqliteStore) RemoveFlatRange(prefix enc.Name, first enc.Component, last enc.Component) error {
+	firstKey := prefix.Append(first).BytesInner()
+	lastKey := prefix.Append(last).BytesInner()
+	if bytes.Compare(firstKey, lastKey) > 0 {
+		return fmt.Errorf("firstKey > lastKey")
+	}
+
+	return s.removeRange(firstKey, sqliteKeySuccessor(lastKey))
+}
+
+// Begin starts a write transaction.
+func (s *SqliteStore) Begin() (ndn.Store, error) {
+	if s.tx != nil {
+		panic("Begin() called within a write transaction")
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &SqliteStore{db: s.db, tx: tx}, nil
+}
+
+// Commit commits the write transaction.
+func (s *SqliteStore) Commit() error {
+	if s.tx == nil {
+		panic("Commit() called without a write transaction")
+	}
+	return s.tx.Commit()
+}
+
+// Rollback discards the write transaction.
+func (s *SqliteStore) Rollback() error {
+	if s.tx == nil {
+		panic("Rollback() called without a write transaction")
+	}
+	return s.tx.Rollback()
+}
+
+// Stats returns the usage statistics of the store.
+func (s *SqliteStore) Stats() ndn.StoreStats {
+	stats := ndn.StoreStats{}
+	s.q().QueryRow("SELECT COUNT(*), COALESCE(SUM(LENGTH(wire)), 0) FROM store").
+		Scan(&stats.Objects, &stats.Bytes)
+	return stats
+}
+
+// removeRange deletes all keys in [start, end), or [start, ...) if end is nil.
+func (s *SqliteStore) removeRange(start []byte, end []byte) error {
+	var err error
+	if end != nil {
+		_, err = s.q().Exec("DELETE FROM store WHERE name>=? AND name<?", start, end)
+	} else {
+		_, err = s.q().Exec("DELETE FROM store WHERE name>=?", start)
+	}
+	return err
+}
+
+// q returns the active transaction or the database.
+func (s *SqliteStore) q() sqliteQueryer {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.db
+}
+
+// sqliteKeySuccessor returns the smallest key that is greater than all keys
+// with the given prefix, or nil if there is no such key.
+func sqliteKeySuccessor(key []byte) []byte {
+	end := bytes.Clone(key)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
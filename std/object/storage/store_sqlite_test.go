@@ -0,0 +1,34 @@
+//go:build !js
+
+package storage_test
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/object/storage"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests the SqliteStore implementation with the shared store tests on a temporary database file.
+func TestSqliteStore(t *testing.T) {
+	tu.SetT(t)
+
+	store, err := storage.NewSqliteStore(filepath.Join(t.TempDir(), "store.db"))
+	require.NoError(t, err)
+	testStoreBasic(t, store)
+	testStoreRemoveRange(t, store)
+	testStoreTxn(t, store)
+	testStoreIter(t, store)
+
+	stats := store.Stats()
+	require.Equal(t, 5, stats.Objects)
+	require.NoError(t, store.Close())
+
+	// a failed read does not produce a partial export
+	_, err = storage.ExportStore(store, enc.Name{}, io.Discard)
+	require.Error(t, err)
+}
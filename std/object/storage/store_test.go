@@ -1,11 +1,15 @@
 package storage_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 	"github.com/named-data/ndnd/std/object/storage"
+	sig "github.com/named-data/ndnd/std/security/signer"
 	tu "github.com/named-data/ndnd/std/utils/testutils"
 	"github.com/stretchr/testify/require"
 )
@@ -272,6 +276,167 @@ func testStoreTxn(t *testing.T, store ndn.Store) {
 	require.Equal(t, wire3, data)
 }
 
+// Tests iteration over the store in name order, run after testStoreTxn.
+func testStoreIter(t *testing.T, store ndn.Store) {
+	prefix, _ := enc.NameFromStr("/ndn/edu/memphis/test/packet")
+
+	names := make([]string, 0)
+	wires := make([][]byte, 0)
+	entries, iterErr := store.Iter(prefix)
+	for name, wire := range entries {
+		names = append(names, name.String())
+		wires = append(wires, wire)
+	}
+	require.NoError(t, iterErr())
+	require.Equal(t, []string{
+		"/ndn/edu/memphis/test/packet/v1",
+		"/ndn/edu/memphis/test/packet/v5",
+		"/ndn/edu/memphis/test/packet/v9",
+	}, names)
+	require.Equal(t, []byte{0x04, 0x05, 0x06}, wires[1])
+
+	// early break
+	count := 0
+	entries, iterErr = store.Iter(prefix)
+	for range entries {
+		count++
+		break
+	}
+	require.Equal(t, 1, count)
+	require.NoError(t, iterErr())
+
+	// empty prefix
+	empty, _ := enc.NameFromStr("/ndn/edu/none")
+	entries, iterErr = store.Iter(empty)
+	for range entries {
+		require.Fail(t, "unexpected entry")
+	}
+	require.NoError(t, iterErr())
+}
+
+// Tests exporting a store to a TLV stream and importing it into another store.
+func testStoreExport(t *testing.T, src ndn.Store, dst ndn.Store) {
+	prefix, _ := enc.NameFromStr("/ndn/edu/ucla/export")
+	for i := range 3 {
+		name := prefix.Append(enc.NewVersionComponent(uint64(i)))
+		data, err := spec.Spec{}.MakeData(name, &ndn.DataConfig{}, enc.Wire{[]byte("hello")}, sig.NewSha256Signer())
+		require.NoError(t, err)
+		require.NoError(t, src.Put(name, data.Wire.Join()))
+	}
+
+	buf := &bytes.Buffer{}
+	count, err := storage.ExportStore(src, prefix, buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	count, err = storage.ImportStore(dst, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	entries, iterErr := src.Iter(prefix)
+	for name, wire := range entries {
+		data, err := dst.Get(name, false)
+		require.NoError(t, err)
+		require.Equal(t, wire, data)
+	}
+	require.NoError(t, iterErr())
+
+	// truncated stream
+	_, err = storage.ImportStore(dst, bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	require.Error(t, err)
+
+	// oversized length is rejected before reading the value
+	_, err = storage.ImportStore(dst, bytes.NewReader([]byte{0x06, 0xfe, 0x7f, 0xff, 0xff, 0xff}))
+	require.Error(t, err)
+
+	// large streams are committed in batches
+	large := &bytes.Buffer{}
+	for i := range 3000 {
+		name := prefix.Append(enc.NewGenericComponent("large"), enc.NewSequenceNumComponent(uint64(i)))
+		data, err := spec.Spec{}.MakeData(name, &ndn.DataConfig{}, enc.Wire{make([]byte, 4096)}, sig.NewSha256Signer())
+		require.NoError(t, err)
+		large.Write(data.Wire.Join())
+	}
+	count, err = storage.ImportStore(dst, bytes.NewReader(large.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, 3000, count)
+
+	// packets before an invalid batch remain committed
+	corrupt := append(bytes.Clone(large.Bytes()[:large.Len()/2]), 0x05, 0x00)
+	count, err = storage.ImportStore(dst, bytes.NewReader(corrupt))
+	require.Error(t, err)
+	require.Equal(t, 1024, count)
+}
+
+// policyStore is a store with a garbage collection policy.
+type policyStore interface {
+	ndn.Store
+	ndn.StoreInspector
+	Sweep()
+}
+
+// Tests quota eviction of a store created with MaxObjects=3 and LRU eviction.
+func testStorePolicyLru(t *testing.T, store policyStore) {
+	prefix, _ := enc.NameFromStr("/ndn/edu/ucla/gc")
+	names := make([]enc.Name, 4)
+	for i := range names {
+		names[i] = prefix.Append(enc.NewSequenceNumComponent(uint64(i)))
+	}
+	wire := []byte{0x01, 0x02, 0x03, 0x04}
+
+	require.NoError(t, store.Put(names[0], wire))
+	require.NoError(t, store.Put(names[1], wire))
+	require.NoError(t, store.Put(names[2], wire))
+	require.NoError(t, store.Put(names[3], wire))
+
+	stats := store.Stats()
+	require.Equal(t, 4, stats.Objects)
+	require.Equal(t, int64(16), stats.Bytes)
+
+	// touch name0, so name1 is the least recently used
+	data, _ := store.Get(names[0], false)
+	require.Equal(t, wire, data)
+
+	store.Sweep()
+	data, _ = store.Get(names[1], false)
+	require.Equal(t, []byte(nil), data)
+	data, _ = store.Get(names[0], false)
+	require.Equal(t, wire, data)
+
+	stats = store.Stats()
+	require.Equal(t, 3, stats.Objects)
+	require.Equal(t, uint64(1), stats.Evicted)
+	require.False(t, stats.LastSweep.IsZero())
+
+	// removal is tracked
+	require.NoError(t, store.RemovePrefix(names[0].Prefix(-1)))
+	require.Equal(t, 0, store.Stats().Objects)
+	require.Equal(t, int64(0), store.Stats().Bytes)
+}
+
+// Tests TTL expiry of a store created with a 50ms TTL for /ndn/edu/ucla/ttl.
+func testStorePolicyTtl(t *testing.T, store policyStore) {
+	name1, _ := enc.NameFromStr("/ndn/edu/ucla/ttl/v1")
+	name2, _ := enc.NameFromStr("/ndn/edu/ucla/keep/v1")
+	wire := []byte{0x01, 0x02, 0x03}
+
+	require.NoError(t, store.Put(name1, wire))
+	require.NoError(t, store.Put(name2, wire))
+
+	// nothing expired yet
+	store.Sweep()
+	require.Equal(t, 2, store.Stats().Objects)
+
+	time.Sleep(100 * time.Millisecond)
+	store.Sweep()
+
+	data, _ := store.Get(name1, false)
+	require.Equal(t, []byte(nil), data)
+	data, _ = store.Get(name2, false)
+	require.Equal(t, wire, data)
+	require.Equal(t, uint64(1), store.Stats().Expired)
+}
+
 // "TestMemoryStore initializes a memory-based storage instance and executes test cases for basic operations, range removal, and transaction handling in NDN Go."
 func TestMemoryStore(t *testing.T) {
 	tu.SetT(t)
@@ -279,4 +444,25 @@ func TestMemoryStore(t *testing.T) {
 	testStoreBasic(t, store)
 	testStoreRemoveRange(t, store)
 	testStoreTxn(t, store)
+	testStoreIter(t, store)
+	testStoreExport(t, store, storage.NewMemoryStore())
+}
+
+// Tests the garbage collection policies of the memory store.
+func TestMemoryStorePolicy(t *testing.T) {
+	tu.SetT(t)
+
+	store := storage.NewMemoryStoreWithPolicy(storage.StorePolicy{
+		MaxObjects: 3,
+		Eviction:   storage.EvictLru,
+	})
+	testStorePolicyLru(t, store)
+	require.NoError(t, store.Close())
+
+	ttlPfx, _ := enc.NameFromStr("/ndn/edu/ucla/ttl")
+	store = storage.NewMemoryStoreWithPolicy(storage.StorePolicy{
+		TTL: []storage.TtlRule{{Prefix: ttlPfx, TTL: 50 * time.Millisecond}},
+	})
+	testStorePolicyTtl(t, store)
+	require.NoError(t, store.Close())
 }
@@ -0,0 +1,140 @@
+package nac
+
+import (
+	"crypto/ecdh"
+	"fmt"
+	"sync"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/ndncert"
+)
+
+// AccessManager owns the KEK/KDK pair for an access prefix.
+type AccessManager struct {
+	client ndn.Client
+	prefix enc.Name
+	mutex  sync.Mutex
+
+	// current key-encryption key
+	kek     *ecdh.PrivateKey
+	kekName enc.Name
+	// granted consumer keys (key name TLV -> consumer)
+	granted map[string]grantedKey
+}
+
+type grantedKey struct {
+	keyName enc.Name
+	pub     *ecdh.PublicKey
+}
+
+// NewAccessManager creates an access manager for the given prefix and publishes its first KEK.
+func NewAccessManager(client ndn.Client, prefix enc.Name) (*AccessManager, error) {
+	m := &AccessManager{
+		client:  client,
+		prefix:  prefix.Clone(),
+		granted: make(map[string]grantedKey),
+	}
+	if err := m.Rotate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Instance log identifier
+func (m *AccessManager) String() string {
+	return fmt.Sprintf("nac-access (%s)", m.prefix)
+}
+
+// KekName returns the versioned name of the current KEK.
+func (m *AccessManager) KekName() enc.Name {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.kekName
+}
+
+// Grant gives the owner of a certificate access to content encrypted under the current KEK.
+// The certificate must be validated by the caller, and must carry an ECDSA P-256 key.
+func (m *AccessManager) Grant(cert ndn.Data) error {
+	keyName, err := sec.GetKeyNameFromCertName(cert.Name())
+	if err != nil {
+		return err
+	}
+
+	pub, err := ecdhPublicKey(cert.Content().Join())
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := grantedKey{keyName: keyName.Clone(), pub: pub}
+	if err := m.publishKdk(key); err != nil {
+		return err
+	}
+	m.granted[keyName.TlvStr()] = key
+	return nil
+}
+
+// Revoke removes a consumer key and rotates the KEK, so that content keys
+// created after this call can no longer be decrypted with that key.
+func (m *AccessManager) Revoke(keyName enc.Name) error {
+	m.mutex.Lock()
+	delete(m.granted, keyName.TlvStr())
+	m.mutex.Unlock()
+
+	return m.Rotate()
+}
+
+// Rotate generates a new KEK and republishes the KDK for every granted consumer.
+func (m *AccessManager) Rotate() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	kek, err := ndncert.EcdhKeygen()
+	if err != nil {
+		return err
+	}
+
+	kekName, err := m.client.Produce(ndn.ProduceArgs{
+		Name:    KekPrefix(m.prefix).WithVersion(enc.VersionUnixMicro),
+		Content: enc.Wire{kek.PublicKey().Bytes()},
+	})
+	if err != nil {
+		return err
+	}
+
+	m.kek = kek
+	m.kekName = kekName
+	log.Info(m, "Published new KEK", "name", kekName)
+
+	for _, key := range m.granted {
+		if err := m.publishKdk(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishKdk encrypts the current KEK private key for a consumer and publishes it.
+func (m *AccessManager) publishKdk(key grantedKey) error {
+	content, err := sealFor(key.pub, key.keyName, m.kek.Bytes())
+	if err != nil {
+		return err
+	}
+
+	name := KdkName(m.kekName, key.keyName)
+	if _, err = m.client.Produce(ndn.ProduceArgs{
+		Name:    name,
+		Content: content.Encode(),
+	}); err != nil {
+		return err
+	}
+
+	log.Debug(m, "Published KDK", "name", name)
+	return nil
+}
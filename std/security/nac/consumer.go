@@ -0,0 +1,148 @@
+package nac
+
+import (
+	"crypto/ecdh"
+	"fmt"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/security/nac/tlv"
+	"github.com/named-data/ndnd/std/security/ndncert"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// Consumer decrypts content using a consumer key that was granted access.
+// It implements ndn.ContentDecrypter and can be set in ConsumeExtArgs.
+type Consumer struct {
+	// Key is the consumer key with a certificate known to the access manager.
+	Key ndn.Signer
+	// TryStore attempts to get keys from the local store first.
+	TryStore bool
+	// IgnoreValidity ignores validity period when validating keys.
+	IgnoreValidity optional.Optional[bool]
+}
+
+// NewConsumer creates a consumer decrypter with the given consumer key.
+func NewConsumer(key ndn.Signer) *Consumer {
+	return &Consumer{Key: key}
+}
+
+// Instance log identifier
+func (d *Consumer) String() string {
+	return "nac-consumer"
+}
+
+// Decrypt decrypts the content of an encrypted object.
+func (d *Consumer) Decrypt(client ndn.Client, content enc.Wire, callback func(enc.Wire, error)) {
+	ec, err := tlv.ParseEncryptedContent(enc.NewWireView(content), false)
+	if err != nil {
+		callback(nil, fmt.Errorf("%w: invalid encrypted content: %w", ndn.ErrSecurity, err))
+		return
+	}
+
+	d.fetchContentKey(client, ec.KeyName, func(ck [ndncert.AeadSizeTag]byte, err error) {
+		if err != nil {
+			callback(nil, err)
+			return
+		}
+
+		plaintext, err := decrypt(ck, ec)
+		if err != nil {
+			callback(nil, fmt.Errorf("%w: failed to decrypt content: %w", ndn.ErrSecurity, err))
+			return
+		}
+
+		callback(enc.Wire{plaintext}, nil)
+	})
+}
+
+// fetchContentKey fetches and decrypts a content key and the KDK used to encrypt it.
+func (d *Consumer) fetchContentKey(
+	client ndn.Client,
+	ckName enc.Name,
+	callback func([ndncert.AeadSizeTag]byte, error),
+) {
+	fail := func(err error) {
+		callback([ndncert.AeadSizeTag]byte{}, err)
+	}
+
+	if !ckName.At(-1).IsVersion() {
+		fail(fmt.Errorf("%w: content key name is not versioned: %s", ndn.ErrSecurity, ckName))
+		return
+	}
+
+	log.Debug(d, "Fetching content key", "name", ckName)
+	d.fetchKey(client, ckName, func(ckEnc *tlv.EncryptedContent, err error) {
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		kekName := ckEnc.KeyName
+		if !IsKekName(kekName) {
+			fail(fmt.Errorf("%w: invalid KEK name: %s", ndn.ErrSecurity, kekName))
+			return
+		}
+
+		d.fetchKey(client, KdkName(kekName, d.Key.KeyName()), func(kdkEnc *tlv.EncryptedContent, err error) {
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			// decrypt the KDK with the consumer key
+			skey, err := ecdhPrivateKey(d.Key)
+			if err != nil {
+				fail(err)
+				return
+			}
+			kdkBits, err := open(skey, kdkEnc)
+			if err != nil {
+				fail(fmt.Errorf("%w: failed to decrypt KDK: %w", ndn.ErrSecurity, err))
+				return
+			}
+			kdk, err := ecdh.P256().NewPrivateKey(kdkBits)
+			if err != nil {
+				fail(fmt.Errorf("%w: invalid KDK: %w", ndn.ErrSecurity, err))
+				return
+			}
+
+			// decrypt the CK with the KDK
+			ck, err := open(kdk, ckEnc)
+			if err != nil {
+				fail(fmt.Errorf("%w: failed to decrypt content key: %w", ndn.ErrSecurity, err))
+				return
+			}
+			if len(ck) != ndncert.AeadSizeTag {
+				fail(fmt.Errorf("%w: invalid content key length", ndn.ErrSecurity))
+				return
+			}
+
+			callback([ndncert.AeadSizeTag]byte(ck), nil)
+		})
+	})
+}
+
+// fetchKey fetches an encrypted key object.
+func (d *Consumer) fetchKey(client ndn.Client, name enc.Name, callback func(*tlv.EncryptedContent, error)) {
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:           name,
+		TryStore:       d.TryStore,
+		IgnoreValidity: d.IgnoreValidity,
+		Callback: func(state ndn.ConsumeState) {
+			if err := state.Error(); err != nil {
+				callback(nil, fmt.Errorf("%w: failed to fetch key %s: %w", ndn.ErrSecurity, name, err))
+				return
+			}
+
+			ec, err := tlv.ParseEncryptedContent(enc.NewWireView(state.Content()), false)
+			if err != nil {
+				callback(nil, fmt.Errorf("%w: invalid key %s: %w", ndn.ErrSecurity, name, err))
+				return
+			}
+
+			callback(ec, nil)
+		},
+	})
+}
@@ -0,0 +1,169 @@
+// Package nac implements name-based access control for the object API.
+//
+// An access manager publishes a key-encryption key (KEK) and, for each
+// granted consumer, the matching key-decryption key (KDK) encrypted with
+// the consumer's certificate key. Producers encrypt content with a content
+// key (CK), which they publish encrypted with the KEK.
+//
+//	KEK: <access-prefix>/32=NAC/32=KEK/<version>
+//	KDK: <access-prefix>/32=NAC/32=KDK/32=ENCRYPTED-BY/<consumer-key>/<version>
+//	CK:  <producer-prefix>/32=NAC/32=CK/<version>
+//
+// The KDK version is always the same as that of the KEK it belongs to.
+// All keys are published as objects with the client's Produce API.
+package nac
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/x509"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/security/nac/tlv"
+	"github.com/named-data/ndnd/std/security/ndncert"
+	"github.com/named-data/ndnd/std/security/signer"
+)
+
+const (
+	KeywordNac         = "NAC"
+	KeywordKek         = "KEK"
+	KeywordKdk         = "KDK"
+	KeywordCk          = "CK"
+	KeywordEncryptedBy = "ENCRYPTED-BY"
+)
+
+// KekPrefix gets the (unversioned) KEK name for an access prefix.
+func KekPrefix(prefix enc.Name) enc.Name {
+	return prefix.
+		Append(enc.NewKeywordComponent(KeywordNac)).
+		Append(enc.NewKeywordComponent(KeywordKek))
+}
+
+// CkPrefix gets the (unversioned) CK name for a producer prefix.
+func CkPrefix(prefix enc.Name) enc.Name {
+	return prefix.
+		Append(enc.NewKeywordComponent(KeywordNac)).
+		Append(enc.NewKeywordComponent(KeywordCk))
+}
+
+// KdkName gets the name of the KDK for a consumer key given the versioned KEK name.
+func KdkName(kekName enc.Name, keyName enc.Name) enc.Name {
+	return kekName.Prefix(-2).
+		Append(enc.NewKeywordComponent(KeywordKdk)).
+		Append(enc.NewKeywordComponent(KeywordEncryptedBy)).
+		Append(keyName...).
+		Append(kekName.At(-1))
+}
+
+// IsKekName checks if a name is a versioned KEK name.
+func IsKekName(name enc.Name) bool {
+	return len(name) >= 3 &&
+		name.At(-1).IsVersion() &&
+		name.At(-2).IsKeyword(KeywordKek) &&
+		name.At(-3).IsKeyword(KeywordNac)
+}
+
+// sealFor encrypts plaintext for the owner of a public key using an ephemeral ECDH key.
+func sealFor(pub *ecdh.PublicKey, keyName enc.Name, plaintext []byte) (*tlv.EncryptedContent, error) {
+	eph, err := ndncert.EcdhKeygen()
+	if err != nil {
+		return nil, err
+	}
+
+	info := keyName.Bytes()
+	key, err := ndncert.EcdhHkdf(eph, pub.Bytes(), nil, info)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ndncert.AeadEncrypt([ndncert.AeadSizeTag]byte(key), plaintext, info, ndncert.NewAeadCounter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &tlv.EncryptedContent{
+		KeyName:      keyName,
+		Payload:      msg.CipherText,
+		InitVec:      msg.IV[:],
+		EphemeralKey: eph.PublicKey().Bytes(),
+		AuthTag:      msg.AuthTag[:],
+	}, nil
+}
+
+// open decrypts an encrypted content sealed with sealFor.
+func open(skey *ecdh.PrivateKey, ec *tlv.EncryptedContent) ([]byte, error) {
+	if ec.EphemeralKey == nil {
+		return nil, ndn.ErrInvalidValue{Item: "EphemeralKey"}
+	}
+
+	info := ec.KeyName.Bytes()
+	key, err := ndncert.EcdhHkdf(skey, ec.EphemeralKey, nil, info)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt([ndncert.AeadSizeTag]byte(key), ec)
+}
+
+// decrypt decrypts an encrypted content with a symmetric key.
+func decrypt(key [ndncert.AeadSizeTag]byte, ec *tlv.EncryptedContent) ([]byte, error) {
+	if len(ec.InitVec) != ndncert.AeadSizeNonce || len(ec.AuthTag) != ndncert.AeadSizeTag {
+		return nil, ndn.ErrInvalidValue{Item: "EncryptedContent"}
+	}
+
+	msg := ndncert.AeadMessage{
+		IV:         [ndncert.AeadSizeNonce]byte(ec.InitVec),
+		AuthTag:    [ndncert.AeadSizeTag]byte(ec.AuthTag),
+		CipherText: ec.Payload,
+	}
+	return ndncert.AeadDecrypt(key, msg, ec.KeyName.Bytes())
+}
+
+// ecdhPublicKey converts a PKIX-encoded ECDSA P-256 public key to an ECDH key.
+func ecdhPublicKey(pkix []byte) (*ecdh.PublicKey, error) {
+	pub, err := x509.ParsePKIXPublicKey(pkix)
+	if err != nil {
+		return nil, err
+	}
+
+	ecpub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, ndn.ErrNotSupported{Item: "non-ECDSA key for NAC"}
+	}
+
+	key, err := ecpub.ECDH()
+	if err != nil {
+		return nil, err
+	}
+	if key.Curve() != ecdh.P256() {
+		return nil, ndn.ErrNotSupported{Item: "non-P256 key for NAC"}
+	}
+	return key, nil
+}
+
+// ecdhPrivateKey converts an ECDSA P-256 signer to an ECDH private key.
+func ecdhPrivateKey(key ndn.Signer) (*ecdh.PrivateKey, error) {
+	if key.Type() != ndn.SignatureSha256WithEcdsa {
+		return nil, ndn.ErrNotSupported{Item: "non-ECDSA key for NAC"}
+	}
+
+	secret, err := signer.GetSecret(key)
+	if err != nil {
+		return nil, err
+	}
+
+	eckey, err := x509.ParseECPrivateKey(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	skey, err := eckey.ECDH()
+	if err != nil {
+		return nil, err
+	}
+	if skey.Curve() != ecdh.P256() {
+		return nil, ndn.ErrNotSupported{Item: "non-P256 key for NAC"}
+	}
+	return skey, nil
+}
@@ -0,0 +1,79 @@
+package nac
+
+import (
+	"crypto/elliptic"
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/security/nac/tlv"
+	"github.com/named-data/ndnd/std/security/ndncert"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Verifies that a key sealed for a consumer's ECDSA key can only be opened by that key.
+func TestNacSealOpen(t *testing.T) {
+	tu.SetT(t)
+
+	aliceName, _ := enc.NameFromStr("/ndn/alice/KEY/1")
+	alice := tu.NoErr(sig.KeygenEcc(aliceName, elliptic.P256()))
+	bobName, _ := enc.NameFromStr("/ndn/bob/KEY/1")
+	bob := tu.NoErr(sig.KeygenEcc(bobName, elliptic.P256()))
+
+	alicePub := tu.NoErr(ecdhPublicKey(tu.NoErr(alice.Public())))
+	secret := []byte("key-decryption-key")
+
+	sealed := tu.NoErr(sealFor(alicePub, aliceName, secret))
+	require.Equal(t, aliceName, sealed.KeyName)
+	require.NotEqual(t, secret, sealed.Payload)
+
+	// round trip through the wire format
+	sealed = tu.NoErr(tlv.ParseEncryptedContent(enc.NewWireView(sealed.Encode()), false))
+
+	// alice can open
+	aliceKey := tu.NoErr(ecdhPrivateKey(alice))
+	require.Equal(t, secret, tu.NoErr(open(aliceKey, sealed)))
+
+	// bob cannot open
+	bobKey := tu.NoErr(ecdhPrivateKey(bob))
+	_, err := open(bobKey, sealed)
+	require.Error(t, err)
+
+	// ed25519 keys are not supported
+	edKey := tu.NoErr(sig.KeygenEd25519(aliceName))
+	_, err = ecdhPrivateKey(edKey)
+	require.Error(t, err)
+}
+
+// Verifies that content encrypted with a content key decrypts only with the same key and name.
+func TestNacContentKey(t *testing.T) {
+	tu.SetT(t)
+
+	ckName, _ := enc.NameFromStr("/ndn/producer/32=NAC/32=CK/v=1")
+	p := &Producer{
+		ck:      [ndncert.AeadSizeTag]byte{1, 2, 3, 4},
+		ckName:  ckName,
+		counter: ndncert.NewAeadCounter(),
+	}
+
+	wire := tu.NoErr(p.Encrypt(enc.Wire{[]byte("hello "), []byte("world")}))
+	ec := tu.NoErr(tlv.ParseEncryptedContent(enc.NewWireView(wire), false))
+	require.Equal(t, ckName, ec.KeyName)
+	require.Nil(t, ec.EphemeralKey)
+
+	pt := tu.NoErr(decrypt(p.ck, ec))
+	require.Equal(t, []byte("hello world"), pt)
+
+	// wrong key
+	_, err := decrypt([ndncert.AeadSizeTag]byte{5}, ec)
+	require.Error(t, err)
+
+	// KDK name is derived from the versioned KEK name
+	kekName, _ := enc.NameFromStr("/ndn/access/32=NAC/32=KEK/v=5")
+	keyName, _ := enc.NameFromStr("/ndn/alice/KEY/1")
+	require.True(t, IsKekName(kekName))
+	require.False(t, IsKekName(ckName))
+	require.Equal(t, "/ndn/access/32=NAC/32=KDK/32=ENCRYPTED-BY/ndn/alice/KEY/1/v=5",
+		KdkName(kekName, keyName).String())
+}
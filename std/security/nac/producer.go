@@ -0,0 +1,124 @@
+package nac
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"fmt"
+	"sync"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/security/nac/tlv"
+	"github.com/named-data/ndnd/std/security/ndncert"
+)
+
+// Producer encrypts content with a content key (CK), which is published
+// encrypted with the KEK of an access manager.
+type Producer struct {
+	client ndn.Client
+	prefix enc.Name
+	mutex  sync.Mutex
+
+	// current content key
+	ck      [ndncert.AeadSizeTag]byte
+	ckName  enc.Name
+	counter *ndncert.AeadCounter
+}
+
+// NewProducer creates a producer that publishes content keys under the given prefix.
+func NewProducer(client ndn.Client, prefix enc.Name) *Producer {
+	return &Producer{
+		client: client,
+		prefix: prefix.Clone(),
+	}
+}
+
+// Instance log identifier
+func (p *Producer) String() string {
+	return fmt.Sprintf("nac-producer (%s)", p.prefix)
+}
+
+// CkName returns the versioned name of the current content key, or nil.
+func (p *Producer) CkName() enc.Name {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.ckName
+}
+
+// Rekey fetches the KEK of an access prefix, then generates and publishes
+// a new content key encrypted under it.
+func (p *Producer) Rekey(accessPrefix enc.Name, callback func(error)) {
+	p.client.Consume(KekPrefix(accessPrefix), func(state ndn.ConsumeState) {
+		if err := state.Error(); err != nil {
+			callback(fmt.Errorf("%w: failed to fetch KEK: %w", ndn.ErrNetwork, err))
+			return
+		}
+
+		if !IsKekName(state.Name()) {
+			callback(fmt.Errorf("%w: invalid KEK name: %s", ndn.ErrSecurity, state.Name()))
+			return
+		}
+
+		kek, err := ecdh.P256().NewPublicKey(state.Content().Join())
+		if err != nil {
+			callback(fmt.Errorf("%w: invalid KEK: %w", ndn.ErrSecurity, err))
+			return
+		}
+
+		callback(p.setContentKey(kek, state.Name()))
+	})
+}
+
+// setContentKey generates a new content key and publishes it encrypted with the KEK.
+func (p *Producer) setContentKey(kek *ecdh.PublicKey, kekName enc.Name) error {
+	var ck [ndncert.AeadSizeTag]byte
+	if _, err := rand.Read(ck[:]); err != nil {
+		return err
+	}
+
+	content, err := sealFor(kek, kekName, ck[:])
+	if err != nil {
+		return err
+	}
+
+	ckName, err := p.client.Produce(ndn.ProduceArgs{
+		Name:    CkPrefix(p.prefix).WithVersion(enc.VersionUnixMicro),
+		Content: content.Encode(),
+	})
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.ck = ck
+	p.ckName = ckName
+	p.counter = ndncert.NewAeadCounter()
+
+	log.Info(p, "Published new content key", "name", ckName, "kek", kekName)
+	return nil
+}
+
+// Encrypt encrypts content with the current content key.
+// The result should be used as the Content of ProduceArgs.
+func (p *Producer) Encrypt(content enc.Wire) (enc.Wire, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.ckName == nil {
+		return nil, fmt.Errorf("%w: no content key, call Rekey first", ndn.ErrSecurity)
+	}
+
+	msg, err := ndncert.AeadEncrypt(p.ck, content.Join(), p.ckName.Bytes(), p.counter)
+	if err != nil {
+		return nil, err
+	}
+
+	return (&tlv.EncryptedContent{
+		KeyName: p.ckName,
+		Payload: msg.CipherText,
+		InitVec: msg.IV[:],
+		AuthTag: msg.AuthTag[:],
+	}).Encode(), nil
+}
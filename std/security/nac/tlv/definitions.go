@@ -0,0 +1,22 @@
+//go:generate gondn_tlv_gen
+package tlv
+
+import enc "github.com/named-data/ndnd/std/encoding"
+
+// EncryptedContent is the content of an encrypted Data packet or key.
+// The payload is sealed with AES-GCM. If EphemeralKey is present, the
+// AES key is derived with ECDH+HKDF between the ephemeral key and the
+// public key identified by KeyName; otherwise KeyName identifies the
+// content key directly.
+type EncryptedContent struct {
+	//+field:name
+	KeyName enc.Name `tlv:"0x07"`
+	//+field:binary
+	Payload []byte `tlv:"0x84"`
+	//+field:binary
+	InitVec []byte `tlv:"0x85"`
+	//+field:binary
+	EphemeralKey []byte `tlv:"0x86"`
+	//+field:binary
+	AuthTag []byte `tlv:"0x87"`
+}
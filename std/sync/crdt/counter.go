@@ -0,0 +1,152 @@
+package crdt
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	spec_crdt "github.com/named-data/ndnd/std/ndn/crdt"
+)
+
+// GCounter is a grow-only counter.
+type GCounter struct {
+	replica string
+	mutex   sync.RWMutex
+	counts  map[string]uint64
+}
+
+// NewGCounter creates a new grow-only counter.
+// The replica name must be unique for each instance.
+func NewGCounter(replica string) *GCounter {
+	return &GCounter{
+		replica: replica,
+		counts:  make(map[string]uint64),
+	}
+}
+
+// Inc increments the counter and returns the delta.
+func (c *GCounter) Inc(n uint64) *spec_crdt.State {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.counts[c.replica] += n
+	return &spec_crdt.State{
+		Positive: []*spec_crdt.Counter{{Replica: c.replica, Value: c.counts[c.replica]}},
+	}
+}
+
+// Value returns the value of the counter.
+func (c *GCounter) Value() uint64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return sumCounts(c.counts)
+}
+
+// Merge joins a remote state or delta into the counter.
+func (c *GCounter) Merge(state *spec_crdt.State) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	mergeCounts(c.counts, state.Positive)
+}
+
+// State returns the full state of the counter.
+func (c *GCounter) State() *spec_crdt.State {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return &spec_crdt.State{Positive: encodeCounts(c.counts)}
+}
+
+// PNCounter is a counter that can be incremented and decremented.
+type PNCounter struct {
+	replica string
+	mutex   sync.RWMutex
+	pos     map[string]uint64
+	neg     map[string]uint64
+}
+
+// NewPNCounter creates a new increment/decrement counter.
+// The replica name must be unique for each instance.
+func NewPNCounter(replica string) *PNCounter {
+	return &PNCounter{
+		replica: replica,
+		pos:     make(map[string]uint64),
+		neg:     make(map[string]uint64),
+	}
+}
+
+// Inc increments the counter and returns the delta.
+func (c *PNCounter) Inc(n uint64) *spec_crdt.State {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.pos[c.replica] += n
+	return &spec_crdt.State{
+		Positive: []*spec_crdt.Counter{{Replica: c.replica, Value: c.pos[c.replica]}},
+	}
+}
+
+// Dec decrements the counter and returns the delta.
+func (c *PNCounter) Dec(n uint64) *spec_crdt.State {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.neg[c.replica] += n
+	return &spec_crdt.State{
+		Negative: []*spec_crdt.Counter{{Replica: c.replica, Value: c.neg[c.replica]}},
+	}
+}
+
+// Value returns the value of the counter.
+func (c *PNCounter) Value() int64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return int64(sumCounts(c.pos) - sumCounts(c.neg))
+}
+
+// Merge joins a remote state or delta into the counter.
+func (c *PNCounter) Merge(state *spec_crdt.State) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	mergeCounts(c.pos, state.Positive)
+	mergeCounts(c.neg, state.Negative)
+}
+
+// State returns the full state of the counter.
+func (c *PNCounter) State() *spec_crdt.State {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return &spec_crdt.State{
+		Positive: encodeCounts(c.pos),
+		Negative: encodeCounts(c.neg),
+	}
+}
+
+// sumCounts returns the sum of all replica counts.
+func sumCounts(counts map[string]uint64) (sum uint64) {
+	for _, v := range counts {
+		sum += v
+	}
+	return sum
+}
+
+// mergeCounts takes the maximum count of each replica.
+func mergeCounts(counts map[string]uint64, remote []*spec_crdt.Counter) {
+	for _, c := range remote {
+		counts[c.Replica] = max(counts[c.Replica], c.Value)
+	}
+}
+
+// encodeCounts encodes the counts sorted by replica.
+func encodeCounts(counts map[string]uint64) []*spec_crdt.Counter {
+	res := make([]*spec_crdt.Counter, 0, len(counts))
+	for _, replica := range slices.Sorted(maps.Keys(counts)) {
+		res = append(res, &spec_crdt.Counter{Replica: replica, Value: counts[replica]})
+	}
+	return res
+}
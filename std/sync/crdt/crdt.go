@@ -0,0 +1,120 @@
+// Package crdt provides conflict-free replicated data types on top of SvsALO.
+//
+// Each data type is a state-based CRDT. Local updates return a delta state,
+// which is published to the group through a Replicator and merged by all
+// other replicas. Since merging is idempotent and commutative, replicas
+// converge regardless of the order and number of deliveries.
+//
+// The Replicator periodically publishes a snapshot of the entire merged
+// state, so new members bootstrap from the latest snapshot instead of
+// fetching the full history of publications.
+package crdt
+
+import (
+	"fmt"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	spec_crdt "github.com/named-data/ndnd/std/ndn/crdt"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+)
+
+// Crdt is a state-based replicated data type.
+type Crdt interface {
+	// Merge joins a remote state or delta into the local state.
+	Merge(state *spec_crdt.State)
+	// State returns the full local state.
+	State() *spec_crdt.State
+}
+
+// Replicator replicates a data type through SvsALO publications.
+type Replicator struct {
+	o   ReplicatorOpts
+	alo *ndn_sync.SvsALO
+}
+
+type ReplicatorOpts struct {
+	// Data is the replicated data type.
+	Data Crdt
+	// Alo is the options of the underlying SvsALO instance.
+	// The Snapshot strategy is set by the Replicator.
+	Alo ndn_sync.SvsAloOpts
+	// Number of publications before a snapshot of the merged state is taken (default 100)
+	SnapshotThreshold uint64
+	// Callback after an update from a remote replica is merged (optional)
+	OnChange func(publisher enc.Name)
+}
+
+// NewReplicator creates a new Replicator for a data type.
+func NewReplicator(opts ReplicatorOpts) (*Replicator, error) {
+	if opts.Data == nil {
+		panic("Replicator: Data is required")
+	}
+	if opts.SnapshotThreshold == 0 {
+		opts.SnapshotThreshold = 100
+	}
+
+	r := &Replicator{o: opts}
+
+	// The snapshot is the merged state of all replicas
+	r.o.Alo.Snapshot = &ndn_sync.SnapshotNodeLatest{
+		SnapMe: func(enc.Name) (enc.Wire, error) {
+			return r.o.Data.State().Encode(), nil
+		},
+		Threshold: r.o.SnapshotThreshold,
+	}
+
+	alo, err := ndn_sync.NewSvsALO(r.o.Alo)
+	if err != nil {
+		return nil, err
+	}
+	r.alo = alo
+
+	// Snapshots and deltas are merged the same way
+	if err := r.alo.SubscribePublisher(enc.Name{}, r.onPublication); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Instance log identifier
+func (r *Replicator) String() string {
+	return fmt.Sprintf("crdt (%s)", r.o.Alo.Name)
+}
+
+// ALO returns the underlying SvsALO instance.
+func (r *Replicator) ALO() *ndn_sync.SvsALO {
+	return r.alo
+}
+
+// Start the Replicator.
+func (r *Replicator) Start() error {
+	return r.alo.Start()
+}
+
+// Stop the Replicator.
+func (r *Replicator) Stop() error {
+	return r.alo.Stop()
+}
+
+// Publish sends a delta returned by a local update to the group.
+func (r *Replicator) Publish(delta *spec_crdt.State) error {
+	_, _, err := r.alo.Publish(delta.Encode())
+	return err
+}
+
+// onPublication merges a delta or snapshot from a remote replica.
+func (r *Replicator) onPublication(pub ndn_sync.SvsPub) {
+	state, err := spec_crdt.ParseState(enc.NewWireView(pub.Content), true)
+	if err != nil {
+		log.Warn(r, "Failed to parse publication", "name", pub.DataName, "err", err)
+		return
+	}
+
+	r.o.Data.Merge(state)
+
+	if r.o.OnChange != nil {
+		r.o.OnChange(pub.Publisher)
+	}
+}
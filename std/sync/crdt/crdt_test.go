@@ -0,0 +1,226 @@
+package crdt_test
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	spec_crdt "github.com/named-data/ndnd/std/ndn/crdt"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	ndn_sync "github.com/named-data/ndnd/std/sync"
+	"github.com/named-data/ndnd/std/sync/crdt"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// wire sends a state through its wire encoding.
+func wire(state *spec_crdt.State) *spec_crdt.State {
+	return tu.NoErr(spec_crdt.ParseState(enc.NewWireView(state.Encode()), true))
+}
+
+// sync merges the full state of every replica into every other replica.
+func syncAll(replicas ...crdt.Crdt) {
+	for _, a := range replicas {
+		for _, b := range replicas {
+			b.Merge(wire(a.State()))
+		}
+	}
+}
+
+// Tests merging of grow-only counters in different orders.
+func TestGCounter(t *testing.T) {
+	tu.SetT(t)
+
+	a := crdt.NewGCounter("a")
+	b := crdt.NewGCounter("b")
+	c := crdt.NewGCounter("c")
+
+	da := a.Inc(3)
+	db := b.Inc(4)
+	dc := c.Inc(5)
+	require.Equal(t, uint64(3), a.Value())
+
+	// Deltas in different orders, with duplicates
+	a.Merge(wire(dc))
+	a.Merge(wire(db))
+	b.Merge(wire(da))
+	b.Merge(wire(dc))
+	b.Merge(wire(dc))
+	require.Equal(t, uint64(12), a.Value())
+	require.Equal(t, uint64(12), b.Value())
+
+	// Stale delta is ignored
+	c.Inc(1)
+	c.Merge(wire(dc))
+	require.Equal(t, uint64(6), c.Value())
+
+	syncAll(a, b, c)
+	require.Equal(t, uint64(13), a.Value())
+	require.Equal(t, uint64(13), b.Value())
+	require.Equal(t, uint64(13), c.Value())
+	require.Equal(t, a.State(), c.State())
+}
+
+// Tests merging of increment/decrement counters.
+func TestPNCounter(t *testing.T) {
+	tu.SetT(t)
+
+	a := crdt.NewPNCounter("a")
+	b := crdt.NewPNCounter("b")
+
+	a.Inc(10)
+	b.Dec(4)
+	d := a.Dec(3)
+	require.Equal(t, int64(7), a.Value())
+	require.Equal(t, int64(-4), b.Value())
+
+	b.Merge(wire(d))
+	require.Equal(t, int64(-7), b.Value())
+
+	syncAll(a, b)
+	require.Equal(t, int64(3), a.Value())
+	require.Equal(t, int64(3), b.Value())
+	require.Equal(t, a.State(), b.State())
+}
+
+// Tests concurrent writes to last-writer-wins registers.
+func TestLWWRegister(t *testing.T) {
+	tu.SetT(t)
+
+	a := crdt.NewLWWRegister("a")
+	b := crdt.NewLWWRegister("b")
+	require.Nil(t, a.Get())
+
+	a.Set([]byte("first"))
+	b.Merge(wire(a.State()))
+	require.Equal(t, []byte("first"), b.Get())
+
+	// A write after observing a remote write always wins
+	b.Set([]byte("second"))
+	a.Merge(wire(b.State()))
+	require.Equal(t, []byte("second"), a.Get())
+
+	// Equal timestamps are ordered by the replica name
+	c := crdt.NewLWWRegister("c")
+	c.Merge(&spec_crdt.State{Registers: []*spec_crdt.Register{
+		{Value: []byte("z"), Timestamp: 1 << 62, Replica: "z"},
+	}})
+	c.Merge(&spec_crdt.State{Registers: []*spec_crdt.Register{
+		{Value: []byte("y"), Timestamp: 1 << 62, Replica: "y"},
+	}})
+	require.Equal(t, []byte("z"), c.Get())
+
+	syncAll(a, b, c)
+	require.Equal(t, []byte("z"), a.Get())
+	require.Equal(t, []byte("z"), b.Get())
+}
+
+// Tests concurrent additions and removals of observed-remove sets.
+func TestORSet(t *testing.T) {
+	tu.SetT(t)
+
+	a := crdt.NewORSet()
+	b := crdt.NewORSet()
+
+	a.Add([]byte("apple"))
+	a.Add([]byte("pear"))
+	syncAll(a, b)
+	require.Equal(t, [][]byte{[]byte("apple"), []byte("pear")}, b.Values())
+
+	// Concurrent add and remove of the same value: add wins
+	da := a.Remove([]byte("apple"))
+	db := b.Add([]byte("apple"))
+	require.False(t, a.Contains([]byte("apple")))
+	a.Merge(wire(db))
+	b.Merge(wire(da))
+	require.True(t, a.Contains([]byte("apple")))
+	require.True(t, b.Contains([]byte("apple")))
+
+	// Observed remove
+	d := b.Remove([]byte("pear"))
+	a.Merge(wire(d))
+	require.False(t, a.Contains([]byte("pear")))
+
+	// Stale addition does not resurrect the value
+	syncAll(a, b)
+	a.Merge(wire(a.State()))
+	require.Equal(t, [][]byte{[]byte("apple")}, a.Values())
+	require.Equal(t, a.State(), b.State())
+}
+
+// Tests set and delete of last-writer-wins maps.
+func TestLWWMap(t *testing.T) {
+	tu.SetT(t)
+
+	a := crdt.NewLWWMap("a")
+	b := crdt.NewLWWMap("b")
+
+	a.Set("x", []byte("1"))
+	b.Set("y", []byte("2"))
+	syncAll(a, b)
+	require.Equal(t, []string{"x", "y"}, a.Keys())
+
+	// Delete after observing the write
+	d := b.Delete("x")
+	a.Merge(wire(d))
+	_, ok := a.Get("x")
+	require.False(t, ok)
+
+	// Older write does not resurrect the key
+	a.Merge(&spec_crdt.State{Registers: []*spec_crdt.Register{
+		{Key: []byte("x"), Value: []byte("old"), Timestamp: 1, Replica: "z"},
+	}})
+	require.Equal(t, []string{"y"}, a.Keys())
+
+	syncAll(a, b)
+	val, ok := b.Get("y")
+	require.True(t, ok)
+	require.Equal(t, []byte("2"), val)
+	require.Equal(t, a.State(), b.State())
+}
+
+// Tests that the replicator publishes deltas and snapshots of the merged state.
+func TestReplicator(t *testing.T) {
+	tu.SetT(t)
+
+	client := object.NewClient(engine.NewBasicEngine(face.NewDummyFace()), storage.NewMemoryStore(), nil)
+	group := tu.NoErr(enc.NameFromStr("/ndn/group"))
+	node := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+
+	counter := crdt.NewGCounter("alice")
+	counter.Merge(&spec_crdt.State{Positive: []*spec_crdt.Counter{{Replica: "bob", Value: 100}}})
+
+	rep := tu.NoErr(crdt.NewReplicator(crdt.ReplicatorOpts{
+		Data: counter,
+		Alo: ndn_sync.SvsAloOpts{
+			Name: node,
+			Svs: ndn_sync.SvSyncOpts{
+				Client:      client,
+				GroupPrefix: group,
+				BootTime:    uint64(time.Now().Unix()),
+			},
+		},
+		SnapshotThreshold: 5,
+	}))
+
+	// A snapshot is taken on the first and sixth publication
+	for range 6 {
+		require.NoError(t, rep.Publish(counter.Inc(1)))
+	}
+
+	// Each publication is a delta
+	pub := rep.ALO().DataPrefix().Append(enc.NewSequenceNumComponent(5))
+	content := tu.NoErr(client.GetLocal(tu.NoErr(client.LatestLocal(pub))))
+	delta := tu.NoErr(spec_crdt.ParseState(enc.NewWireView(content), true))
+	require.Equal(t, []*spec_crdt.Counter{{Replica: "alice", Value: 5}}, delta.Positive)
+
+	// The snapshot is the merged state
+	snap := rep.ALO().DataPrefix().Append(enc.NewKeywordComponent("SNAP"))
+	content = tu.NoErr(client.GetLocal(tu.NoErr(client.LatestLocal(snap))))
+	other := crdt.NewGCounter("cathy")
+	other.Merge(tu.NoErr(spec_crdt.ParseState(enc.NewWireView(content), true)))
+	require.Equal(t, uint64(106), other.Value())
+}
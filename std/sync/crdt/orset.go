@@ -0,0 +1,130 @@
+package crdt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"maps"
+	"slices"
+	"sync"
+
+	spec_crdt "github.com/named-data/ndnd/std/ndn/crdt"
+)
+
+// ORSet is an observed-remove set of byte strings.
+//
+// Each addition is tagged uniquely, and a removal only removes the tags
+// observed by the remover. Concurrent additions therefore win over removals.
+//
+// The tag of every removed element is kept as a tombstone, so that a
+// delayed or replayed addition of the same tag cannot resurrect it.
+// Tombstones are never discarded and are included in every snapshot,
+// so the state of the set grows with the total number of removals.
+// The ORSet is therefore meant for sets with a bounded number of updates,
+// such as membership or configuration, and not for high-churn data.
+type ORSet struct {
+	mutex sync.RWMutex
+	// value of each live tag
+	adds map[string][]byte
+	// removed tags (tombstones)
+	removed map[string]bool
+}
+
+// NewORSet creates a new observed-remove set.
+func NewORSet() *ORSet {
+	return &ORSet{
+		adds:    make(map[string][]byte),
+		removed: make(map[string]bool),
+	}
+}
+
+// Add adds a value to the set and returns the delta.
+func (s *ORSet) Add(value []byte) *spec_crdt.State {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tag := make([]byte, 16)
+	rand.Read(tag)
+
+	value = slices.Clone(value)
+	s.adds[string(tag)] = value
+	return &spec_crdt.State{
+		Elements: []*spec_crdt.Element{{Value: value, Tag: tag}},
+	}
+}
+
+// Remove removes a value from the set and returns the delta.
+func (s *ORSet) Remove(value []byte) *spec_crdt.State {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delta := &spec_crdt.State{}
+	for tag, v := range s.adds {
+		if bytes.Equal(v, value) {
+			delete(s.adds, tag)
+			s.removed[tag] = true
+			delta.Removed = append(delta.Removed, []byte(tag))
+		}
+	}
+	return delta
+}
+
+// Contains checks if a value is in the set.
+func (s *ORSet) Contains(value []byte) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, v := range s.adds {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Values returns the sorted list of values in the set.
+func (s *ORSet) Values() [][]byte {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	unique := make(map[string][]byte, len(s.adds))
+	for _, v := range s.adds {
+		unique[string(v)] = v
+	}
+	values := slices.Collect(maps.Values(unique))
+	slices.SortFunc(values, bytes.Compare)
+	return values
+}
+
+// Merge joins a remote state or delta into the set.
+func (s *ORSet) Merge(state *spec_crdt.State) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, tag := range state.Removed {
+		delete(s.adds, string(tag))
+		s.removed[string(tag)] = true
+	}
+	for _, elem := range state.Elements {
+		if !s.removed[string(elem.Tag)] {
+			s.adds[string(elem.Tag)] = elem.Value
+		}
+	}
+}
+
+// State returns the full state of the set.
+func (s *ORSet) State() *spec_crdt.State {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	state := &spec_crdt.State{
+		Elements: make([]*spec_crdt.Element, 0, len(s.adds)),
+		Removed:  make([][]byte, 0, len(s.removed)),
+	}
+	for _, tag := range slices.Sorted(maps.Keys(s.adds)) {
+		state.Elements = append(state.Elements, &spec_crdt.Element{Value: s.adds[tag], Tag: []byte(tag)})
+	}
+	for _, tag := range slices.Sorted(maps.Keys(s.removed)) {
+		state.Removed = append(state.Removed, []byte(tag))
+	}
+	return state
+}
@@ -0,0 +1,197 @@
+package crdt
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	spec_crdt "github.com/named-data/ndnd/std/ndn/crdt"
+)
+
+// LWWRegister is a last-writer-wins register.
+type LWWRegister struct {
+	replica string
+	mutex   sync.RWMutex
+	clock   lwwClock
+	reg     *spec_crdt.Register
+}
+
+// NewLWWRegister creates a new last-writer-wins register.
+// The replica name must be unique for each instance.
+func NewLWWRegister(replica string) *LWWRegister {
+	return &LWWRegister{replica: replica}
+}
+
+// Set sets the value of the register and returns the delta.
+func (r *LWWRegister) Set(value []byte) *spec_crdt.State {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.reg = &spec_crdt.Register{
+		Value:     slices.Clone(value),
+		Timestamp: r.clock.next(),
+		Replica:   r.replica,
+	}
+	return &spec_crdt.State{Registers: []*spec_crdt.Register{r.reg}}
+}
+
+// Get returns the value of the register, or nil if never set.
+func (r *LWWRegister) Get() []byte {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.reg == nil {
+		return nil
+	}
+	return r.reg.Value
+}
+
+// Merge joins a remote state or delta into the register.
+func (r *LWWRegister) Merge(state *spec_crdt.State) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, reg := range state.Registers {
+		r.clock.observe(reg.Timestamp)
+		if lwwNewer(reg, r.reg) {
+			r.reg = reg
+		}
+	}
+}
+
+// State returns the full state of the register.
+func (r *LWWRegister) State() *spec_crdt.State {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.reg == nil {
+		return &spec_crdt.State{}
+	}
+	return &spec_crdt.State{Registers: []*spec_crdt.Register{r.reg}}
+}
+
+// LWWMap is a map of last-writer-wins registers.
+type LWWMap struct {
+	replica string
+	mutex   sync.RWMutex
+	clock   lwwClock
+	// deleted keys are kept as tombstones
+	regs map[string]*spec_crdt.Register
+}
+
+// NewLWWMap creates a new last-writer-wins map.
+// The replica name must be unique for each instance.
+func NewLWWMap(replica string) *LWWMap {
+	return &LWWMap{
+		replica: replica,
+		regs:    make(map[string]*spec_crdt.Register),
+	}
+}
+
+// Set sets the value of a key and returns the delta.
+func (m *LWWMap) Set(key string, value []byte) *spec_crdt.State {
+	return m.write(key, slices.Clone(value), false)
+}
+
+// Delete removes a key and returns the delta.
+func (m *LWWMap) Delete(key string) *spec_crdt.State {
+	return m.write(key, nil, true)
+}
+
+// write updates the register of a key.
+func (m *LWWMap) write(key string, value []byte, deleted bool) *spec_crdt.State {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	reg := &spec_crdt.Register{
+		Key:       []byte(key),
+		Value:     value,
+		Timestamp: m.clock.next(),
+		Replica:   m.replica,
+		Deleted:   deleted,
+	}
+	m.regs[key] = reg
+	return &spec_crdt.State{Registers: []*spec_crdt.Register{reg}}
+}
+
+// Get returns the value of a key.
+func (m *LWWMap) Get(key string) ([]byte, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	reg, ok := m.regs[key]
+	if !ok || reg.Deleted {
+		return nil, false
+	}
+	return reg.Value, true
+}
+
+// Keys returns the sorted list of keys in the map.
+func (m *LWWMap) Keys() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	keys := make([]string, 0, len(m.regs))
+	for key, reg := range m.regs {
+		if !reg.Deleted {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Merge joins a remote state or delta into the map.
+func (m *LWWMap) Merge(state *spec_crdt.State) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, reg := range state.Registers {
+		m.clock.observe(reg.Timestamp)
+		if key := string(reg.Key); lwwNewer(reg, m.regs[key]) {
+			m.regs[key] = reg
+		}
+	}
+}
+
+// State returns the full state of the map.
+func (m *LWWMap) State() *spec_crdt.State {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	regs := make([]*spec_crdt.Register, 0, len(m.regs))
+	for _, key := range slices.Sorted(maps.Keys(m.regs)) {
+		regs = append(regs, m.regs[key])
+	}
+	return &spec_crdt.State{Registers: regs}
+}
+
+// lwwNewer checks if register a wins over b.
+// Ties of the timestamp are broken by the replica name.
+func lwwNewer(a, b *spec_crdt.Register) bool {
+	if b == nil {
+		return true
+	}
+	if a.Timestamp != b.Timestamp {
+		return a.Timestamp > b.Timestamp
+	}
+	return a.Replica > b.Replica
+}
+
+// lwwClock generates timestamps that are greater than all observed ones,
+// so that a local write always wins over the writes it has seen.
+type lwwClock struct {
+	last uint64
+}
+
+// next returns the timestamp for a new write.
+func (c *lwwClock) next() uint64 {
+	c.last = max(uint64(time.Now().UnixNano()), c.last+1)
+	return c.last
+}
+
+// observe records a remote timestamp.
+func (c *lwwClock) observe(ts uint64) {
+	c.last = max(c.last, ts)
+}
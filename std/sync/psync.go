@@ -0,0 +1,664 @@
+package sync
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	rand "math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec_psync "github.com/named-data/ndnd/std/ndn/psync"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// number of recent sync replies kept to serve their remaining segments
+const psyncReplyCount = 64
+
+// maximum size of the uncompressed content of sync data
+const psyncMaxStateSize = 1 << 22
+
+// size of the segments of sync data, leaving room for the long names
+const psyncSegmentSize = ndn.MaxNDNPacketSize / 2
+
+// PSyncFull is a PSync full-sync participant.
+//
+// Each participant publishes a set of name prefixes with sequence numbers,
+// and all participants converge to the same set using the difference of
+// invertible Bloom lookup tables (IBLT) carried in Sync Interests.
+// The state of PSync is independent of the number of producers that
+// are not updated, unlike SVS.
+//
+// The packet format is that of the PSync library. Sync Interests are named
+// /<group>/<IBLT>/<count>, and the reply is a segmented object named
+// /<sync interest>/<IBLT>/<version>/<segment>, containing the compressed state.
+//
+// A full-sync participant also serves PSyncPartial consumers, which only
+// receive updates for the prefixes they subscribe to.
+type PSyncFull struct {
+	o PSyncOpts
+
+	running atomic.Bool
+	stopped atomic.Bool
+	stop    chan struct{}
+	ticker  *time.Ticker
+
+	mutex sync.Mutex
+	// latest sequence number of each prefix
+	prefixes map[string]uint64
+	// IBLT key of the latest name of each prefix
+	prefixKeys map[string]uint32
+	// latest name of each IBLT key
+	keyNames map[uint32]enc.Name
+	// IBLT of the latest names of all prefixes
+	iblt *psyncIblt
+	// Sync Interests waiting for new data
+	pending map[string]*psyncPending
+	// store of recent sync replies
+	store ndn.Store
+	// names of recent sync replies
+	replies *storage.MemoryFifoDir
+
+	// routable prefix of all sync Interests
+	prefix enc.Name
+
+	// channel for incoming sync data
+	recvState chan *spec_psync.State
+}
+
+type PSyncOpts struct {
+	// NDN Object API client
+	Client ndn.Client
+	// Sync group prefix for the PSync group
+	GroupPrefix enc.Name
+	// Callback for PSync updates. The Boot field is always zero.
+	OnUpdate func(SvSyncUpdate)
+
+	// Initial state from persistence
+	InitialState *spec_psync.State
+	// Expected number of differences between participants (default 80)
+	// This must be the same for all participants in the group.
+	ExpectedEntries int
+	// Lifetime of Sync Interests (default 1s)
+	SyncInterestLifetime time.Duration
+	// Freshness period of Sync Data (default 1s)
+	SyncReplyFreshness time.Duration
+
+	// IgnoreValidity ignores validity period in the validation chain
+	IgnoreValidity optional.Optional[bool]
+}
+
+// psyncPending is a Sync Interest that could not be answered yet.
+type psyncPending struct {
+	name     enc.Name
+	iblt     *psyncIblt
+	bloom    *psyncBloom // partial sync only
+	reply    ndn.WireReplyFunc
+	deadline time.Time
+}
+
+// NewPSyncFull creates a new PSync full-sync instance.
+func NewPSyncFull(opts PSyncOpts) *PSyncFull {
+	// Check required options
+	if opts.Client == nil {
+		panic("PSyncFull: Client is required")
+	}
+	if len(opts.GroupPrefix) == 0 {
+		panic("PSyncFull: GroupPrefix is required")
+	}
+	if opts.OnUpdate == nil {
+		panic("PSyncFull: OnUpdate is required")
+	}
+
+	// Set default options
+	if opts.ExpectedEntries == 0 {
+		opts.ExpectedEntries = 80
+	}
+	if opts.SyncInterestLifetime == 0 {
+		opts.SyncInterestLifetime = 1 * time.Second
+	}
+	if opts.SyncReplyFreshness == 0 {
+		opts.SyncReplyFreshness = 1 * time.Second
+	}
+
+	s := &PSyncFull{
+		o: opts,
+
+		running: atomic.Bool{},
+		stop:    make(chan struct{}),
+		ticker:  time.NewTicker(opts.SyncInterestLifetime / 2),
+
+		mutex:      sync.Mutex{},
+		prefixes:   make(map[string]uint64),
+		prefixKeys: make(map[string]uint32),
+		keyNames:   make(map[uint32]enc.Name),
+		iblt:       newPsyncIblt(opts.ExpectedEntries),
+		pending:    make(map[string]*psyncPending),
+		store:      storage.NewMemoryStore(),
+		replies:    storage.NewMemoryFifoDir(psyncReplyCount),
+
+		prefix: opts.GroupPrefix,
+
+		recvState: make(chan *spec_psync.State, 128),
+	}
+
+	// Use initial state if provided
+	if opts.InitialState != nil {
+		for _, name := range opts.InitialState.Content {
+			if prefix, seq, ok := psyncSplitName(name); ok {
+				s.updateSeqNo(prefix, seq)
+			}
+		}
+	}
+
+	return s
+}
+
+// Instance log identifier
+func (s *PSyncFull) String() string {
+	return fmt.Sprintf("psync (%s)", s.o.GroupPrefix)
+}
+
+// Start the PSync instance.
+func (s *PSyncFull) Start() (err error) {
+	err = s.o.Client.Engine().AttachHandler(s.prefix,
+		func(args ndn.InterestHandlerArgs) {
+			s.onInterest(args)
+		})
+	if err != nil {
+		return err
+	}
+
+	go s.main()
+
+	return nil
+}
+
+// Processes incoming sync data and periodically sends Sync Interests until the instance is stopped.
+func (s *PSyncFull) main() {
+	// Cleanup on exit
+	defer s.o.Client.Engine().DetachHandler(s.prefix)
+
+	// Set running state
+	s.running.Store(true)
+	defer s.running.Store(false)
+
+	// Send the initial Sync Interest
+	go s.sendSyncInterest()
+
+	for {
+		select {
+		case <-s.ticker.C:
+			s.ticker.Reset(s.getSyncInterval())
+			s.mutex.Lock()
+			s.satisfyPending() // also drops expired Interests
+			s.mutex.Unlock()
+			go s.sendSyncInterest()
+		case state := <-s.recvState:
+			s.onSyncState(state)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Stop the PSync instance.
+func (s *PSyncFull) Stop() error {
+	if !s.stopped.Swap(true) {
+		s.ticker.Stop()
+		close(s.stop)
+	}
+	return nil
+}
+
+// GetSeqNo returns the sequence number for a name prefix.
+func (s *PSyncFull) GetSeqNo(name enc.Name) uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.prefixes[name.TlvStr()]
+}
+
+// SetSeqNo sets the sequence number for a name prefix.
+// The instance must only set sequence numbers for prefixes it owns.
+// The sequence number must be greater than the previous value.
+func (s *PSyncFull) SetSeqNo(name enc.Name, seqNo uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if seqNo <= s.prefixes[name.TlvStr()] {
+		return fmt.Errorf("PSyncFull: seqNo must be greater than previous")
+	}
+
+	s.updateSeqNo(name, seqNo)
+	s.satisfyPending()
+	return nil
+}
+
+// IncrSeqNo increments the sequence number for a name prefix.
+// The instance must only increment sequence numbers for prefixes it owns.
+func (s *PSyncFull) IncrSeqNo(name enc.Name) uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seqNo := s.prefixes[name.TlvStr()] + 1
+	s.updateSeqNo(name, seqNo)
+	s.satisfyPending()
+	return seqNo
+}
+
+// GetNames returns all name prefixes known to this instance.
+func (s *PSyncFull) GetNames() []enc.Name {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	names := make([]enc.Name, 0, len(s.prefixKeys))
+	for _, key := range s.prefixKeys {
+		names = append(names, s.keyNames[key].Prefix(-1))
+	}
+	return names
+}
+
+// State returns the current state for persistence.
+func (s *PSyncFull) State() *spec_psync.State {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.encodeState(nil)
+}
+
+// updateSeqNo replaces the latest name of a prefix in the IBLT (requires lock).
+func (s *PSyncFull) updateSeqNo(prefix enc.Name, seqNo uint64) {
+	hash := prefix.TlvStr()
+
+	if key, ok := s.prefixKeys[hash]; ok {
+		s.iblt.erase(key)
+		delete(s.keyNames, key)
+	}
+
+	name := prefix.Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, seqNo))
+	key := psyncHashName(name)
+	s.iblt.insert(key)
+	s.keyNames[key] = name
+	s.prefixKeys[hash] = key
+	s.prefixes[hash] = seqNo
+}
+
+// encodeState returns the latest names of all prefixes matching the filter (requires lock).
+func (s *PSyncFull) encodeState(bloom *psyncBloom) *spec_psync.State {
+	state := &spec_psync.State{Content: make([]enc.Name, 0, len(s.keyNames))}
+	for _, name := range s.keyNames {
+		if bloom == nil || bloom.contains(name.Prefix(-1)) {
+			state.Content = append(state.Content, name)
+		}
+	}
+	return state
+}
+
+// diffState returns the latest names for the keys that the remote IBLT is missing,
+// or the entire state if the difference cannot be decoded (requires lock).
+func (s *PSyncFull) diffState(remote *psyncIblt, bloom *psyncBloom) *spec_psync.State {
+	diff, err := s.iblt.sub(remote)
+	if err != nil {
+		return &spec_psync.State{}
+	}
+
+	positive, _, ok := diff.listEntries()
+	if !ok {
+		// Cannot decode the difference, send everything we have
+		return s.encodeState(bloom)
+	}
+
+	state := &spec_psync.State{}
+	for _, key := range positive {
+		if name, ok := s.keyNames[key]; ok {
+			if bloom == nil || bloom.contains(name.Prefix(-1)) {
+				state.Content = append(state.Content, name)
+			}
+		}
+	}
+	return state
+}
+
+// onInterest handles full sync, partial hello and partial sync Interests.
+func (s *PSyncFull) onInterest(args ndn.InterestHandlerArgs) {
+	if !s.running.Load() {
+		return
+	}
+
+	name := args.Interest.Name()
+	if len(name) <= len(s.prefix) {
+		return
+	}
+
+	// Remaining segments of a previous reply
+	if wire, _ := s.store.Get(name, false); wire != nil {
+		args.Reply(enc.Wire{wire})
+		return
+	}
+
+	// /<group>/<IBLT>/<count>, /<group>/hello or /<group>/sync/<BF>/<IBLT>
+	suffix := name[len(s.prefix):]
+	switch {
+	case suffix[0].IsGeneric("hello") && len(suffix) == 1:
+		s.onHelloInterest(args)
+	case suffix[0].IsGeneric("sync") && len(suffix) == 3:
+		bloom, err := parsePsyncBloom(suffix[1])
+		if err != nil {
+			log.Debug(s, "Invalid bloom filter in Sync Interest", "err", err)
+			return
+		}
+		s.onSyncInterest(args, suffix[2], bloom)
+	case len(suffix) == 2:
+		s.onSyncInterest(args, suffix[0], nil)
+	default:
+		log.Debug(s, "Unknown PSync Interest", "name", name)
+	}
+}
+
+// onHelloInterest replies with the latest names of all prefixes.
+func (s *PSyncFull) onHelloInterest(args ndn.InterestHandlerArgs) {
+	s.mutex.Lock()
+	state := s.encodeState(nil)
+	ibf := s.iblt.component()
+	s.mutex.Unlock()
+
+	s.sendSyncData(args.Interest.Name().Append(ibf), state, args.Reply)
+}
+
+// onSyncInterest replies with the names the remote party is missing,
+// or stores the Interest until there is new data.
+func (s *PSyncFull) onSyncInterest(args ndn.InterestHandlerArgs, ibfComp enc.Component, bloom *psyncBloom) {
+	remote, err := parsePsyncIblt(ibfComp.Val, s.o.ExpectedEntries)
+	if err != nil {
+		log.Debug(s, "Invalid IBLT in Sync Interest", "err", err)
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	name := args.Interest.Name()
+	if state := s.diffState(remote, bloom); len(state.Content) > 0 {
+		go s.sendSyncData(name.Append(s.iblt.component()), state, args.Reply)
+		return
+	}
+
+	// Nothing new, wait for new data until the Interest expires
+	lifetime := args.Interest.Lifetime().GetOr(4 * time.Second)
+	deadline := time.Now().Add(lifetime)
+	if !args.Deadline.IsZero() {
+		deadline = args.Deadline
+	}
+	s.pending[name.TlvStr()] = &psyncPending{
+		name:     name,
+		iblt:     remote,
+		bloom:    bloom,
+		reply:    args.Reply,
+		deadline: deadline,
+	}
+}
+
+// satisfyPending answers all pending Sync Interests that can be satisfied (requires lock).
+func (s *PSyncFull) satisfyPending() {
+	now := time.Now()
+	ibf := s.iblt.component()
+
+	for hash, entry := range s.pending {
+		if now.After(entry.deadline) {
+			delete(s.pending, hash)
+			continue
+		}
+
+		if state := s.diffState(entry.iblt, entry.bloom); len(state.Content) > 0 {
+			delete(s.pending, hash)
+			go s.sendSyncData(entry.name.Append(ibf), state, entry.reply)
+		}
+	}
+}
+
+// sendSyncData produces the compressed state as a new version of the object name,
+// and replies with the first segment. The other segments are served from the store.
+func (s *PSyncFull) sendSyncData(name enc.Name, state *spec_psync.State, reply ndn.WireReplyFunc) {
+	signer := s.o.Client.SuggestSigner(name)
+	if signer == nil {
+		log.Error(s, "PSyncFull failed to find valid signer", "name", name)
+		return
+	}
+
+	content := psyncCompress((&spec_psync.SyncData{State: state}).Encode())
+	objName := name.WithVersion(enc.VersionUnixMicro)
+	lastSeg := max(len(content)-1, 0) / psyncSegmentSize
+
+	dataCfg := &ndn.DataConfig{
+		ContentType:  optional.Some(ndn.ContentTypeBlob),
+		Freshness:    optional.Some(s.o.SyncReplyFreshness),
+		FinalBlockID: optional.Some(enc.NewSegmentComponent(uint64(lastSeg))),
+	}
+
+	var segment enc.Wire
+	for seg := 0; seg <= lastSeg; seg++ {
+		segName := objName.Append(enc.NewSegmentComponent(uint64(seg)))
+		segContent := content[seg*psyncSegmentSize : min((seg+1)*psyncSegmentSize, len(content))]
+		data, err := s.o.Client.Engine().Spec().MakeData(segName, dataCfg, enc.Wire{segContent}, signer)
+		if err != nil {
+			log.Error(s, "PSyncFull failed to make sync data", "err", err)
+			return
+		}
+		if seg == 0 {
+			segment = data.Wire
+		}
+		if err := s.store.Put(segName, data.Wire.Join()); err != nil {
+			log.Error(s, "PSyncFull failed to store sync data", "err", err)
+			return
+		}
+	}
+
+	// Evict the oldest reply if we have too many
+	s.replies.Push(objName)
+	if old := s.replies.Pop(); old != nil {
+		s.store.RemovePrefix(old)
+	}
+
+	if err := reply(segment); err != nil {
+		log.Debug(s, "PSyncFull failed to reply sync data", "err", err)
+	}
+}
+
+// sendSyncInterest sends a full Sync Interest with the current IBLT.
+func (s *PSyncFull) sendSyncInterest() {
+	if !s.running.Load() {
+		return
+	}
+
+	s.mutex.Lock()
+	name := s.prefix.
+		Append(s.iblt.component()).
+		Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, uint64(len(s.keyNames))))
+	s.mutex.Unlock()
+
+	psyncExpress(s.o.Client, name, s.o.SyncInterestLifetime, s.o.IgnoreValidity, func(_ enc.Name, state *spec_psync.State) {
+		select {
+		case s.recvState <- state:
+		case <-s.stop:
+		}
+	})
+}
+
+// onSyncState applies the names in received sync data, and delivers the updates.
+func (s *PSyncFull) onSyncState(state *spec_psync.State) {
+	// Deliver the updates after the lock is released.
+	// This function runs on our main goroutine, so updates are ordered.
+	var updates []SvSyncUpdate
+	defer func() {
+		for _, update := range updates {
+			s.o.OnUpdate(update)
+		}
+	}()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, name := range state.Content {
+		prefix, seqNo, ok := psyncSplitName(name)
+		if !ok {
+			continue
+		}
+
+		known := s.prefixes[prefix.TlvStr()]
+		if seqNo <= known {
+			continue
+		}
+
+		s.updateSeqNo(prefix, seqNo)
+		updates = append(updates, SvSyncUpdate{
+			Name: prefix,
+			High: seqNo,
+			Low:  known + 1,
+		})
+	}
+
+	if len(updates) > 0 {
+		// Others may be waiting for what we just learned
+		s.satisfyPending()
+
+		// Send a new Sync Interest with the updated IBLT
+		go s.sendSyncInterest()
+	}
+}
+
+// Generates the interval between Sync Interests, half the Interest lifetime with ±10% jitter.
+func (s *PSyncFull) getSyncInterval() time.Duration {
+	base := s.o.SyncInterestLifetime / 2
+	jitter := base / 10
+	return base - jitter + time.Duration(rand.Int64N(int64(2*jitter)+1))
+}
+
+// psyncExpress sends a Sync Interest and calls the callback with the name of the first
+// segment and the state of validated data. The remaining segments are fetched if the
+// state does not fit in one packet.
+func psyncExpress(
+	client ndn.Client,
+	name enc.Name,
+	lifetime time.Duration,
+	ignoreValidity optional.Optional[bool],
+	callback func(enc.Name, *spec_psync.State),
+) {
+	client.ExpressR(ndn.ExpressRArgs{
+		Name: name,
+		Config: &ndn.InterestConfig{
+			CanBePrefix: true,
+			MustBeFresh: true,
+			Lifetime:    optional.Some(lifetime),
+			Nonce:       utils.ConvertNonce(client.Engine().Timer().Nonce()),
+		},
+		Callback: func(args ndn.ExpressCallbackArgs) {
+			if args.Result != ndn.InterestResultData {
+				return
+			}
+
+			client.ValidateExt(ndn.ValidateExtArgs{
+				Data:           args.Data,
+				SigCovered:     args.SigCovered,
+				IgnoreValidity: ignoreValidity,
+				Callback: func(valid bool, err error) {
+					if !valid || err != nil {
+						log.Warn(nil, "PSync failed to validate sync data", "name", args.Data.Name(), "err", err)
+						return
+					}
+
+					dataName := args.Data.Name()
+					if len(dataName) < 2 || !dataName.At(-1).IsSegment() || !dataName.At(-2).IsVersion() {
+						log.Warn(nil, "PSync sync data is not segmented", "name", dataName)
+						return
+					}
+
+					// Most replies fit in a single segment
+					if last, ok := args.Data.FinalBlockID().Get(); !ok || last.Equal(dataName.At(-1)) {
+						psyncDeliver(dataName, args.Data.Content(), callback)
+						return
+					}
+
+					client.ConsumeExt(ndn.ConsumeExtArgs{
+						Name:           dataName.Prefix(-1),
+						NoMetadata:     true,
+						IgnoreValidity: ignoreValidity,
+						Callback: func(status ndn.ConsumeState) {
+							if err := status.Error(); err != nil {
+								log.Warn(nil, "PSync failed to fetch sync data", "name", dataName, "err", err)
+								return
+							}
+							psyncDeliver(dataName, status.Content(), callback)
+						},
+					})
+				},
+			})
+		},
+	})
+}
+
+// psyncDeliver decompresses and parses the content of sync data, and calls the callback.
+func psyncDeliver(name enc.Name, content enc.Wire, callback func(enc.Name, *spec_psync.State)) {
+	wire, err := psyncDecompress(content.Join(), psyncMaxStateSize)
+	if err != nil {
+		log.Warn(nil, "PSync failed to decompress sync data", "name", name, "err", err)
+		return
+	}
+
+	data, err := spec_psync.ParseSyncData(enc.NewBufferView(wire), false)
+	if err != nil || data.State == nil {
+		log.Warn(nil, "PSync failed to parse sync data", "name", name, "err", err)
+		return
+	}
+
+	callback(name, data.State)
+}
+
+// psyncCompress compresses a wire with zlib, the default compression of PSync.
+func psyncCompress(wire enc.Wire) []byte {
+	out := &bytes.Buffer{}
+	zw := zlib.NewWriter(out)
+	for _, buf := range wire {
+		zw.Write(buf)
+	}
+	zw.Close()
+	return out.Bytes()
+}
+
+// psyncDecompress decompresses zlib data of at most limit bytes.
+func psyncDecompress(wire []byte, limit int) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(wire))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(zr, int64(limit)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > limit {
+		return nil, fmt.Errorf("decompressed size exceeds %d bytes", limit)
+	}
+	return raw, nil
+}
+
+// psyncSplitName splits a PSync name into the prefix and sequence number.
+// The sequence number is a generic component containing a NonNegativeInteger.
+func psyncSplitName(name enc.Name) (enc.Name, uint64, bool) {
+	if len(name) < 2 || name.At(-1).Typ != enc.TypeGenericNameComponent {
+		return nil, 0, false
+	}
+	seqNo, _, err := enc.ParseNat(name.At(-1).Val)
+	if err != nil {
+		return nil, 0, false
+	}
+	return name.Prefix(-1), uint64(seqNo), true
+}
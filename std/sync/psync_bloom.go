@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// psyncBloom is the subscription Bloom filter of a PSync partial consumer.
+type psyncBloom struct {
+	hashes uint32
+	bits   []byte
+}
+
+// newPsyncBloom creates a Bloom filter for capacity entries
+// with the given false positive rate.
+func newPsyncBloom(capacity int, fpr float64) *psyncBloom {
+	capacity = max(capacity, 1)
+	m := math.Ceil(-float64(capacity) * math.Log(fpr) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(capacity) * math.Ln2)
+	return &psyncBloom{
+		hashes: uint32(max(k, 1)),
+		bits:   make([]byte, (int(m)+7)/8),
+	}
+}
+
+// insert adds a name to the filter.
+func (b *psyncBloom) insert(name enc.Name) {
+	size := uint32(len(b.bits) * 8)
+	wire := name.Bytes()
+	for i := uint32(0); i < b.hashes; i++ {
+		idx := murmur3(i, wire) % size
+		b.bits[idx/8] |= 1 << (idx % 8)
+	}
+}
+
+// contains checks if a name may be in the filter.
+func (b *psyncBloom) contains(name enc.Name) bool {
+	size := uint32(len(b.bits) * 8)
+	if size == 0 {
+		return false
+	}
+
+	wire := name.Bytes()
+	for i := uint32(0); i < b.hashes; i++ {
+		idx := murmur3(i, wire) % size
+		if b.bits[idx/8]&(1<<(idx%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// component returns the filter as a name component.
+func (b *psyncBloom) component() enc.Component {
+	buf := make([]byte, 4+len(b.bits))
+	binary.BigEndian.PutUint32(buf, b.hashes)
+	copy(buf[4:], b.bits)
+	return enc.NewGenericBytesComponent(buf)
+}
+
+// parsePsyncBloom decodes a filter from a name component.
+func parsePsyncBloom(comp enc.Component) (*psyncBloom, error) {
+	if len(comp.Val) < 5 {
+		return nil, fmt.Errorf("bloom filter too short")
+	}
+
+	hashes := binary.BigEndian.Uint32(comp.Val)
+	if hashes == 0 || hashes > 32 {
+		return nil, fmt.Errorf("invalid bloom filter hash count: %d", hashes)
+	}
+
+	return &psyncBloom{
+		hashes: hashes,
+		bits:   comp.Val[4:],
+	}, nil
+}
@@ -0,0 +1,215 @@
+package sync
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// number of hash functions of the IBLT
+const psyncIbltHashes = 3
+
+// seed of the IBLT checksum hash and the name hash
+const psyncHashCheckSeed = 11
+
+// size of an encoded IBLT cell
+const psyncIbltCellSize = 12
+
+// psyncIblt is an invertible Bloom lookup table of 32-bit keys.
+// The table is split into one partition per hash function.
+type psyncIblt struct {
+	cells []psyncIbltCell
+}
+
+type psyncIbltCell struct {
+	count    int32
+	keySum   uint32
+	keyCheck uint32
+}
+
+// newPsyncIblt creates an IBLT that can decode about expected differences.
+func newPsyncIblt(expected int) *psyncIblt {
+	size := expected + expected/2
+	if rem := size % psyncIbltHashes; rem != 0 {
+		size += psyncIbltHashes - rem
+	}
+	return &psyncIblt{cells: make([]psyncIbltCell, max(size, psyncIbltHashes))}
+}
+
+// insert adds a key to the table.
+func (t *psyncIblt) insert(key uint32) {
+	t.update(1, key)
+}
+
+// erase removes a key from the table.
+func (t *psyncIblt) erase(key uint32) {
+	t.update(-1, key)
+}
+
+// update adds count to all cells of the key.
+func (t *psyncIblt) update(count int32, key uint32) {
+	part := uint32(len(t.cells) / psyncIbltHashes)
+	check := psyncHashKey(psyncHashCheckSeed, key)
+	for i := uint32(0); i < psyncIbltHashes; i++ {
+		cell := &t.cells[i*part+psyncHashKey(i, key)%part]
+		cell.count += count
+		cell.keySum ^= key
+		cell.keyCheck ^= check
+	}
+}
+
+// sub returns the difference t - o. Both tables must have the same size.
+func (t *psyncIblt) sub(o *psyncIblt) (*psyncIblt, error) {
+	if len(t.cells) != len(o.cells) {
+		return nil, fmt.Errorf("IBLT size mismatch: %d != %d", len(t.cells), len(o.cells))
+	}
+
+	res := &psyncIblt{cells: make([]psyncIbltCell, len(t.cells))}
+	for i := range t.cells {
+		res.cells[i] = psyncIbltCell{
+			count:    t.cells[i].count - o.cells[i].count,
+			keySum:   t.cells[i].keySum ^ o.cells[i].keySum,
+			keyCheck: t.cells[i].keyCheck ^ o.cells[i].keyCheck,
+		}
+	}
+	return res, nil
+}
+
+// listEntries peels the table and returns the keys with positive and
+// negative counts. ok is false if the table could not be fully decoded,
+// in which case the returned lists are partial.
+func (t *psyncIblt) listEntries() (positive []uint32, negative []uint32, ok bool) {
+	peel := &psyncIblt{cells: append([]psyncIbltCell(nil), t.cells...)}
+
+	for {
+		progress := false
+		for i := range peel.cells {
+			cell := peel.cells[i]
+			if !cell.isPure() {
+				continue
+			}
+
+			if cell.count == 1 {
+				positive = append(positive, cell.keySum)
+			} else {
+				negative = append(negative, cell.keySum)
+			}
+			peel.update(-cell.count, cell.keySum)
+			progress = true
+		}
+		if !progress {
+			break
+		}
+	}
+
+	for _, cell := range peel.cells {
+		if cell != (psyncIbltCell{}) {
+			return positive, negative, false
+		}
+	}
+	return positive, negative, true
+}
+
+// isPure checks if the cell contains exactly one key.
+func (c psyncIbltCell) isPure() bool {
+	return (c.count == 1 || c.count == -1) &&
+		psyncHashKey(psyncHashCheckSeed, c.keySum) == c.keyCheck
+}
+
+// encode returns the compressed wire encoding of the table.
+// Each cell is encoded as three little-endian 32-bit integers, as in PSync.
+func (t *psyncIblt) encode() []byte {
+	raw := make([]byte, len(t.cells)*psyncIbltCellSize)
+	for i, cell := range t.cells {
+		buf := raw[i*psyncIbltCellSize:]
+		binary.LittleEndian.PutUint32(buf[0:], uint32(cell.count))
+		binary.LittleEndian.PutUint32(buf[4:], cell.keySum)
+		binary.LittleEndian.PutUint32(buf[8:], cell.keyCheck)
+	}
+	return psyncCompress(enc.Wire{raw})
+}
+
+// component returns the table as a name component.
+func (t *psyncIblt) component() enc.Component {
+	return enc.NewGenericBytesComponent(t.encode())
+}
+
+// parsePsyncIblt decodes a compressed table of the expected size.
+func parsePsyncIblt(wire []byte, expected int) (*psyncIblt, error) {
+	t := newPsyncIblt(expected)
+	raw, err := psyncDecompress(wire, len(t.cells)*psyncIbltCellSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != len(t.cells)*psyncIbltCellSize {
+		return nil, fmt.Errorf("IBLT size mismatch: %d bytes", len(raw))
+	}
+
+	for i := range t.cells {
+		buf := raw[i*psyncIbltCellSize:]
+		t.cells[i] = psyncIbltCell{
+			count:    int32(binary.LittleEndian.Uint32(buf[0:])),
+			keySum:   binary.LittleEndian.Uint32(buf[4:]),
+			keyCheck: binary.LittleEndian.Uint32(buf[8:]),
+		}
+	}
+	return t, nil
+}
+
+// psyncHashName hashes a name to an IBLT key.
+// PSync hashes the TLV-VALUE of the name, without the outer type and length.
+func psyncHashName(name enc.Name) uint32 {
+	return murmur3(psyncHashCheckSeed, name.BytesInner())
+}
+
+// psyncHashKey hashes an IBLT key with a seed.
+func psyncHashKey(seed uint32, key uint32) uint32 {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], key)
+	return murmur3(seed, buf[:])
+}
+
+// murmur3 computes the 32-bit MurmurHash3 of data.
+func murmur3(seed uint32, data []byte) uint32 {
+	const c1, c2 = 0xcc9e2d51, 0x1b873593
+
+	h := seed
+	nblocks := len(data) / 4
+	for i := range nblocks {
+		k := binary.LittleEndian.Uint32(data[i*4:])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	tail := data[nblocks*4:]
+	k := uint32(0)
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
@@ -0,0 +1,151 @@
+package sync
+
+import (
+	"slices"
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests MurmurHash3 against reference vectors.
+func TestMurmur3(t *testing.T) {
+	tu.SetT(t)
+
+	require.Equal(t, uint32(0), murmur3(0, []byte{}))
+	require.Equal(t, uint32(0x514e28b7), murmur3(1, []byte{}))
+	require.Equal(t, uint32(0x248bfa47), murmur3(0, []byte("hello")))
+	require.Equal(t, uint32(0x2e4ff723), murmur3(0, []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+// Tests the set difference of two IBLTs, and the wire encoding.
+func TestPsyncIblt(t *testing.T) {
+	tu.SetT(t)
+
+	a := newPsyncIblt(40)
+	b := newPsyncIblt(40)
+	require.Equal(t, 0, len(a.cells)%psyncIbltHashes)
+
+	// common keys
+	for i := range 100 {
+		name := tu.NoErr(enc.NameFromStr("/ndn/common")).Append(enc.NewSequenceNumComponent(uint64(i)))
+		a.insert(psyncHashName(name))
+		b.insert(psyncHashName(name))
+	}
+
+	// different keys
+	onlyA := []uint32{
+		psyncHashName(tu.NoErr(enc.NameFromStr("/ndn/alice/seq=1"))),
+		psyncHashName(tu.NoErr(enc.NameFromStr("/ndn/alice/seq=2"))),
+	}
+	onlyB := []uint32{
+		psyncHashName(tu.NoErr(enc.NameFromStr("/ndn/bob/seq=5"))),
+	}
+	for _, k := range onlyA {
+		a.insert(k)
+	}
+	for _, k := range onlyB {
+		b.insert(k)
+	}
+
+	diff, err := a.sub(b)
+	require.NoError(t, err)
+	positive, negative, ok := diff.listEntries()
+	require.True(t, ok)
+	slices.Sort(positive)
+	slices.Sort(onlyA)
+	require.Equal(t, onlyA, positive)
+	require.Equal(t, onlyB, negative)
+
+	// erase makes the tables equal
+	for _, k := range onlyA {
+		a.erase(k)
+	}
+	for _, k := range onlyB {
+		b.erase(k)
+	}
+	diff, _ = a.sub(b)
+	positive, negative, ok = diff.listEntries()
+	require.True(t, ok)
+	require.Empty(t, positive)
+	require.Empty(t, negative)
+
+	// too many differences cannot be decoded
+	for i := range 200 {
+		a.insert(uint32(i * 7919))
+	}
+	diff, _ = a.sub(b)
+	_, _, ok = diff.listEntries()
+	require.False(t, ok)
+
+	// encoding roundtrip
+	c, err := parsePsyncIblt(a.encode(), 40)
+	require.NoError(t, err)
+	require.Equal(t, a.cells, c.cells)
+
+	// size mismatch
+	_, err = parsePsyncIblt(a.encode(), 80)
+	require.Error(t, err)
+	_, err = a.sub(newPsyncIblt(80))
+	require.Error(t, err)
+}
+
+// Tests the wire encoding of names and IBLTs against the PSync library.
+func TestPsyncIbltWire(t *testing.T) {
+	tu.SetT(t)
+
+	// The key of a name is the hash of its TLV-VALUE, and the
+	// sequence number is a generic NonNegativeInteger component
+	name := tu.NoErr(enc.NameFromStr("/a")).Append(enc.NewNumberComponent(enc.TypeGenericNameComponent, 5))
+	require.Equal(t, murmur3(11, []byte{0x08, 0x01, 'a', 0x08, 0x01, 0x05}), psyncHashName(name))
+
+	// Three cells, one per hash function, each with a little-endian
+	// count, key sum and key checksum
+	iblt := newPsyncIblt(2)
+	iblt.insert(0x01020304)
+	check := murmur3(11, []byte{0x04, 0x03, 0x02, 0x01})
+	cell := []byte{
+		0x01, 0x00, 0x00, 0x00,
+		0x04, 0x03, 0x02, 0x01,
+		byte(check), byte(check >> 8), byte(check >> 16), byte(check >> 24),
+	}
+	raw, err := psyncDecompress(iblt.encode(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, slices.Concat(cell, cell, cell), raw)
+
+	// A negative count is encoded in two's complement
+	iblt.erase(0x01020304)
+	iblt.erase(0x01020304)
+	raw, _ = psyncDecompress(iblt.encode(), 1024)
+	require.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, raw[0:4])
+
+	// Tables are exchanged as zlib streams
+	c, err := parsePsyncIblt(psyncCompress(enc.Wire{slices.Concat(cell, cell, cell)}), 2)
+	require.NoError(t, err)
+	positive, negative, ok := c.listEntries()
+	require.True(t, ok)
+	require.Equal(t, []uint32{0x01020304}, positive)
+	require.Empty(t, negative)
+}
+
+// Tests the subscription Bloom filter and its name component encoding.
+func TestPsyncBloom(t *testing.T) {
+	tu.SetT(t)
+
+	bloom := newPsyncBloom(10, 0.001)
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+
+	bloom.insert(alice)
+	require.True(t, bloom.contains(alice))
+	require.False(t, bloom.contains(bob))
+
+	parsed, err := parsePsyncBloom(bloom.component())
+	require.NoError(t, err)
+	require.True(t, parsed.contains(alice))
+	require.False(t, parsed.contains(bob))
+
+	_, err = parsePsyncBloom(enc.NewGenericComponent("x"))
+	require.Error(t, err)
+}
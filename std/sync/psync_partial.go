@@ -0,0 +1,317 @@
+package sync
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec_psync "github.com/named-data/ndnd/std/ndn/psync"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// PSyncPartial is a PSync partial-sync consumer.
+//
+// The consumer subscribes to a subset of the prefixes published in a PSync
+// group. The subscriptions are sent to the producers as a Bloom filter,
+// and only updates for the subscribed prefixes are received.
+//
+// The Interests are named as in the PSync library, but the Bloom filter
+// is encoded in one component, so only PSyncFull producers are supported.
+type PSyncPartial struct {
+	o PSyncPartialOpts
+
+	running atomic.Bool
+	stopped atomic.Bool
+	stop    chan struct{}
+
+	mutex sync.Mutex
+	// latest sequence number of each subscribed prefix
+	subs map[string]uint64
+	// names of subscribed prefixes
+	subNames map[string]enc.Name
+	// subscription filter
+	bloom *psyncBloom
+	// last IBLT received from a producer
+	ibf enc.Component
+	// hello data was received
+	hello bool
+
+	// routable prefix of all sync Interests
+	prefix enc.Name
+
+	// channel for incoming hello and sync data
+	recvHello chan psyncPartialRecv
+	recvState chan psyncPartialRecv
+}
+
+type PSyncPartialOpts struct {
+	// NDN Object API client
+	Client ndn.Client
+	// Sync group prefix for the PSync group
+	GroupPrefix enc.Name
+	// Callback for PSync updates of subscribed prefixes. The Boot field is always zero.
+	OnUpdate func(SvSyncUpdate)
+	// Callback with the latest state of all prefixes in the group (optional)
+	// This is called on start, and can be used to choose subscriptions.
+	OnHello func([]SvSyncUpdate)
+
+	// Expected number of subscriptions for the Bloom filter (default 80)
+	ExpectedSubscriptions int
+	// False positive rate of the Bloom filter (default 0.001)
+	FalsePositiveRate float64
+	// Expected number of differences of the producers (default 80)
+	// This must be the same as the producers in the group.
+	ExpectedEntries int
+	// Lifetime of Sync Interests (default 1s)
+	SyncInterestLifetime time.Duration
+
+	// IgnoreValidity ignores validity period in the validation chain
+	IgnoreValidity optional.Optional[bool]
+}
+
+type psyncPartialRecv struct {
+	name  enc.Name
+	state *spec_psync.State
+}
+
+// NewPSyncPartial creates a new PSync partial-sync consumer.
+func NewPSyncPartial(opts PSyncPartialOpts) *PSyncPartial {
+	// Check required options
+	if opts.Client == nil {
+		panic("PSyncPartial: Client is required")
+	}
+	if len(opts.GroupPrefix) == 0 {
+		panic("PSyncPartial: GroupPrefix is required")
+	}
+	if opts.OnUpdate == nil {
+		panic("PSyncPartial: OnUpdate is required")
+	}
+
+	// Set default options
+	if opts.ExpectedSubscriptions == 0 {
+		opts.ExpectedSubscriptions = 80
+	}
+	if opts.FalsePositiveRate == 0 {
+		opts.FalsePositiveRate = 0.001
+	}
+	if opts.ExpectedEntries == 0 {
+		opts.ExpectedEntries = 80
+	}
+	if opts.SyncInterestLifetime == 0 {
+		opts.SyncInterestLifetime = 1 * time.Second
+	}
+
+	return &PSyncPartial{
+		o: opts,
+
+		running: atomic.Bool{},
+		stop:    make(chan struct{}),
+
+		mutex:    sync.Mutex{},
+		subs:     make(map[string]uint64),
+		subNames: make(map[string]enc.Name),
+		bloom:    newPsyncBloom(opts.ExpectedSubscriptions, opts.FalsePositiveRate),
+		ibf:      newPsyncIblt(opts.ExpectedEntries).component(),
+
+		prefix: opts.GroupPrefix,
+
+		recvHello: make(chan psyncPartialRecv, 4),
+		recvState: make(chan psyncPartialRecv, 128),
+	}
+}
+
+// Instance log identifier
+func (s *PSyncPartial) String() string {
+	return fmt.Sprintf("psync-partial (%s)", s.o.GroupPrefix)
+}
+
+// Start the PSync consumer.
+func (s *PSyncPartial) Start() error {
+	go s.main()
+	return nil
+}
+
+// Sends the hello Interest and processes incoming data until the consumer is stopped.
+func (s *PSyncPartial) main() {
+	s.running.Store(true)
+	defer s.running.Store(false)
+
+	go s.sendHelloInterest()
+
+	// Retry the Sync Interest if no data is received
+	ticker := time.NewTicker(s.o.SyncInterestLifetime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case recv := <-s.recvHello:
+			s.onHelloState(recv)
+		case recv := <-s.recvState:
+			s.onSyncState(recv)
+			ticker.Reset(s.o.SyncInterestLifetime)
+		case <-ticker.C:
+			s.mutex.Lock()
+			hello := s.hello
+			s.mutex.Unlock()
+
+			if !hello {
+				go s.sendHelloInterest()
+			} else {
+				go s.sendSyncInterest()
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Stop the PSync consumer.
+func (s *PSyncPartial) Stop() error {
+	if !s.stopped.Swap(true) {
+		close(s.stop)
+	}
+	return nil
+}
+
+// Subscribe adds a prefix to the subscriptions.
+// Updates are delivered for sequence numbers greater than seqNo.
+func (s *PSyncPartial) Subscribe(prefix enc.Name, seqNo uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	hash := prefix.TlvStr()
+	s.subs[hash] = seqNo
+	s.subNames[hash] = prefix.Clone()
+	s.bloom.insert(prefix)
+
+	go s.sendSyncInterest()
+}
+
+// Unsubscribe removes a prefix from the subscriptions.
+func (s *PSyncPartial) Unsubscribe(prefix enc.Name) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	hash := prefix.TlvStr()
+	delete(s.subs, hash)
+	delete(s.subNames, hash)
+
+	// Bloom filters do not support removal
+	s.bloom = newPsyncBloom(s.o.ExpectedSubscriptions, s.o.FalsePositiveRate)
+	for _, name := range s.subNames {
+		s.bloom.insert(name)
+	}
+}
+
+// GetSeqNo returns the sequence number for a subscribed prefix.
+func (s *PSyncPartial) GetSeqNo(prefix enc.Name) uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.subs[prefix.TlvStr()]
+}
+
+// sendHelloInterest asks a producer for the state of all prefixes.
+func (s *PSyncPartial) sendHelloInterest() {
+	if !s.running.Load() {
+		return
+	}
+
+	name := s.prefix.Append(enc.NewGenericComponent("hello"))
+	psyncExpress(s.o.Client, name, s.o.SyncInterestLifetime, s.o.IgnoreValidity, func(name enc.Name, state *spec_psync.State) {
+		select {
+		case s.recvHello <- psyncPartialRecv{name: name, state: state}:
+		case <-s.stop:
+		}
+	})
+}
+
+// sendSyncInterest sends a Sync Interest with the subscriptions and the last known IBLT.
+func (s *PSyncPartial) sendSyncInterest() {
+	if !s.running.Load() {
+		return
+	}
+
+	s.mutex.Lock()
+	if len(s.subs) == 0 {
+		s.mutex.Unlock()
+		return
+	}
+	name := s.prefix.
+		Append(enc.NewGenericComponent("sync")).
+		Append(s.bloom.component()).
+		Append(s.ibf)
+	s.mutex.Unlock()
+
+	psyncExpress(s.o.Client, name, s.o.SyncInterestLifetime, s.o.IgnoreValidity, func(name enc.Name, state *spec_psync.State) {
+		select {
+		case s.recvState <- psyncPartialRecv{name: name, state: state}:
+		case <-s.stop:
+		}
+	})
+}
+
+// onHelloState delivers the state of all prefixes to the application.
+func (s *PSyncPartial) onHelloState(recv psyncPartialRecv) {
+	// The IBLT of the producer precedes the version and segment of the data name
+	s.mutex.Lock()
+	s.ibf = recv.name.At(-3)
+	s.hello = true
+	s.mutex.Unlock()
+
+	if s.o.OnHello != nil {
+		hello := make([]SvSyncUpdate, 0, len(recv.state.Content))
+		for _, name := range recv.state.Content {
+			if prefix, seqNo, ok := psyncSplitName(name); ok {
+				hello = append(hello, SvSyncUpdate{Name: prefix, High: seqNo})
+			}
+		}
+		s.o.OnHello(hello)
+	}
+
+	// Start syncing with the subscriptions
+	go s.sendSyncInterest()
+}
+
+// onSyncState applies the names in received sync data, and delivers the updates.
+func (s *PSyncPartial) onSyncState(recv psyncPartialRecv) {
+	// Deliver the updates after the lock is released.
+	var updates []SvSyncUpdate
+	defer func() {
+		for _, update := range updates {
+			s.o.OnUpdate(update)
+		}
+	}()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// The IBLT of the producer precedes the version and segment of the data name
+	s.ibf = recv.name.At(-3)
+
+	for _, name := range recv.state.Content {
+		prefix, seqNo, ok := psyncSplitName(name)
+		if !ok {
+			continue
+		}
+
+		hash := prefix.TlvStr()
+		known, ok := s.subs[hash]
+		if !ok || seqNo <= known {
+			continue // false positive or old
+		}
+
+		s.subs[hash] = seqNo
+		updates = append(updates, SvSyncUpdate{
+			Name: prefix,
+			High: seqNo,
+			Low:  known + 1,
+		})
+	}
+
+	// Immediately wait for the next update
+	go s.sendSyncInterest()
+}
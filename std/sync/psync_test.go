@@ -0,0 +1,300 @@
+package sync
+
+import (
+	"crypto/rand"
+	gosync "sync"
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/named-data/ndnd/std/types/optional"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates two clients on dummy faces that deliver every packet sent by one to the other.
+func psyncTestLink(t *testing.T) (ndn.Client, ndn.Client) {
+	faceA, faceB := face.NewDummyFace(), face.NewDummyFace()
+	clients := make([]ndn.Client, 0, 2)
+	for _, f := range []*face.DummyFace{faceA, faceB} {
+		app := engine.NewBasicEngine(f)
+		require.NoError(t, app.Start())
+		client := object.NewClient(app, storage.NewMemoryStore(), nil)
+		require.NoError(t, client.Start())
+		clients = append(clients, client)
+	}
+
+	done := make(chan struct{})
+	pump := func(from, to *face.DummyFace) {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if pkt, err := from.Consume(); err == nil {
+				to.FeedPacket(pkt)
+			}
+		}
+	}
+	go pump(faceA, faceB)
+	go pump(faceB, faceA)
+
+	t.Cleanup(func() {
+		close(done)
+		for _, client := range clients {
+			client.Stop()
+			client.Engine().Stop()
+		}
+	})
+	return clients[0], clients[1]
+}
+
+// psyncUpdates collects updates delivered by a PSync instance.
+type psyncUpdates struct {
+	mutex   gosync.Mutex
+	updates []SvSyncUpdate
+}
+
+func (u *psyncUpdates) add(update SvSyncUpdate) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.updates = append(u.updates, update)
+}
+
+func (u *psyncUpdates) get() []SvSyncUpdate {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return append([]SvSyncUpdate(nil), u.updates...)
+}
+
+// Tests that two full-sync participants converge to the same state.
+func TestPSyncFullConvergence(t *testing.T) {
+	tu.SetT(t)
+
+	clientA, clientB := psyncTestLink(t)
+	group := tu.NoErr(enc.NameFromStr("/ndn/psync/full"))
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+
+	var updatesA, updatesB psyncUpdates
+	syncA := NewPSyncFull(PSyncOpts{
+		Client:               clientA,
+		GroupPrefix:          group,
+		OnUpdate:             updatesA.add,
+		SyncInterestLifetime: 400 * time.Millisecond,
+	})
+	syncB := NewPSyncFull(PSyncOpts{
+		Client:               clientB,
+		GroupPrefix:          group,
+		OnUpdate:             updatesB.add,
+		SyncInterestLifetime: 400 * time.Millisecond,
+	})
+	require.NoError(t, syncA.Start())
+	require.NoError(t, syncB.Start())
+
+	// Each side publishes its own prefix
+	syncA.IncrSeqNo(alice)
+	syncA.IncrSeqNo(alice)
+	syncA.IncrSeqNo(alice)
+	syncB.IncrSeqNo(bob)
+
+	require.Eventually(t, func() bool {
+		return syncB.GetSeqNo(alice) == 3 && syncA.GetSeqNo(bob) == 1
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// Updates cover all missing sequence numbers
+	require.Eventually(t, func() bool {
+		high := uint64(0)
+		for _, u := range updatesB.get() {
+			require.True(t, u.Name.Equal(alice))
+			require.Equal(t, high+1, u.Low)
+			high = u.High
+		}
+		return high == 3
+	}, time.Second, 20*time.Millisecond)
+	require.Equal(t, []SvSyncUpdate{{Name: bob, Low: 1, High: 1}}, updatesA.get())
+
+	// Later updates are delivered without a new participant
+	require.NoError(t, syncB.SetSeqNo(bob, 5))
+	require.Eventually(t, func() bool {
+		return syncA.GetSeqNo(bob) == 5
+	}, 5*time.Second, 20*time.Millisecond)
+	require.Len(t, syncA.GetNames(), 2)
+	require.Len(t, syncB.State().Content, 2)
+
+	// Stop never blocks, even when called again
+	stopped := make(chan struct{})
+	go func() {
+		syncA.Stop()
+		syncA.Stop()
+		syncB.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		require.FailNow(t, "Stop blocked")
+	}
+}
+
+// Tests that a partial-sync consumer receives only its subscriptions.
+func TestPSyncPartialConvergence(t *testing.T) {
+	tu.SetT(t)
+
+	clientA, clientB := psyncTestLink(t)
+	group := tu.NoErr(enc.NameFromStr("/ndn/psync/partial"))
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+
+	producer := NewPSyncFull(PSyncOpts{
+		Client:               clientA,
+		GroupPrefix:          group,
+		OnUpdate:             func(SvSyncUpdate) {},
+		SyncInterestLifetime: 400 * time.Millisecond,
+	})
+	require.NoError(t, producer.Start())
+	t.Cleanup(func() { producer.Stop() })
+	producer.SetSeqNo(alice, 2)
+	producer.SetSeqNo(bob, 7)
+
+	var updates psyncUpdates
+	hello := make(chan []SvSyncUpdate, 1)
+	consumer := NewPSyncPartial(PSyncPartialOpts{
+		Client:               clientB,
+		GroupPrefix:          group,
+		OnUpdate:             updates.add,
+		OnHello:              func(h []SvSyncUpdate) { hello <- h },
+		SyncInterestLifetime: 400 * time.Millisecond,
+	})
+	require.NoError(t, consumer.Start())
+	t.Cleanup(func() { consumer.Stop() })
+
+	// Hello carries the state of all prefixes
+	var state []SvSyncUpdate
+	select {
+	case state = <-hello:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no hello data")
+	}
+	require.ElementsMatch(t, []SvSyncUpdate{{Name: alice, High: 2}, {Name: bob, High: 7}}, state)
+
+	// Only the subscribed prefix is synchronized
+	consumer.Subscribe(alice, 2)
+	producer.IncrSeqNo(alice)
+	producer.IncrSeqNo(bob)
+	require.Eventually(t, func() bool {
+		return consumer.GetSeqNo(alice) == 3
+	}, 5*time.Second, 20*time.Millisecond)
+
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, []SvSyncUpdate{{Name: alice, Low: 3, High: 3}}, updates.get())
+	require.Equal(t, uint64(0), consumer.GetSeqNo(bob))
+}
+
+// Tests that a Sync Interest in the format of the PSync library is answered
+// with segmented data containing the compressed State TLV.
+func TestPSyncFullWire(t *testing.T) {
+	tu.SetT(t)
+
+	clientA, clientB := psyncTestLink(t)
+	group := tu.NoErr(enc.NameFromStr("/localhop/ndn/nlsr/sync/v=12"))
+
+	producer := NewPSyncFull(PSyncOpts{
+		Client:          clientA,
+		GroupPrefix:     group,
+		OnUpdate:        func(SvSyncUpdate) {},
+		ExpectedEntries: 2,
+	})
+	require.NoError(t, producer.Start())
+	t.Cleanup(func() { producer.Stop() })
+	require.NoError(t, producer.SetSeqNo(tu.NoErr(enc.NameFromStr("/a")), 5))
+
+	// /<group>/<empty IBLT>/<count>
+	ibf := psyncCompress(enc.Wire{make([]byte, 3*psyncIbltCellSize)})
+	interest := group.
+		Append(enc.NewGenericBytesComponent(ibf)).
+		Append(enc.NewGenericBytesComponent([]byte{0x00}))
+
+	reply := make(chan ndn.Data, 1)
+	clientB.ExpressR(ndn.ExpressRArgs{
+		Name: interest,
+		Config: &ndn.InterestConfig{
+			CanBePrefix: true,
+			MustBeFresh: true,
+			Lifetime:    optional.Some(time.Second),
+		},
+		Callback: func(args ndn.ExpressCallbackArgs) {
+			if args.Result == ndn.InterestResultData {
+				reply <- args.Data
+			}
+		},
+	})
+
+	var data ndn.Data
+	select {
+	case data = <-reply:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no sync data")
+	}
+
+	// /<sync interest>/<IBLT>/<version>/<segment>
+	name := data.Name()
+	require.Len(t, name, len(interest)+3)
+	require.True(t, interest.IsPrefix(name))
+	require.True(t, name.At(-2).IsVersion())
+	require.Equal(t, enc.NewSegmentComponent(0), name.At(-1))
+	require.Equal(t, enc.NewSegmentComponent(0), data.FinalBlockID().Unwrap())
+
+	iblt := newPsyncIblt(2)
+	iblt.insert(murmur3(11, []byte{0x08, 0x01, 'a', 0x08, 0x01, 0x05}))
+	remote, err := parsePsyncIblt(name.At(-3).Val, 2)
+	require.NoError(t, err)
+	require.Equal(t, iblt.cells, remote.cells)
+
+	// State (128) containing /a/%05
+	content, err := psyncDecompress(data.Content().Join(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x80, 0x08, 0x07, 0x06, 0x08, 0x01, 'a', 0x08, 0x01, 0x05}, content)
+}
+
+// Tests that a state larger than one packet is fetched in segments.
+func TestPSyncFullSegments(t *testing.T) {
+	tu.SetT(t)
+
+	clientA, clientB := psyncTestLink(t)
+	group := tu.NoErr(enc.NameFromStr("/ndn/psync/segments"))
+
+	syncA := NewPSyncFull(PSyncOpts{
+		Client:      clientA,
+		GroupPrefix: group,
+		OnUpdate:    func(SvSyncUpdate) {},
+	})
+	require.NoError(t, syncA.Start())
+	t.Cleanup(func() { syncA.Stop() })
+
+	// Random names cannot be compressed into one packet
+	for range 2000 {
+		id := make([]byte, 16)
+		rand.Read(id)
+		syncA.IncrSeqNo(enc.Name{enc.NewGenericComponent("ndn"), enc.NewGenericBytesComponent(id)})
+	}
+	require.Greater(t, len(psyncCompress(syncA.State().Encode())), 2*8000)
+
+	syncB := NewPSyncFull(PSyncOpts{
+		Client:      clientB,
+		GroupPrefix: group,
+		OnUpdate:    func(SvSyncUpdate) {},
+	})
+	require.NoError(t, syncB.Start())
+	t.Cleanup(func() { syncB.Stop() })
+
+	require.Eventually(t, func() bool {
+		return len(syncB.GetNames()) == 2000
+	}, 5*time.Second, 20*time.Millisecond)
+}
@@ -1,62 +1,104 @@
 package sync
 
-import enc "github.com/named-data/ndnd/std/encoding"
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/types/optional"
+)
 
+// Snapshot is the snapshot strategy of SvsALO.
+//
+// A strategy decides when to take and publish a snapshot of the local state,
+// and when to fetch and apply a snapshot of a remote node instead of fetching
+// individual publications. Applications can implement this interface to
+// define their own snapshot format, e.g. a serialized state machine.
+//
+// All hooks are called with the SvsALO lock held, and must not block.
+// Network operations must be asynchronous, and the result must be applied
+// with SnapshotHandle.Apply.
 type Snapshot interface {
 	// Snapshot returns the Snapshot trait.
 	Snapshot() Snapshot
 
-	// initialize the strategy, and set up ps state.
-	initialize(snapPsState, SvMap[svsDataState])
+	// Initialize the strategy, called once when the SvsALO instance is created.
+	// The state contains the initial state vector of the instance.
+	Initialize(handle SnapshotHandle, state SvMap[SvsDataState])
 
-	// onUpdate is called when the state vector is updated for a different node.
-	// The strategy can decide to block fetching for the snapshot.
+	// OnUpdate is called when the state vector is updated for a different node.
+	// The strategy can decide to fetch a snapshot and block fetching of
+	// publications by setting SnapBlock on the state entry.
 	// This function may also be called for this node name with a different boot time.
-	onUpdate(state SvMap[svsDataState], node enc.Name)
+	OnUpdate(state SvMap[SvsDataState], node enc.Name)
 
-	// onPublication is called when the state for this node is updated (for this boot).
-	// The strategy can decide to take a snapshot.
+	// OnPublication is called when the state for this node is updated (for this boot).
+	// The strategy can decide to take and publish a snapshot.
 	// The name of the new publication is passed to the strategy, so old publications
 	// can be evicted if needed.
-	onPublication(state SvMap[svsDataState], pub enc.Name)
+	OnPublication(state SvMap[SvsDataState], pub enc.Name)
 }
 
-// snapPsState is the shared data struct between snapshot strategy
+// SnapshotHandle is the interface between a snapshot strategy
 // and the SVS data fetching layer.
-type snapPsState struct {
-	// nodePrefix is the name of the current nodePrefix.
-	nodePrefix enc.Name
-	// groupPrefix is the name of the sync groupPrefix.
-	groupPrefix enc.Name
-	// bootTime is the boot time of the node.
-	bootTime uint64
-
-	// onSnap is the callback for snapshot received from a remote party.
-	// The snapshot strategy should call the inner function when
-	// a snapshot is received.
-	//
-	// The callback provides a function to update the state vector,
-	// and return the snapshot publication. When updating the state vector,
-	// make sure to only update the following fields. Updating Pending is
-	// required, otherwise the fetcher will break.
-	//
-	//   - SnapBlock - to unblock fetching for the node
-	//   - Known - set to max(Known, SnapSeq)
-	//   - Pending - set to max(Pending, Known)
-	//
-	// The name of the snapshot publication must either be a node name
-	// when a single node is affected, or empty to indicate the entire group
-	// has been updated (i.e. one or more nodes).
-	//
-	// Only Publisher, Content and DataName fields in the pub are required.
-	// Other fields are informational and the application can ignore them.
-	//
-	// Even if the callback returns an error, the Publication field should
-	// be appropriately set. This will trigger a re-fetch for the producers.
-	//
-	onSnap func(callback snapRecvCallback)
+type SnapshotHandle struct {
+	// NodePrefix is the name of this node.
+	NodePrefix enc.Name
+	// GroupPrefix is the name of the sync group.
+	GroupPrefix enc.Name
+	// BootTime is the boot time of this node.
+	BootTime uint64
+	// Client is the object client of the SvsALO instance.
+	Client ndn.Client
+	// IgnoreValidity is inherited from the SVS options.
+	IgnoreValidity optional.Optional[bool]
+
+	// apply is the callback into SvsALO.
+	apply func(callback SnapRecvCallback)
 }
 
-// snapRecvCallback is the callback function passed to the onReceive callback.
+// SnapRecvCallback is the callback function passed to SnapshotHandle.Apply.
 // This callback should update the state if needed (lock is held by the caller).
-type snapRecvCallback = func(state SvMap[svsDataState]) (SvsPub, error)
+type SnapRecvCallback = func(state SvMap[SvsDataState]) (SvsPub, error)
+
+// Apply delivers a snapshot received from a remote party.
+// The strategy should call this function when a snapshot is fetched.
+//
+// The callback is provided the state vector to update, and must return
+// the snapshot publication. When updating the state vector, make sure
+// to only update the following fields. Updating Pending is required,
+// otherwise the fetcher will break.
+//
+//   - SnapBlock - to unblock fetching for the node
+//   - Known - set to max(Known, SnapSeq)
+//   - Pending - set to max(Pending, Known)
+//
+// The name of the snapshot publication must either be a node name
+// when a single node is affected, or empty to indicate the entire group
+// has been updated (i.e. one or more nodes).
+//
+// Only Publisher, Content and DataName fields in the pub are required.
+// Other fields are informational and the application can ignore them.
+// A publication with nil Content is not delivered to the application.
+//
+// Even if the callback returns an error, the Publication field should
+// be appropriately set. This will trigger a re-fetch for the producers.
+func (h SnapshotHandle) Apply(callback SnapRecvCallback) {
+	h.apply(callback)
+}
+
+// PubPrefix returns the name prefix of all publications of a node instance.
+func (h SnapshotHandle) PubPrefix(node enc.Name, boot uint64) enc.Name {
+	return h.GroupPrefix.
+		Append(node...).
+		Append(enc.NewTimestampComponent(boot))
+}
+
+// Prune evicts publications of this node from 0 to seqNo (inclusive)
+// from the local store, e.g. after they are covered by a snapshot.
+func (h SnapshotHandle) Prune(seqNo uint64) error {
+	// No version specified - this will remove metadata too
+	return h.Client.Store().RemoveFlatRange(
+		h.PubPrefix(h.NodePrefix, h.BootTime),
+		enc.NewSequenceNumComponent(0),
+		enc.NewSequenceNumComponent(seqNo),
+	)
+}
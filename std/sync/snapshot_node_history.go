@@ -49,8 +49,8 @@ type SnapshotNodeHistory struct {
 	// repoKnown is the known snapshot sequence number.
 	repoKnown SvMap[uint64]
 
-	// pss is the struct from the svs layer.
-	pss snapPsState
+	// handle is the interface to the svs layer.
+	handle SnapshotHandle
 	// prevSeq is my last snapshot sequence number.
 	prevSeq uint64
 }
@@ -65,12 +65,18 @@ func (s *SnapshotNodeHistory) Snapshot() Snapshot {
 	return s
 }
 
-// initialize the snapshot strategy.
-func (s *SnapshotNodeHistory) initialize(pss snapPsState, state SvMap[svsDataState]) {
+// Initialize the snapshot strategy.
+func (s *SnapshotNodeHistory) Initialize(handle SnapshotHandle, state SvMap[SvsDataState]) {
+	if s.Client == nil {
+		s.Client = handle.Client
+	}
 	if s.Client == nil || s.Threshold == 0 {
-		panic("SnapshotNodeLatest: not initialized")
+		panic("SnapshotNodeHistory: not initialized")
 	}
-	s.pss = pss
+	s.handle = handle
+
+	// inherit from SVS (incorrect but practical)
+	s.IgnoreValidity = handle.IgnoreValidity
 
 	// Load repo known state for repo mode
 	if s.IsRepo {
@@ -88,8 +94,8 @@ func (s *SnapshotNodeHistory) initialize(pss snapPsState, state SvMap[svsDataSta
 	}
 }
 
-// onUpdate determines if a snapshot should be fetched.
-func (s *SnapshotNodeHistory) onUpdate(state SvMap[svsDataState], node enc.Name) {
+// OnUpdate determines if a snapshot should be fetched.
+func (s *SnapshotNodeHistory) OnUpdate(state SvMap[SvsDataState], node enc.Name) {
 	nodeHash := node.TlvStr()
 	entries := state[nodeHash]
 
@@ -102,7 +108,7 @@ func (s *SnapshotNodeHistory) onUpdate(state SvMap[svsDataState], node enc.Name)
 		}
 
 		// Skip this instance, what's the point?
-		if node.Equal(s.pss.nodePrefix) && boot == s.pss.bootTime {
+		if node.Equal(s.handle.NodePrefix) && boot == s.handle.BootTime {
 			continue
 		}
 
@@ -129,10 +135,10 @@ func (s *SnapshotNodeHistory) onUpdate(state SvMap[svsDataState], node enc.Name)
 	}
 }
 
-// onPublication is called when the state for this node is updated.
-func (s *SnapshotNodeHistory) onPublication(state SvMap[svsDataState], pub enc.Name) {
+// OnPublication is called when the state for this node is updated.
+func (s *SnapshotNodeHistory) OnPublication(state SvMap[SvsDataState], pub enc.Name) {
 	// Get the sequence number
-	entry := state.Get(s.pss.nodePrefix.TlvStr(), s.pss.bootTime)
+	entry := state.Get(s.handle.NodePrefix.TlvStr(), s.handle.BootTime)
 	seqNo := entry.Known
 
 	// Check if I should take a snapshot
@@ -145,17 +151,13 @@ func (s *SnapshotNodeHistory) onPublication(state SvMap[svsDataState], pub enc.N
 
 // snapName is the naming convention for snapshots.
 func (s *SnapshotNodeHistory) snapName(node enc.Name, boot uint64) enc.Name {
-	return s.pss.groupPrefix.
-		Append(node...).
-		Append(enc.NewTimestampComponent(boot)).
+	return s.handle.PubPrefix(node, boot).
 		Append(enc.NewKeywordComponent("HIST"))
 }
 
 // Constructs a unique name component for a node's history index by appending the node name, a timestamp derived from the boot time, and the "HIDX" keyword to a group prefix.
 func (s *SnapshotNodeHistory) idxName(node enc.Name, boot uint64) enc.Name {
-	return s.pss.groupPrefix.
-		Append(node...).
-		Append(enc.NewTimestampComponent(boot)).
+	return s.handle.PubPrefix(node, boot).
 		Append(enc.NewKeywordComponent("HIDX"))
 }
 
@@ -177,7 +179,7 @@ func (s *SnapshotNodeHistory) handleIndex(node enc.Name, boot uint64, known uint
 	onError := func(err error) {
 		time.Sleep(2 * time.Second) // we are in a different goroutine
 
-		s.pss.onSnap(func(state SvMap[svsDataState]) (SvsPub, error) {
+		s.handle.Apply(func(state SvMap[SvsDataState]) (SvsPub, error) {
 			entry := state.Get(hash, boot)
 			entry.SnapBlock = 0
 			state.Set(hash, boot, entry)
@@ -235,7 +237,7 @@ func (s *SnapshotNodeHistory) handleIndex(node enc.Name, boot uint64, known uint
 				return
 			}
 
-			s.pss.onSnap(func(state SvMap[svsDataState]) (SvsPub, error) {
+			s.handle.Apply(func(state SvMap[SvsDataState]) (SvsPub, error) {
 				// do not use onError in the callback (blocking sleep)
 				entry := state.Get(hash, boot)
 
@@ -285,7 +287,7 @@ func (s *SnapshotNodeHistory) handleIndex(node enc.Name, boot uint64, known uint
 	time.Sleep(SnapHistoryIndexFreshness)
 
 	// Reset snap block flag
-	s.pss.onSnap(func(state SvMap[svsDataState]) (SvsPub, error) {
+	s.handle.Apply(func(state SvMap[SvsDataState]) (SvsPub, error) {
 		entry := state.Get(hash, boot)
 		entry.SnapBlock = 0
 		state.Set(hash, boot, entry)
@@ -322,9 +324,7 @@ func (s *SnapshotNodeHistory) takeSnap(seqNo uint64) {
 	}
 
 	// Add all publications since the last snapshot
-	pubBasename := s.pss.groupPrefix.
-		Append(s.pss.nodePrefix...).
-		Append(enc.NewTimestampComponent(s.pss.bootTime))
+	pubBasename := s.handle.PubPrefix(s.handle.NodePrefix, s.handle.BootTime)
 	for i := s.prevSeq + 1; i <= seqNo; i++ {
 		pubName := pubBasename.
 			Append(enc.NewSequenceNumComponent(i)).
@@ -346,7 +346,7 @@ func (s *SnapshotNodeHistory) takeSnap(seqNo uint64) {
 	}
 
 	// Publish snapshot into our store
-	snapName := s.snapName(s.pss.nodePrefix, s.pss.bootTime).
+	snapName := s.snapName(s.handle.NodePrefix, s.handle.BootTime).
 		WithVersion(seqNo)
 	_, err = s.Client.Produce(ndn.ProduceArgs{
 		Name:    snapName,
@@ -360,7 +360,7 @@ func (s *SnapshotNodeHistory) takeSnap(seqNo uint64) {
 	// Write new index
 	indexWire := index.Encode()
 	_, err = s.Client.Produce(ndn.ProduceArgs{
-		Name: s.idxName(s.pss.nodePrefix, s.pss.bootTime).
+		Name: s.idxName(s.handle.NodePrefix, s.handle.BootTime).
 			WithVersion(seqNo),
 		Content:         indexWire,
 		FreshnessPeriod: SnapHistoryIndexFreshness,
@@ -384,12 +384,7 @@ func (s *SnapshotNodeHistory) takeSnap(seqNo uint64) {
 	// Evict publications more than 3 snapshots old
 	if len(index.SeqNos) > 4 {
 		evictLast := index.SeqNos[len(index.SeqNos)-3]
-		err = s.Client.Store().RemoveFlatRange(
-			pubBasename,
-			enc.NewSequenceNumComponent(0),
-			enc.NewSequenceNumComponent(evictLast),
-		)
-		if err != nil {
+		if err = s.handle.Prune(evictLast); err != nil {
 			log.Warn(s, "Failed to evict old publications", "err", err)
 		}
 	}
@@ -397,7 +392,7 @@ func (s *SnapshotNodeHistory) takeSnap(seqNo uint64) {
 
 // Retrieves the latest local history index name and parsed index data for the node's snapshot history.
 func (s *SnapshotNodeHistory) getIndex() (enc.Name, *svs_ps.HistoryIndex, error) {
-	idxName := s.idxName(s.pss.nodePrefix, s.pss.bootTime)
+	idxName := s.idxName(s.handle.NodePrefix, s.handle.BootTime)
 
 	prevIdxName, err := s.Client.LatestLocal(idxName)
 	if err != nil {
@@ -36,8 +36,8 @@ type SnapshotNodeLatest struct {
 	// IgnoreValidity ignores validity period in the validation chain
 	IgnoreValidity optional.Optional[bool]
 
-	// pss is the struct from the svs layer.
-	pss snapPsState
+	// handle is the interface to the svs layer.
+	handle SnapshotHandle
 	// prevSeq is my last snapshot sequence number.
 	prevSeq uint64
 }
@@ -52,16 +52,22 @@ func (s *SnapshotNodeLatest) Snapshot() Snapshot {
 	return s
 }
 
-// initialize the snapshot strategy.
-func (s *SnapshotNodeLatest) initialize(pss snapPsState, _ SvMap[svsDataState]) {
+// Initialize the snapshot strategy.
+func (s *SnapshotNodeLatest) Initialize(handle SnapshotHandle, _ SvMap[SvsDataState]) {
+	if s.Client == nil {
+		s.Client = handle.Client
+	}
 	if s.Client == nil || s.SnapMe == nil || s.Threshold == 0 {
 		panic("SnapshotNodeLatest: not initialized")
 	}
-	s.pss = pss
+	s.handle = handle
+
+	// inherit from SVS (incorrect but practical)
+	s.IgnoreValidity = handle.IgnoreValidity
 }
 
-// onUpdate determines if a snapshot should be fetched.
-func (s *SnapshotNodeLatest) onUpdate(state SvMap[svsDataState], node enc.Name) {
+// OnUpdate determines if a snapshot should be fetched.
+func (s *SnapshotNodeLatest) OnUpdate(state SvMap[SvsDataState], node enc.Name) {
 	// We only care about the latest boot.
 	// For all other states, make sure the fetch is skipped.
 	entries := state[node.TlvStr()]
@@ -93,10 +99,10 @@ func (s *SnapshotNodeLatest) onUpdate(state SvMap[svsDataState], node enc.Name)
 	}
 }
 
-// onPublication is called when the state for this node is updated.
-func (s *SnapshotNodeLatest) onPublication(state SvMap[svsDataState], pub enc.Name) {
+// OnPublication is called when the state for this node is updated.
+func (s *SnapshotNodeLatest) OnPublication(state SvMap[SvsDataState], pub enc.Name) {
 	// This strategy only cares about the latest boot.
-	entry := state.Get(s.pss.nodePrefix.TlvStr(), s.pss.bootTime)
+	entry := state.Get(s.handle.NodePrefix.TlvStr(), s.handle.BootTime)
 	seqNo := entry.Known
 
 	// Check if I should take a snapshot
@@ -109,9 +115,7 @@ func (s *SnapshotNodeLatest) onPublication(state SvMap[svsDataState], pub enc.Na
 
 // snapName is the naming convention for snapshots.
 func (s *SnapshotNodeLatest) snapName(node enc.Name, boot uint64) enc.Name {
-	return s.pss.groupPrefix.
-		Append(node...).
-		Append(enc.NewTimestampComponent(boot)).
+	return s.handle.PubPrefix(node, boot).
 		Append(enc.NewKeywordComponent("SNAP"))
 }
 
@@ -136,7 +140,7 @@ func (s *SnapshotNodeLatest) fetchSnap(node enc.Name, boot uint64) {
 
 // handleSnap processes the fetched snapshot.
 func (s *SnapshotNodeLatest) handleSnap(node enc.Name, boot uint64, cstate ndn.ConsumeState) {
-	s.pss.onSnap(func(state SvMap[svsDataState]) (pub SvsPub, err error) {
+	s.handle.Apply(func(state SvMap[SvsDataState]) (pub SvsPub, err error) {
 		hash := node.TlvStr()
 		entry := state.Get(hash, boot)
 
@@ -182,7 +186,7 @@ func (s *SnapshotNodeLatest) handleSnap(node enc.Name, boot uint64, cstate ndn.C
 
 // takeSnap takes a snapshot of the application state for the current node.
 func (s *SnapshotNodeLatest) takeSnap(seqNo uint64) {
-	basename := s.snapName(s.pss.nodePrefix, s.pss.bootTime)
+	basename := s.snapName(s.handle.NodePrefix, s.handle.BootTime)
 	name := basename.WithVersion(seqNo)
 
 	// Request snapshot from application
@@ -220,14 +224,7 @@ func (s *SnapshotNodeLatest) takeSnap(seqNo uint64) {
 
 	// Evict covered publications from 0 to seqNo-3*threshold
 	if seqNo >= 4*s.Threshold {
-		// No version specified - this will remove metadata too
-		if err := s.Client.Store().RemoveFlatRange(
-			s.pss.groupPrefix.
-				Append(s.pss.nodePrefix...).
-				Append(enc.NewTimestampComponent(s.pss.bootTime)),
-			enc.NewSequenceNumComponent(0),
-			enc.NewSequenceNumComponent(seqNo-3*s.Threshold),
-		); err != nil {
+		if err := s.handle.Prune(seqNo - 3*s.Threshold); err != nil {
 			log.Warn(s, "Failed to evict old publications from store", "err", err)
 		}
 	}
@@ -0,0 +1,145 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// countSnapshot is an application-defined snapshot strategy.
+// It prunes its own publications every two publications, and blocks
+// fetching of remote nodes until the test applies a snapshot.
+type countSnapshot struct {
+	handle  SnapshotHandle
+	initial []string
+	pubs    []enc.Name
+	updates []enc.Name
+}
+
+func (s *countSnapshot) Snapshot() Snapshot {
+	return s
+}
+
+func (s *countSnapshot) Initialize(handle SnapshotHandle, state SvMap[SvsDataState]) {
+	s.handle = handle
+	for name := range state.Iter() {
+		s.initial = append(s.initial, name.String())
+	}
+}
+
+func (s *countSnapshot) OnUpdate(state SvMap[SvsDataState], node enc.Name) {
+	s.updates = append(s.updates, node)
+	hash := node.TlvStr()
+	for _, entry := range state[hash] {
+		if entry.Value.Known == 0 {
+			entry.Value.SnapBlock = 1
+			state.Set(hash, entry.Boot, entry.Value)
+		}
+	}
+}
+
+func (s *countSnapshot) OnPublication(state SvMap[SvsDataState], pub enc.Name) {
+	s.pubs = append(s.pubs, pub)
+	if len(s.pubs)%2 == 0 {
+		if err := s.handle.Prune(uint64(len(s.pubs))); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Tests an application snapshot strategy driven by SvsALO.
+func TestSnapshotCustom(t *testing.T) {
+	tu.SetT(t)
+
+	client := object.NewClient(engine.NewBasicEngine(face.NewDummyFace()), storage.NewMemoryStore(), nil)
+	group := tu.NoErr(enc.NameFromStr("/ndn/group"))
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+
+	snap := &countSnapshot{}
+	alo := tu.NoErr(NewSvsALO(SvsAloOpts{
+		Name:     alice,
+		Snapshot: snap,
+		Svs: SvSyncOpts{
+			Client:      client,
+			GroupPrefix: group,
+			BootTime:    1000,
+		},
+	}))
+
+	// The strategy is initialized with the handle and the local state
+	require.Equal(t, alice, snap.handle.NodePrefix)
+	require.Equal(t, group, snap.handle.GroupPrefix)
+	require.Equal(t, uint64(1000), snap.handle.BootTime)
+	require.Equal(t, []string{alice.String()}, snap.initial)
+
+	// Every publication is passed to the strategy, which prunes them
+	for range 3 {
+		_, _, err := alo.Publish(enc.Wire{[]byte("hello")})
+		require.NoError(t, err)
+	}
+	require.Len(t, snap.pubs, 3)
+	stored := func(seq uint64) bool {
+		name := snap.handle.PubPrefix(alice, 1000).Append(enc.NewSequenceNumComponent(seq))
+		wire, _ := client.Store().Get(name, true)
+		return wire != nil
+	}
+	require.False(t, stored(1))
+	require.False(t, stored(2))
+	require.True(t, stored(3))
+
+	// Updates of subscribed nodes are passed to the strategy,
+	// which blocks fetching until a snapshot is applied
+	delivered := 0
+	require.NoError(t, alo.SubscribePublisher(bob, func(SvsPub) { delivered++ }))
+	alo.onSvsUpdate(SvSyncUpdate{Name: bob, Boot: 100, High: 5})
+	require.Equal(t, []enc.Name{bob}, snap.updates)
+	entry := alo.state.Get(bob.TlvStr(), 100)
+	require.Equal(t, uint64(5), entry.Latest)
+	require.Equal(t, uint64(0), entry.Pending)
+	require.Equal(t, 1, entry.SnapBlock)
+
+	// A failed snapshot is reported to the application
+	snapErr := errors.New("snapshot failed")
+	snap.handle.Apply(func(SvMap[SvsDataState]) (SvsPub, error) {
+		return SvsPub{Publisher: bob}, snapErr
+	})
+	require.Equal(t, snapErr, <-alo.errpipe)
+
+	// The applied snapshot unblocks the node and is delivered
+	snap.handle.Apply(func(state SvMap[SvsDataState]) (SvsPub, error) {
+		entry := state.Get(bob.TlvStr(), 100)
+		entry.SnapBlock = 0
+		entry.Known = 5
+		entry.Pending = max(entry.Pending, entry.Known)
+		state.Set(bob.TlvStr(), 100, entry)
+		return SvsPub{
+			Publisher:  bob,
+			Content:    enc.Wire{[]byte("snapshot")},
+			DataName:   snap.handle.PubPrefix(bob, 100),
+			IsSnapshot: true,
+		}, nil
+	})
+	pub := <-alo.outpipe
+	require.True(t, pub.IsSnapshot)
+	require.Equal(t, []byte("snapshot"), pub.Bytes())
+	require.NotNil(t, pub.State)
+	require.Len(t, pub.subcribers, 1)
+	pub.subcribers[0](pub)
+	require.Equal(t, 1, delivered)
+
+	// Nothing is left to fetch after the snapshot, and
+	// every applied snapshot checks the node with the strategy again
+	entry = alo.state.Get(bob.TlvStr(), 100)
+	require.Equal(t, 0, entry.SnapBlock)
+	require.Equal(t, uint64(5), entry.Known)
+	require.Equal(t, uint64(5), entry.Pending)
+	require.Len(t, snap.updates, 3)
+}
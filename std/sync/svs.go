@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	rand "math/rand/v2"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,6 +30,10 @@ type SvSync struct {
 	mtime  map[string]time.Time
 	prefix enc.Name
 
+	// Pruning state
+	pruned SvMap[svSyncPruned]
+	owned  map[string]bool
+
 	// Suppression state
 	suppress bool
 	merge    SvMap[uint64]
@@ -40,6 +45,10 @@ type SvSync struct {
 	// Channel for incoming state vectors
 	recvSv chan svSyncRecvSvArgs
 
+	// Counters for rejected updates
+	rejectedEntries atomic.Uint64
+	rejectedVectors atomic.Uint64
+
 	// cancellation for face hook
 	faceCancel func()
 }
@@ -67,6 +76,35 @@ type SvSyncOpts struct {
 	// Suppression period for ignoring outdated Sync Interests (default 200ms)
 	SuppressionPeriod time.Duration
 
+	// Timeout after which names with no updates are removed from the
+	// state vector (default 0, disabled). Names owned by this instance
+	// are never removed. This should be longer than PeriodicTimeout,
+	// since pruning nodes consider a state vector missing names updated
+	// within PeriodicTimeout to be outdated. Nodes that do not prune consider
+	// a state vector missing any of their names outdated, so pruning should
+	// be enabled on all nodes of the group.
+	PruneTimeout time.Duration
+	// Maximum number of boot times kept for each name (default 0, unlimited).
+	// Older boot times are removed from the state vector, and are not
+	// considered missing from the state vectors of other nodes.
+	MaxBootTimes int
+	// Callback when a new name joins the state vector (optional)
+	OnJoin func(enc.Name)
+	// Callback when a name is removed after PruneTimeout (optional)
+	OnLeave func(enc.Name)
+
+	// Trust schema to validate state vector entries (optional)
+	// If set, the name of each entry must be allowed to be signed by the
+	// key that signed the Sync Data, so that only the owner of a name
+	// can advance its sequence number. Other entries are still compared
+	// with the local state vector to detect outdated state.
+	TrustSchema ndn.TrustSchema
+	// RejectInvalidEntries drops the entire state vector if any entry that
+	// fails the TrustSchema check would advance the local state vector.
+	// By default, only these entries are ignored and the rest of the
+	// state vector is applied.
+	RejectInvalidEntries bool
+
 	// Passive mode does not send sign Sync Interests
 	Passive bool
 	// IgnoreValidity ignores validity period in the validation chain
@@ -80,9 +118,27 @@ type SvSyncUpdate struct {
 	Low  uint64
 }
 
+// svSyncPruned remembers the last sequence number of a removed entry,
+// so the entry is not delivered again if other nodes still advertise it.
+type svSyncPruned struct {
+	seqNo uint64
+	seen  time.Time
+}
+
+// SvSyncStats are the counters of an SV Sync instance.
+type SvSyncStats struct {
+	// Number of state vector entries that were not applied because
+	// they failed the TrustSchema check
+	RejectedEntries uint64
+	// Number of state vectors that were dropped due to invalid entries
+	RejectedVectors uint64
+}
+
 type svSyncRecvSvArgs struct {
 	sv   *spec_svs.StateVector
 	data enc.Wire
+	// names the signer is not allowed to advance
+	untrusted map[string]bool
 }
 
 // NewSvSync creates a new SV Sync instance.
@@ -100,12 +156,15 @@ func NewSvSync(opts SvSyncOpts) *SvSync {
 
 	// Use initial state if provided
 	initialState := NewSvMap[uint64](0)
+	mtime := make(map[string]time.Time)
 	if opts.InitialState != nil {
+		now := time.Now()
 		for _, node := range opts.InitialState.Entries {
 			hash := node.Name.TlvStr()
 			for _, entry := range node.SeqNoEntries {
 				initialState.Set(hash, entry.BootstrapTime, entry.SeqNo)
 			}
+			mtime[hash] = now
 		}
 	}
 
@@ -122,6 +181,9 @@ func NewSvSync(opts SvSyncOpts) *SvSync {
 	if len(opts.SyncDataName) == 0 {
 		opts.SyncDataName = opts.GroupPrefix
 	}
+	// Sync Interests are sent concurrently, and appending to a name
+	// with spare capacity would write to the same buffer
+	opts.SyncDataName = slices.Clip(opts.SyncDataName)
 
 	return &SvSync{
 		o: opts,
@@ -132,8 +194,11 @@ func NewSvSync(opts SvSyncOpts) *SvSync {
 
 		mutex:  sync.Mutex{},
 		state:  initialState,
-		mtime:  make(map[string]time.Time),
-		prefix: opts.GroupPrefix.Append(enc.NewVersionComponent(3)),
+		mtime:  mtime,
+		prefix: slices.Clip(opts.GroupPrefix.Append(enc.NewVersionComponent(3))),
+
+		pruned: NewSvMap[svSyncPruned](0),
+		owned:  make(map[string]bool),
 
 		suppress: false,
 		merge:    NewSvMap[uint64](0),
@@ -191,12 +256,22 @@ func (s *SvSync) main() {
 		go s.sendSyncInterest()
 	}
 
+	// Remove silent names periodically
+	var pruneC <-chan time.Time
+	if s.o.PruneTimeout > 0 {
+		pruneTicker := time.NewTicker(s.o.PruneTimeout / 4)
+		defer pruneTicker.Stop()
+		pruneC = pruneTicker.C
+	}
+
 	for {
 		select {
 		case <-s.ticker.C:
 			s.timerExpired()
 		case sv := <-s.recvSv:
 			s.onReceiveStateVector(sv)
+		case <-pruneC:
+			s.prune(time.Now())
 		case <-s.stop:
 			return
 		}
@@ -240,6 +315,7 @@ func (s *SvSync) SetSeqNo(name enc.Name, seqNo uint64) error {
 	// [Spec] When the node generates a new publication,
 	// immediately emit a Sync Interest
 	s.state.Set(hash, s.o.BootTime, seqNo)
+	s.owned[hash] = true
 	go s.sendSyncInterest()
 
 	return nil
@@ -259,6 +335,7 @@ func (s *SvSync) IncrSeqNo(name enc.Name) uint64 {
 	entry := s.state.Get(hash, s.o.BootTime)
 	entry++
 	s.state.Set(hash, s.o.BootTime, entry)
+	s.owned[hash] = true
 
 	// [Spec] When the node generates a new publication,
 	// immediately emit a Sync Interest
@@ -285,6 +362,14 @@ func (s *SvSync) GetNames() []enc.Name {
 	return names
 }
 
+// Stats returns the counters of the SV Sync instance.
+func (s *SvSync) Stats() SvSyncStats {
+	return SvSyncStats{
+		RejectedEntries: s.rejectedEntries.Load(),
+		RejectedVectors: s.rejectedVectors.Load(),
+	}
+}
+
 // Handles the reception of a state vector by updating local synchronization state, suppressing redundant updates based on time thresholds, and triggering application callbacks for new or modified data entries while managing suppression states and passive wire buffering.
 func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 	// Deliver the updates after this call is done
@@ -292,7 +377,13 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 	// This function, in turn, runs on our main goroutine, so
 	// that ensures the updates are delivered in order.
 	var updates []SvSyncUpdate
+	var joins []enc.Name
 	defer func() {
+		if s.o.OnJoin != nil {
+			for _, name := range joins {
+				s.o.OnJoin(name)
+			}
+		}
 		for _, update := range updates {
 			s.o.OnUpdate(update)
 		}
@@ -301,6 +392,14 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	// Drop the entire state vector if an entry the signer is not
+	// allowed to advance is newer than the local state
+	if s.o.RejectInvalidEntries && s.advancesUntrusted(args) {
+		s.rejectedVectors.Add(1)
+		log.Warn(s, "Dropping state vector with invalid entries")
+		return
+	}
+
 	isOutdated := false
 	canDrop := true
 	recvSv := NewSvMap[uint64](len(args.sv.Entries))
@@ -308,6 +407,7 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 
 	for _, node := range args.sv.Entries {
 		hash := node.Name.TlvStr()
+		_, isMember := s.state[hash]
 
 		// Walk through the state vector entries in reverse order.
 		// The ordering is important so we deliver the newest boot time first.
@@ -324,7 +424,26 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 
 			// Get existing state vector entry
 			known := s.state.Get(hash, entry.BootstrapTime)
-			if entry.SeqNo > known {
+
+			// Other nodes may still advertise entries that we removed.
+			// Ignore these unless there is a newer sequence number.
+			if pruned := s.pruned.Get(hash, entry.BootstrapTime); pruned.seqNo > 0 {
+				if entry.SeqNo <= pruned.seqNo {
+					pruned.seen = now
+					s.pruned.Set(hash, entry.BootstrapTime, pruned)
+					continue
+				}
+				known = pruned.seqNo
+			}
+
+			if entry.SeqNo > known && args.untrusted[hash] {
+				// The signer is not allowed to advance this name.
+				// The owner will send its own update.
+				s.rejectedEntries.Add(1)
+				log.Debug(s, "Rejected state vector entry", "name", node.Name)
+			} else if entry.SeqNo > known {
+				s.pruned.Remove(hash, entry.BootstrapTime)
+
 				// [Spec] If the incoming state vector is newer,
 				// update the local state vector.
 				s.state.Set(hash, entry.BootstrapTime, entry.SeqNo)
@@ -362,15 +481,22 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 			}
 
 			// [Passive] Buffer the incoming wire
-			if s.o.Passive && entry.SeqNo >= known {
+			if s.o.Passive && entry.SeqNo >= known && !args.untrusted[hash] {
 				s.passiveWiresSv.Set(hash, entry.BootstrapTime, args.data)
 			}
 		}
+
+		if !isMember && len(s.state[hash]) > 0 {
+			joins = append(joins, node.Name)
+		}
+
+		// Remove old boot times
+		s.pruneBootTimes(hash, now)
 	}
 
 	// The above checks each node in the incoming state vector, but
 	// does not check if a node is missing from the incoming state vector.
-	if !isOutdated && s.state.IsNewerThan(recvSv, func(_, _ uint64) bool { return false }) {
+	if !isOutdated && s.hasMissingEntries(recvSv, now) {
 		isOutdated = true
 		canDrop = false
 	}
@@ -400,6 +526,100 @@ func (s *SvSync) onReceiveStateVector(args svSyncRecvSvArgs) {
 	s.ticker.Reset(s.getSuppressionTimeout())
 }
 
+// hasMissingEntries checks if the local state vector has entries that are
+// missing from the received state vector. If this instance prunes its state
+// vector, entries that the sender may have pruned are not counted, since the
+// sender would otherwise be considered outdated on every Sync Interest:
+// with PruneTimeout, these are names of other nodes with no updates for
+// PeriodicTimeout (or PruneTimeout, if longer), and with MaxBootTimes, boot
+// times older than a boot time the sender has for the same name. Such entries
+// are still sent to nodes missing them with the next periodic Sync Interest.
+// Call with mutex locked
+func (s *SvSync) hasMissingEntries(recvSv SvMap[uint64], now time.Time) bool {
+	silent := max(s.o.PeriodicTimeout, s.o.PruneTimeout)
+
+	for hash, entries := range s.state {
+		if s.o.PruneTimeout > 0 && !s.owned[hash] && now.Sub(s.mtime[hash]) >= silent {
+			continue
+		}
+
+		others := recvSv[hash]
+		for _, entry := range entries {
+			if slices.ContainsFunc(others, func(o SvMapVal[uint64]) bool { return o.Boot == entry.Boot }) {
+				continue
+			}
+			if s.o.MaxBootTimes > 0 && !s.owned[hash] && len(others) > 0 && others[len(others)-1].Boot > entry.Boot {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// prune removes names with no updates for PruneTimeout from the state vector.
+func (s *SvSync) prune(now time.Time) {
+	var leaves []enc.Name
+	defer func() {
+		if s.o.OnLeave != nil {
+			for _, name := range leaves {
+				s.o.OnLeave(name)
+			}
+		}
+	}()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for name, entries := range s.state.Iter() {
+		hash := name.TlvStr()
+		if s.owned[hash] || now.Sub(s.mtime[hash]) < s.o.PruneTimeout {
+			continue
+		}
+
+		for _, entry := range entries {
+			s.pruned.Set(hash, entry.Boot, svSyncPruned{seqNo: entry.Value, seen: now})
+		}
+		delete(s.state, hash)
+		delete(s.mtime, hash)
+		delete(s.passiveWiresSv, hash)
+		leaves = append(leaves, name)
+	}
+
+	// Forget removed entries that are no longer advertised by anyone
+	for hash, entries := range s.pruned {
+		entries = slices.DeleteFunc(entries, func(entry SvMapVal[svSyncPruned]) bool {
+			return now.Sub(entry.Value.seen) >= s.o.PruneTimeout
+		})
+		if len(entries) == 0 {
+			delete(s.pruned, hash)
+		} else {
+			s.pruned[hash] = entries
+		}
+	}
+
+	if len(leaves) > 0 {
+		log.Info(s, "Pruned silent names from state vector", "count", len(leaves))
+	}
+}
+
+// Call with mutex locked
+func (s *SvSync) pruneBootTimes(hash string, now time.Time) {
+	if s.o.MaxBootTimes <= 0 || s.owned[hash] {
+		return
+	}
+
+	entries := s.state[hash]
+	for len(entries) > s.o.MaxBootTimes {
+		// Entries are sorted by boot time, the oldest is first
+		oldest := entries[0]
+		s.pruned.Set(hash, oldest.Boot, svSyncPruned{seqNo: oldest.Value, seen: now})
+		s.passiveWiresSv.Remove(hash, oldest.Boot)
+		s.state.Remove(hash, oldest.Boot)
+		entries = s.state[hash]
+	}
+}
+
 // Handles timer expiration by checking if the local state vector is inconsistent with the merged state vector; if so, triggers a synchronization by sending a Sync Interest, otherwise transitions to a steady state to avoid redundant synchronization.
 func (s *SvSync) timerExpired() {
 	s.mutex.Lock()
@@ -544,11 +764,52 @@ func (s *SvSync) onSyncData(dataWire enc.Wire) {
 			s.recvSv <- svSyncRecvSvArgs{
 				sv:   params.StateVector,
 				data: dataWire,
+				// Check the owner of each entry
+				untrusted: s.checkEntries(params.StateVector, data.Signature().KeyName()),
 			}
 		},
 	})
 }
 
+// checkEntries returns the names in the state vector that the signer
+// is not allowed to advance according to the TrustSchema.
+func (s *SvSync) checkEntries(sv *spec_svs.StateVector, signer enc.Name) map[string]bool {
+	if s.o.TrustSchema == nil {
+		return nil
+	}
+
+	var untrusted map[string]bool
+	for _, entry := range sv.Entries {
+		if s.o.TrustSchema.Check(entry.Name, signer) {
+			continue
+		}
+		if untrusted == nil {
+			untrusted = make(map[string]bool)
+		}
+		untrusted[entry.Name.TlvStr()] = true
+	}
+	return untrusted
+}
+
+// advancesUntrusted checks if an entry the signer is not allowed to
+// advance is newer than the local state vector.
+// Call with mutex locked
+func (s *SvSync) advancesUntrusted(args svSyncRecvSvArgs) bool {
+	for _, node := range args.sv.Entries {
+		hash := node.Name.TlvStr()
+		if !args.untrusted[hash] {
+			continue
+		}
+		for _, entry := range node.SeqNoEntries {
+			known := max(s.state.Get(hash, entry.BootstrapTime), s.pruned.Get(hash, entry.BootstrapTime).seqNo)
+			if entry.SeqNo > known {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Call with mutex locked
 func (s *SvSync) enterSteadyState() {
 	s.suppress = false
@@ -24,7 +24,7 @@ type SvsALO struct {
 	mutex gosync.Mutex
 
 	// state is the current state.
-	state SvMap[svsDataState]
+	state SvMap[SvsDataState]
 	// nodePs is the Pub/Sub coordinator for publisher prefixes
 	nodePs SimplePs[SvsPub]
 
@@ -75,7 +75,7 @@ func NewSvsALO(opts SvsAloOpts) (*SvsALO, error) {
 
 		mutex: gosync.Mutex{},
 
-		state:  NewSvMap[svsDataState](0),
+		state:  NewSvMap[SvsDataState](0),
 		nodePs: NewSimplePs[SvsPub](),
 
 		outpipe:  make(chan SvsPub, 256),
@@ -122,7 +122,7 @@ func NewSvsALO(opts SvsAloOpts) (*SvsALO, error) {
 	// Initialize the state vector with our own state.
 	// If initial state is provided, this should be equal.
 	seqNo := s.svs.GetSeqNo(s.opts.Name)
-	s.state.Set(s.opts.Name.TlvStr(), s.BootTime(), svsDataState{
+	s.state.Set(s.opts.Name.TlvStr(), s.BootTime(), SvsDataState{
 		Known:   seqNo,
 		Latest:  seqNo,
 		Pending: seqNo,
@@ -132,22 +132,16 @@ func NewSvsALO(opts SvsAloOpts) (*SvsALO, error) {
 	if s.opts.Snapshot == nil {
 		s.opts.Snapshot = &SnapshotNull{}
 	} else {
-		s.opts.Snapshot.initialize(snapPsState{
-			nodePrefix:  s.opts.Name,
-			groupPrefix: s.GroupPrefix(),
-			bootTime:    s.BootTime(),
-			onSnap:      s.snapRecvCallback,
+		s.opts.Snapshot.Initialize(SnapshotHandle{
+			NodePrefix:     s.opts.Name,
+			GroupPrefix:    s.GroupPrefix(),
+			BootTime:       s.BootTime(),
+			Client:         s.client,
+			IgnoreValidity: s.opts.Svs.IgnoreValidity,
+			apply:          s.snapRecvCallback,
 		}, s.state)
 	}
 
-	// Overeide IgnoreValidity from SVS (incorect but practical)
-	if latest, ok := s.opts.Snapshot.(*SnapshotNodeLatest); ok {
-		latest.IgnoreValidity = s.opts.Svs.IgnoreValidity
-	}
-	if history, ok := s.opts.Snapshot.(*SnapshotNodeHistory); ok {
-		history.IgnoreValidity = s.opts.Svs.IgnoreValidity
-	}
-
 	return s, nil
 }
 
@@ -183,6 +177,15 @@ func (s *SvsALO) SeqNo() uint64 {
 	return s.svs.GetSeqNo(s.opts.Name)
 }
 
+// InstanceState returns the current state of the instance.
+// The state can be used as the InitialState to restore the instance.
+func (s *SvsALO) InstanceState() enc.Wire {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.instanceState()
+}
+
 // SVS returns the underlying SVS instance.
 func (s *SvsALO) SVS() *SvSync {
 	return s.svs
@@ -8,7 +8,8 @@ import (
 	"github.com/named-data/ndnd/std/ndn"
 )
 
-type svsDataState struct {
+// SvsDataState is the fetching state of a single node instance in SvsALO.
+type SvsDataState struct {
 	// Known is the fetched sequence number.
 	// Data is handed off to outgoing pipe.
 	Known uint64
@@ -18,8 +19,8 @@ type svsDataState struct {
 	// Pending is the pending sequence number.
 	// (Pending-Known) has outstanding Interests.
 	Pending uint64
-	// PendingPubs is the fetched data not yet delivered.
-	PendingPubs map[uint64]SvsPub
+	// pendingPubs is the fetched data not yet delivered.
+	pendingPubs map[uint64]SvsPub
 	// SnapBlock is the snapshot block flag.
 	// If non-zero, fetching is blocked.
 	SnapBlock int
@@ -53,14 +54,14 @@ func (s *SvsALO) produceObject(content enc.Wire) (enc.Name, enc.Wire, error) {
 
 	// We don't get notified of changes to our own state.
 	// So we need to update the state vector ourselves.
-	s.state.Set(node.TlvStr(), boot, svsDataState{
+	s.state.Set(node.TlvStr(), boot, SvsDataState{
 		Known:   seq,
 		Latest:  seq,
 		Pending: seq,
 	})
 
 	// Notify the snapshot strategy
-	s.opts.Snapshot.onPublication(s.state, name)
+	s.opts.Snapshot.OnPublication(s.state, name)
 
 	// Update the state vector
 	if got := s.svs.IncrSeqNo(node); got != seq {
@@ -77,7 +78,7 @@ func (s *SvsALO) consumeCheck(node enc.Name) {
 	}
 
 	// Check with the snapshot strategy
-	s.opts.Snapshot.onUpdate(s.state, node)
+	s.opts.Snapshot.OnUpdate(s.state, node)
 
 	hash := node.TlvStr()
 	totalPending := uint64(0)
@@ -163,14 +164,14 @@ func (s *SvsALO) consumeObject(node enc.Name, boot uint64, seq uint64) {
 			}
 
 			// Initialize the pending map
-			if entry.PendingPubs == nil {
-				entry.PendingPubs = make(map[uint64]SvsPub)
+			if entry.pendingPubs == nil {
+				entry.pendingPubs = make(map[uint64]SvsPub)
 				s.state.Set(hash, boot, entry)
 			}
 
 			// Store the content for in-order delivery
 			// The size of this map is upper bounded
-			entry.PendingPubs[seq] = SvsPub{
+			entry.pendingPubs[seq] = SvsPub{
 				Publisher: node,
 				Content:   status.Content(),
 				DataName:  status.Name(),
@@ -181,14 +182,14 @@ func (s *SvsALO) consumeObject(node enc.Name, boot uint64, seq uint64) {
 			for {
 				// Check if the next seq is available
 				nextSeq := entry.Known + 1
-				pub, ok := entry.PendingPubs[nextSeq]
+				pub, ok := entry.pendingPubs[nextSeq]
 				if !ok {
 					break
 				}
 
 				// Update known state
 				entry.Known = nextSeq
-				delete(entry.PendingPubs, nextSeq)
+				delete(entry.pendingPubs, nextSeq)
 				s.state.Set(hash, boot, entry)
 
 				// Deliver the data to application
@@ -201,7 +202,7 @@ func (s *SvsALO) consumeObject(node enc.Name, boot uint64, seq uint64) {
 
 // snapRecvCallback is called by the snapshot strategy to indicate
 // that a snapshot has been fetched.
-func (s *SvsALO) snapRecvCallback(callback snapRecvCallback) {
+func (s *SvsALO) snapRecvCallback(callback SnapRecvCallback) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
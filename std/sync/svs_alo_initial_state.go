@@ -12,7 +12,7 @@ func (s *SvsALO) instanceState() enc.Wire {
 	state := spec_svsps.InstanceState{
 		Name:          s.opts.Name,
 		BootstrapTime: s.BootTime(),
-		StateVector: s.state.Encode(func(state svsDataState) uint64 {
+		StateVector: s.state.Encode(func(state SvsDataState) uint64 {
 			return state.Known
 		}),
 	}
@@ -37,7 +37,7 @@ func (s *SvsALO) parseInstanceState(wire enc.Wire) error {
 	for _, entry := range initState.StateVector.Entries {
 		hash := entry.Name.TlvStr()
 		for _, seqEntry := range entry.SeqNoEntries {
-			s.state.Set(hash, seqEntry.BootstrapTime, svsDataState{
+			s.state.Set(hash, seqEntry.BootstrapTime, SvsDataState{
 				Known:   seqEntry.SeqNo,
 				Latest:  seqEntry.SeqNo,
 				Pending: seqEntry.SeqNo,
@@ -50,6 +50,22 @@ func (m SvMap[V]) Set(hash string, boot uint64, value V) {
 	m[hash] = slices.Insert(m[hash], i, entry)
 }
 
+// Remove the entry for a bootstrap time.
+// The name is removed if it has no more entries.
+func (m SvMap[V]) Remove(hash string, boot uint64) {
+	var value V
+	entry := SvMapVal[V]{boot, value}
+	i, match := slices.BinarySearchFunc(m[hash], entry, entry.Cmp)
+	if !match {
+		return
+	}
+	if len(m[hash]) == 1 {
+		delete(m, hash)
+		return
+	}
+	m[hash] = slices.Delete(m[hash], i, i+1)
+}
+
 // Clears all entries from the SvMap if it is not nil, preventing panic when the map is nil.
 func (m SvMap[V]) Clear() {
 	if m != nil {
@@ -65,6 +65,30 @@ func TestSvMapSet(t *testing.T) {
 	}
 }
 
+// Tests removing entries of an SvMap by bootstrap time.
+func TestSvMapRemove(t *testing.T) {
+	tu.SetT(t)
+
+	m := makeSvMap()
+
+	// Remove one boot time
+	m.Remove("/ndn/alice", 100)
+	require.Equal(t, uint64(0), m.Get("/ndn/alice", 100))
+	require.Equal(t, uint64(4), m.Get("/ndn/alice", 200))
+	require.Len(t, m["/ndn/alice"], 1)
+
+	// Remove non-existent entries
+	m.Remove("/ndn/alice", 150)
+	m.Remove("/ndn/cathy", 100)
+	require.Len(t, m, 2)
+
+	// Removing the last boot time removes the name
+	m.Remove("/ndn/bob", 150)
+	_, ok := m["/ndn/bob"]
+	require.False(t, ok)
+	require.Len(t, m, 1)
+}
+
 // Tests the `IsNewerThan` method of an SvMap by comparing two version maps under various scenarios involving differing sequence numbers and entry existence, using custom comparison functions to determine ordering and existence criteria.
 func TestSvMapNewer(t *testing.T) {
 	tu.SetT(t)
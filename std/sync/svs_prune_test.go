@@ -0,0 +1,180 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	spec_svs "github.com/named-data/ndnd/std/ndn/svs/v3"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// Tests removing silent names and old boot times from the state vector.
+func TestSvSyncPrune(t *testing.T) {
+	tu.SetT(t)
+
+	var updates []SvSyncUpdate
+	var joins, leaves []string
+
+	client := object.NewClient(engine.NewBasicEngine(face.NewDummyFace()), storage.NewMemoryStore(), nil)
+	svs := NewSvSync(SvSyncOpts{
+		Client:       client,
+		GroupPrefix:  tu.NoErr(enc.NameFromStr("/ndn/group")),
+		OnUpdate:     func(u SvSyncUpdate) { updates = append(updates, u) },
+		OnJoin:       func(n enc.Name) { joins = append(joins, n.String()) },
+		OnLeave:      func(n enc.Name) { leaves = append(leaves, n.String()) },
+		BootTime:     1000,
+		PruneTimeout: 10 * time.Second,
+		MaxBootTimes: 2,
+	})
+
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+	recv := func(name enc.Name, entries ...uint64) {
+		sv := &spec_svs.StateVector{Entries: []*spec_svs.StateVectorEntry{{Name: name}}}
+		for i := 0; i < len(entries); i += 2 {
+			sv.Entries[0].SeqNoEntries = append(sv.Entries[0].SeqNoEntries, &spec_svs.SeqNoEntry{
+				BootstrapTime: entries[i],
+				SeqNo:         entries[i+1],
+			})
+		}
+		svs.onReceiveStateVector(svSyncRecvSvArgs{sv: sv})
+	}
+
+	// New name joins
+	recv(alice, 100, 5)
+	require.Equal(t, []string{"/ndn/alice"}, joins)
+	require.Equal(t, []SvSyncUpdate{{Name: alice, Boot: 100, High: 5, Low: 1}}, updates)
+
+	// Old boot times are collapsed
+	updates = nil
+	recv(alice, 200, 1, 300, 1)
+	require.Len(t, updates, 2)
+	require.Len(t, svs.state[alice.TlvStr()], 2)
+	require.Equal(t, uint64(0), svs.state.Get(alice.TlvStr(), 100))
+
+	// Removed boot time is still advertised by others
+	updates = nil
+	recv(alice, 100, 5)
+	require.Empty(t, updates)
+
+	// Removed boot time has new data
+	recv(alice, 100, 7)
+	require.Equal(t, []SvSyncUpdate{{Name: alice, Boot: 100, High: 7, Low: 6}}, updates)
+	require.Len(t, joins, 1)
+
+	// Owned names are never removed
+	svs.IncrSeqNo(bob)
+
+	// Silent names leave
+	svs.prune(time.Now().Add(11 * time.Second))
+	require.Equal(t, []string{"/ndn/alice"}, leaves)
+	require.Equal(t, []enc.Name{bob}, svs.GetNames())
+
+	// Removed name is still advertised by others
+	updates = nil
+	recv(alice, 300, 1)
+	require.Empty(t, updates)
+	require.Len(t, joins, 1)
+
+	// Removed name rejoins with new data
+	recv(alice, 300, 2)
+	require.Equal(t, []string{"/ndn/alice", "/ndn/alice"}, joins)
+	require.Equal(t, []SvSyncUpdate{{Name: alice, Boot: 300, High: 2, Low: 2}}, updates)
+
+	// Removed entries are forgotten when no longer advertised
+	svs.prune(time.Now().Add(time.Minute))
+	require.Equal(t, []string{"/ndn/alice", "/ndn/alice"}, leaves)
+	svs.prune(time.Now().Add(2 * time.Minute))
+	require.Empty(t, svs.pruned)
+}
+
+// Tests that nodes with different prune settings do not keep each other outdated,
+// and that nodes that do not prune consider every missing entry outdated.
+func TestSvSyncPruneInterop(t *testing.T) {
+	tu.SetT(t)
+
+	newSvs := func(pruneTimeout time.Duration, maxBootTimes int) *SvSync {
+		return NewSvSync(SvSyncOpts{
+			Client:          object.NewClient(engine.NewBasicEngine(face.NewDummyFace()), storage.NewMemoryStore(), nil),
+			GroupPrefix:     tu.NoErr(enc.NameFromStr("/ndn/group")),
+			OnUpdate:        func(SvSyncUpdate) {},
+			PeriodicTimeout: 5 * time.Second,
+			PruneTimeout:    pruneTimeout,
+			MaxBootTimes:    maxBootTimes,
+		})
+	}
+	pruning := newSvs(10*time.Second, 1)
+	peer := newSvs(20*time.Second, 3)
+	plain := newSvs(0, 0)
+
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+	entry := func(name enc.Name, entries ...uint64) *spec_svs.StateVectorEntry {
+		e := &spec_svs.StateVectorEntry{Name: name}
+		for i := 0; i < len(entries); i += 2 {
+			e.SeqNoEntries = append(e.SeqNoEntries, &spec_svs.SeqNoEntry{
+				BootstrapTime: entries[i],
+				SeqNo:         entries[i+1],
+			})
+		}
+		return e
+	}
+	send := func(from, to *SvSync) {
+		to.suppress = false
+		to.onReceiveStateVector(svSyncRecvSvArgs{sv: from.state.Encode(func(s uint64) uint64 { return s })})
+	}
+
+	// Both nodes learn the same state
+	sv := &spec_svs.StateVector{Entries: []*spec_svs.StateVectorEntry{
+		entry(alice, 100, 5, 200, 1),
+		entry(bob, 100, 3),
+	}}
+	pruning.onReceiveStateVector(svSyncRecvSvArgs{sv: sv})
+	peer.onReceiveStateVector(svSyncRecvSvArgs{sv: sv})
+	plain.onReceiveStateVector(svSyncRecvSvArgs{sv: sv})
+	require.Len(t, pruning.state[alice.TlvStr()], 1)
+	require.Len(t, peer.state[alice.TlvStr()], 2)
+
+	// Old boot times removed by the pruning node are not missing
+	send(pruning, peer)
+	require.False(t, peer.suppress)
+
+	// Without pruning, old boot times are missing
+	send(pruning, plain)
+	require.True(t, plain.suppress)
+
+	// Silent names removed by the pruning node are not missing
+	past := time.Now().Add(-21 * time.Second)
+	peer.mtime[bob.TlvStr()] = past
+	plain.mtime[bob.TlvStr()] = past
+	pruning.mtime[bob.TlvStr()] = past
+	pruning.prune(time.Now())
+	require.Nil(t, pruning.state[bob.TlvStr()])
+	send(pruning, peer)
+	require.False(t, peer.suppress)
+
+	// Without pruning, silent names are missing
+	plain.suppress = false
+	plain.onReceiveStateVector(svSyncRecvSvArgs{sv: &spec_svs.StateVector{Entries: []*spec_svs.StateVectorEntry{
+		entry(alice, 100, 5, 200, 1),
+	}}})
+	require.True(t, plain.suppress)
+
+	// The pruning node ignores the entries still advertised by the peer
+	send(peer, pruning)
+	require.False(t, pruning.suppress)
+	require.Nil(t, pruning.state[bob.TlvStr()])
+
+	// Names with recent updates are still missing
+	peer.onReceiveStateVector(svSyncRecvSvArgs{sv: &spec_svs.StateVector{Entries: []*spec_svs.StateVectorEntry{
+		entry(bob, 100, 4),
+	}}})
+	send(pruning, peer)
+	require.True(t, peer.suppress)
+}
@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/engine/face"
+	"github.com/named-data/ndnd/std/ndn"
+	spec_svs "github.com/named-data/ndnd/std/ndn/svs/v3"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// ownerSchema allows a key to sign names under the key's identity.
+type ownerSchema struct{}
+
+func (ownerSchema) Check(pkt enc.Name, cert enc.Name) bool {
+	return len(cert) > 2 && cert[:len(cert)-2].IsPrefix(pkt)
+}
+
+func (ownerSchema) Suggest(enc.Name, ndn.KeyChain) ndn.Signer {
+	return nil
+}
+
+// Tests validation of state vector entries against the trust schema.
+func TestSvSyncCheckEntries(t *testing.T) {
+	tu.SetT(t)
+
+	alice := tu.NoErr(enc.NameFromStr("/ndn/alice"))
+	bob := tu.NoErr(enc.NameFromStr("/ndn/bob"))
+	aliceKey := tu.NoErr(enc.NameFromStr("/ndn/alice/KEY/abc"))
+	sv := &spec_svs.StateVector{Entries: []*spec_svs.StateVectorEntry{
+		{Name: alice, SeqNoEntries: []*spec_svs.SeqNoEntry{{BootstrapTime: 100, SeqNo: 5}}},
+		{Name: bob, SeqNoEntries: []*spec_svs.SeqNoEntry{{BootstrapTime: 100, SeqNo: 99}}},
+	}}
+
+	svs := newTrustSvs("/ndn/group", false)
+
+	// Entries of other names are untrusted
+	require.Equal(t, map[string]bool{bob.TlvStr(): true}, svs.checkEntries(sv, aliceKey))
+
+	// Valid state vector has no untrusted entries
+	require.Empty(t, svs.checkEntries(&spec_svs.StateVector{Entries: sv.Entries[:1]}, aliceKey))
+
+	// Without a trust schema all entries are trusted
+	svs.o.TrustSchema = nil
+	require.Empty(t, svs.checkEntries(sv, aliceKey))
+}
+
+func newTrustSvs(group string, reject bool) *SvSync {
+	return NewSvSync(SvSyncOpts{
+		Client:               object.NewClient(engine.NewBasicEngine(face.NewDummyFace()), storage.NewMemoryStore(), nil),
+		GroupPrefix:          tu.NoErr(enc.NameFromStr(group)),
+		OnUpdate:             func(SvSyncUpdate) {},
+		TrustSchema:          ownerSchema{},
+		RejectInvalidEntries: reject,
+	})
+}
+
+// Tests that state vectors of three nodes converge when each node
+// may only advance its own name.
+func TestSvSyncTrustThreeNodes(t *testing.T) {
+	tu.SetT(t)
+
+	names := make([]enc.Name, 3)
+	keys := make([]enc.Name, 3)
+	nodes := make([]*SvSync, 3)
+	for i, id := range []string{"alice", "bob", "carol"} {
+		names[i] = tu.NoErr(enc.NameFromStr("/ndn/" + id))
+		keys[i] = names[i].Append(enc.NewGenericComponent("KEY"), enc.NewGenericComponent("1"))
+		nodes[i] = newTrustSvs("/ndn/group", i == 1)
+		nodes[i].IncrSeqNo(names[i])
+	}
+	alice, bob, carol := nodes[0], nodes[1], nodes[2]
+
+	// Delivers the state vector of a node to another node
+	send := func(from int, to *SvSync) {
+		sv := nodes[from].state.Encode(func(s uint64) uint64 { return s })
+		to.suppress = false
+		to.onReceiveStateVector(svSyncRecvSvArgs{
+			sv:        sv,
+			untrusted: to.checkEntries(sv, keys[from]),
+		})
+	}
+
+	// Carol learns of alice only through bob, so is not up to date
+	send(0, bob)
+	require.Equal(t, uint64(1), bob.GetSeqNo(names[0]))
+	send(1, carol)
+	require.Equal(t, uint64(1), carol.GetSeqNo(names[1]))
+	require.Equal(t, uint64(0), carol.GetSeqNo(names[0]))
+	require.Equal(t, SvSyncStats{RejectedEntries: 1}, carol.Stats())
+	require.True(t, carol.suppress) // bob is missing carol
+
+	// Carol is missing alice, so bob will send its state
+	send(2, bob)
+	require.Equal(t, uint64(1), bob.GetSeqNo(names[2]))
+	require.Equal(t, SvSyncStats{}, bob.Stats())
+	require.True(t, bob.suppress)
+
+	// Entries of other nodes are compared to detect outdated state
+	send(1, alice)
+	require.Equal(t, uint64(1), alice.GetSeqNo(names[1]))
+	require.Equal(t, uint64(0), alice.GetSeqNo(names[2]))
+	send(2, alice)
+	require.Equal(t, uint64(1), alice.GetSeqNo(names[2]))
+	send(0, carol)
+	require.Equal(t, uint64(1), carol.GetSeqNo(names[0]))
+
+	// Multi-member state vectors are accepted when they do not advance
+	// entries the signer does not own
+	for from := range nodes {
+		for _, to := range nodes {
+			send(from, to)
+			require.False(t, to.suppress)
+		}
+	}
+	require.Equal(t, SvSyncStats{}, bob.Stats())
+
+	// A state vector that advances another name is dropped entirely
+	alice.IncrSeqNo(names[0])
+	send(0, carol)
+	carol.IncrSeqNo(names[2])
+	send(2, bob)
+	require.Equal(t, uint64(1), bob.GetSeqNo(names[0]))
+	require.Equal(t, uint64(1), bob.GetSeqNo(names[2]))
+	require.Equal(t, SvSyncStats{RejectedEntries: 0, RejectedVectors: 1}, bob.Stats())
+}
@@ -9,21 +9,44 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// Constructs and returns a slice of Cobra commands for managing a router's status and neighbor links, including creating, destroying, and querying link status.
+// Constructs and returns a slice of Cobra commands for managing a router's status and neighbor links, including creating, destroying, and querying link status, and for inspecting the router's neighbors, prefix table, FIB and routes.
 func Cmds() []*cobra.Command {
 	t := Tool{}
 
+	linkCreate := &cobra.Command{
+		Use:   "link-create NEIGHBOR-URI",
+		Short: "Create a new active neighbor link",
+		Args:  cobra.ExactArgs(1),
+		Run:   t.RunDvLinkCreate,
+	}
+	linkCreate.Flags().StringVar(&t.area, "area", "", "Routing area of the link (default area if empty)")
+
 	return []*cobra.Command{{
 		Use:   "status",
 		Short: "Print general status of the router",
 		Args:  cobra.NoArgs,
 		Run:   t.RunDvStatus,
 	}, {
-		Use:   "link-create NEIGHBOR-URI",
-		Short: "Create a new active neighbor link",
-		Args:  cobra.ExactArgs(1),
-		Run:   t.RunDvLinkCreate,
+		Use:   "neighbors",
+		Short: "Print the neighbors of the router",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvNeighbors,
+	}, {
+		Use:   "prefixes",
+		Short: "Print the global prefix table",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvPrefixes,
+	}, {
+		Use:   "fib",
+		Short: "Print the FIB computed by the router",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvFib,
 	}, {
+		Use:   "route NAME",
+		Short: "Explain the route to a name",
+		Args:  cobra.ExactArgs(1),
+		Run:   t.RunDvRoute,
+	}, linkCreate, {
 		Use:   "link-destroy NEIGHBOR-URI",
 		Short: "Destroy an active neighbor link",
 		Args:  cobra.ExactArgs(1),
@@ -33,6 +56,8 @@ func Cmds() []*cobra.Command {
 
 type Tool struct {
 	engine ndn.Engine
+	// routing area of a new link
+	area string
 }
 
 // Initializes and starts the NDN engine with a default face, terminating the tool if the engine fails to start.
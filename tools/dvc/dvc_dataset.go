@@ -0,0 +1,37 @@
+package dvc
+
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object"
+)
+
+// Fetches a dataset of the router by consuming the object with the given suffix under the management prefix, returning its content or an error.
+func (t *Tool) fetchDataset(suffix enc.Name) (enc.Wire, error) {
+	// consume-only client, no need for a store
+	client := object.NewClient(t.engine, nil, nil)
+	client.Start()
+	defer client.Stop()
+
+	ch := make(chan ndn.ConsumeState)
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:       t.Prefix().Append(suffix...),
+		NoMetadata: true, // datasets have no RDR metadata
+		Callback:   func(status ndn.ConsumeState) { ch <- status },
+	})
+
+	state := <-ch
+	if err := state.Error(); err != nil {
+		return nil, err
+	}
+
+	return state.Content(), nil
+}
+
+// Returns the management prefix of the router (/localhost/nlsr).
+func (t *Tool) Prefix() enc.Name {
+	return enc.Name{
+		enc.LOCALHOST,
+		enc.NewGenericComponent("nlsr"),
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// Registers a permanent route in the NDN router's RIB with a protocol-specific prefix (`/localhop/<network>/DV/ADS/ACT`) for distance-vector routing, using the specified face.
+// Registers a permanent route in the NDN router's RIB with a protocol-specific prefix (`/localhop/<network>/DV[/AREA/<area>]/ADS/ACT`) for distance-vector routing, using the specified face.
 func (t *Tool) RunDvLinkCreate(cmd *cobra.Command, args []string) {
 	t.Start()
 	defer t.Stop()
@@ -21,10 +21,16 @@ func (t *Tool) RunDvLinkCreate(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	// /localhop/<network>/32=DV/32=ADS/32=ACT
+	// /localhop/<network>/32=DV[/32=AREA/<area>]/32=ADS/32=ACT
 	name := enc.LOCALHOP.
 		Append(status.NetworkName.Name...).
-		Append(enc.NewKeywordComponent("DV")).
+		Append(enc.NewKeywordComponent("DV"))
+	if t.area != "" {
+		name = name.
+			Append(enc.NewKeywordComponent("AREA")).
+			Append(enc.NewGenericComponent(t.area))
+	}
+	name = name.
 		Append(enc.NewKeywordComponent("ADS")).
 		Append(enc.NewKeywordComponent("ACT"))
 
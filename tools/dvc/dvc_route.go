@@ -0,0 +1,72 @@
+package dvc
+
+import (
+	"fmt"
+	"os"
+
+	spec_dv "github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/spf13/cobra"
+)
+
+// Explains how the router forwards a name: the longest matching prefix in the prefix table,
+// the exit routers announcing it, and the next hops towards each exit router.
+func (t *Tool) RunDvRoute(_ *cobra.Command, args []string) {
+	name, err := enc.NameFromStr(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid name: %s\n", args[0])
+		os.Exit(1)
+	}
+
+	t.Start()
+	defer t.Stop()
+
+	suffix := enc.Name{
+		enc.NewGenericComponent("route"),
+		enc.NewGenericBytesComponent(name.Bytes()),
+	}
+	data, err := t.fetchDataset(suffix)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching route dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	route, err := spec_dv.ParseRouteExplanation(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing route dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	if route.Prefix == nil {
+		fmt.Printf("%s does not match any prefix in the prefix table\n", route.Name)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s matches prefix %s\n", route.Name, route.Prefix.Name)
+	for i, exit := range route.Exits {
+		state := "usable"
+		switch {
+		case exit.Denied:
+			state = "denied by import policy"
+		case !exit.Reachable:
+			state = "unreachable"
+		case i == 0:
+			state = "best"
+		}
+
+		if area, ok := exit.Area.Get(); ok {
+			state += ", area " + area
+		}
+
+		fmt.Printf("  exit %s (%s) total-cost=%d router-cost=%d prefix-cost=%d\n",
+			exit.Router.Name, state, exit.RouterCost+exit.PrefixCost, exit.RouterCost, exit.PrefixCost)
+
+		for _, hop := range exit.NextHops {
+			if hop.Neighbor == nil {
+				fmt.Printf("    via local faceid=%d (cost=%d)\n", hop.FaceId, hop.Cost)
+			} else {
+				fmt.Printf("    via %s faceid=%d (cost=%d)\n", hop.Neighbor.Name, hop.FaceId, hop.Cost)
+			}
+		}
+	}
+}
@@ -62,7 +62,7 @@ func (t *Tool) RunDvStatus(_ *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	p := toolutils.StatusPrinter{File: os.Stdout, Padding: 12}
+	p := toolutils.StatusPrinter{File: os.Stdout, Padding: 14}
 	fmt.Println("General DV status:")
 	p.Print("version", status.Version)
 	p.Print("routerName", status.RouterName.Name)
@@ -70,4 +70,6 @@ func (t *Tool) RunDvStatus(_ *cobra.Command, args []string) {
 	p.Print("nRibEntries", status.NRibEntries)
 	p.Print("nNeighbors", status.NNeighbors)
 	p.Print("nFibEntries", status.NFibEntries)
+	p.Print("nExportDenied", status.NExportDenied)
+	p.Print("nImportDenied", status.NImportDenied)
 }
@@ -0,0 +1,105 @@
+package dvc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	spec_dv "github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/spf13/cobra"
+)
+
+// Fetches and prints the neighbors of the router, with the face, last seen time, advertisement sync state and link cost of each neighbor.
+func (t *Tool) RunDvNeighbors(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	data, err := t.fetchDataset(enc.Name{enc.NewGenericComponent("neighbors")})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching neighbors dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	dataset, err := spec_dv.ParseNeighborsDataset(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing neighbors dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Neighbors:")
+	for _, ns := range dataset.Neighbors {
+		face := "passive"
+		if ns.FaceActive {
+			face = "active"
+		}
+		if ns.Discovered {
+			face += ", discovered"
+		}
+		seen := time.Since(time.UnixMilli(int64(ns.LastSeen))).Truncate(time.Millisecond)
+		fmt.Printf("  %s faceid=%d (%s) cost=%d last-seen=%s ago advert-boot=%d advert-seq=%d\n",
+			ns.Name.Name, ns.FaceId, face, ns.Cost, seen, ns.AdvertBoot, ns.AdvertSeq)
+	}
+}
+
+// Fetches and prints the global prefix table, listing the exit routers of each prefix with their costs.
+func (t *Tool) RunDvPrefixes(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	data, err := t.fetchDataset(enc.Name{enc.NewGenericComponent("prefixes")})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching prefix table dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	dataset, err := spec_dv.ParsePrefixTableDataset(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing prefix table dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Prefix table:")
+	for _, prefix := range dataset.Prefixes {
+		exits := make([]string, 0, len(prefix.Exits))
+		for _, exit := range prefix.Exits {
+			flags := ""
+			if area, ok := exit.Area.Get(); ok {
+				flags += ", area=" + area
+			}
+			if exit.Denied {
+				flags += ", denied"
+			}
+			exits = append(exits, fmt.Sprintf("%s (cost=%d%s)", exit.Router.Name, exit.Cost, flags))
+		}
+		fmt.Printf("  %s exits={%s}\n", prefix.Name, strings.Join(exits, ", "))
+	}
+}
+
+// Fetches and prints the FIB computed by the router, listing the next-hop face IDs of each name with their costs.
+func (t *Tool) RunDvFib(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	data, err := t.fetchDataset(enc.Name{enc.NewGenericComponent("fib")})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching FIB dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	dataset, err := spec_dv.ParseFibDataset(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing FIB dataset: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("FIB:")
+	for _, entry := range dataset.Entries {
+		nexthops := make([]string, 0, len(entry.NextHops))
+		for _, nh := range entry.NextHops {
+			nexthops = append(nexthops, fmt.Sprintf("faceid=%d (cost=%d)", nh.FaceId, nh.Cost))
+		}
+		fmt.Printf("  %s nexthops={%s}\n", entry.Name, strings.Join(nexthops, ", "))
+	}
+}
@@ -0,0 +1,248 @@
+package repoc
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/engine"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/object/storage"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/spf13/cobra"
+)
+
+// Constructs and returns a slice of Cobra commands for managing the objects stored in a repository, including inserting, deleting, and checking objects, joining or leaving sync groups, and printing the repository status.
+func Cmds() []*cobra.Command {
+	t := Tool{}
+
+	cmdDelete := &cobra.Command{
+		Use:   "delete REPO-NAME OBJECT-NAME",
+		Short: "Delete an object from the repository",
+		Args:  cobra.ExactArgs(2),
+		Run:   t.RunDelete,
+	}
+	cmdDelete.Flags().BoolVarP(&t.prefix, "prefix", "p", false, "Delete all data under the name prefix")
+
+	cmdJoin := &cobra.Command{
+		Use:   "join REPO-NAME GROUP-NAME",
+		Short: "Join a sync group and persist its data",
+		Args:  cobra.ExactArgs(2),
+		Run:   t.RunJoin,
+	}
+	cmdJoin.Flags().StringVar(&t.protocol, "protocol", "svs", "Sync protocol of the group (svs|psync)")
+	cmdJoin.Flags().StringVar(&t.schema, "schema", "", "Compiled LVS trust schema file for data of the group")
+	cmdJoin.Flags().StringArrayVar(&t.anchors, "anchor", nil, "Trust anchor certificate file (repeatable)")
+
+	cmdLeave := &cobra.Command{
+		Use:   "leave REPO-NAME GROUP-NAME",
+		Short: "Leave a sync group",
+		Args:  cobra.ExactArgs(2),
+		Run:   t.RunLeave,
+	}
+	cmdLeave.Flags().BoolVar(&t.deleteData, "delete", false, "Delete all data of the group")
+
+	return []*cobra.Command{{
+		Use:   "insert REPO-NAME OBJECT-NAME",
+		Short: "Fetch an object into the repository",
+		Args:  cobra.ExactArgs(2),
+		Run:   t.RunInsert,
+	}, cmdDelete, {
+		Use:   "check REPO-NAME OBJECT-NAME",
+		Short: "Check if an object is stored in the repository",
+		Args:  cobra.ExactArgs(2),
+		Run:   t.RunCheck,
+	}, cmdJoin, cmdLeave, {
+		Use:   "status REPO-NAME",
+		Short: "Print the status of the repository",
+		Args:  cobra.ExactArgs(1),
+		Run:   t.RunStatus,
+	}}
+}
+
+type Tool struct {
+	engine ndn.Engine
+	client ndn.Client
+
+	prefix     bool
+	protocol   string
+	schema     string
+	anchors    []string
+	deleteData bool
+}
+
+// Returns the string representation "repoc" for the Tool object.
+func (t *Tool) String() string {
+	return "repoc"
+}
+
+// Initializes and starts the NDN engine and object client, terminating the tool if either fails to start.
+func (t *Tool) Start() {
+	t.engine = engine.NewBasicEngine(engine.NewDefaultFace())
+	if err := t.engine.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to start engine: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	t.client = object.NewClient(t.engine, storage.NewMemoryStore(), nil)
+	if err := t.client.Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to start object client: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+}
+
+// Stops the tool's object client and engine.
+func (t *Tool) Stop() {
+	t.client.Stop()
+	t.engine.Stop()
+}
+
+// Sends an ObjInsert command to the repository and prints the stored object name.
+func (t *Tool) RunInsert(_ *cobra.Command, args []string) {
+	res := t.exec(args[0], &tlv.RepoCmd{
+		ObjInsert: &tlv.ObjInsert{Name: &spec.NameContainer{Name: parseName(args[1])}},
+	})
+	printRes(res)
+}
+
+// Sends an ObjDelete command to the repository and prints the number of removed packets.
+func (t *Tool) RunDelete(_ *cobra.Command, args []string) {
+	res := t.exec(args[0], &tlv.RepoCmd{
+		ObjDelete: &tlv.ObjDelete{
+			Name:   &spec.NameContainer{Name: parseName(args[1])},
+			Prefix: t.prefix,
+		},
+	})
+	printRes(res)
+}
+
+// Sends an ObjStatus command to the repository and prints the latest stored version of the object.
+func (t *Tool) RunCheck(_ *cobra.Command, args []string) {
+	res := t.exec(args[0], &tlv.RepoCmd{
+		ObjStatus: &tlv.ObjStatus{Name: &spec.NameContainer{Name: parseName(args[1])}},
+	})
+	printRes(res)
+}
+
+// Sends a SyncJoin command to the repository for the specified sync protocol.
+func (t *Tool) RunJoin(_ *cobra.Command, args []string) {
+	var protocol enc.Name
+	switch t.protocol {
+	case "svs":
+		protocol = tlv.SyncProtocolSvsV3
+	case "psync":
+		protocol = tlv.SyncProtocolPSync
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown sync protocol: %s\n", t.protocol)
+		os.Exit(1)
+	}
+
+	join := &tlv.SyncJoin{
+		Protocol: &spec.NameContainer{Name: protocol},
+		Group:    &spec.NameContainer{Name: parseName(args[1])},
+	}
+
+	if t.schema != "" {
+		schema, err := os.ReadFile(t.schema)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Unable to read trust schema: %+v\n", err)
+			os.Exit(1)
+		}
+		join.TrustSchema = schema
+	} else if len(t.anchors) > 0 {
+		fmt.Fprintf(os.Stderr, "Trust anchors require a trust schema\n")
+		os.Exit(1)
+	}
+
+	for _, file := range t.anchors {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Unable to read trust anchor: %+v\n", err)
+			os.Exit(1)
+		}
+		_, certs, err := sec.DecodeFile(content)
+		if err != nil || len(certs) == 0 {
+			fmt.Fprintf(os.Stderr, "No certificate found in %s: %+v\n", file, err)
+			os.Exit(1)
+		}
+		join.TrustAnchors = append(join.TrustAnchors, certs...)
+	}
+
+	res := t.exec(args[0], &tlv.RepoCmd{SyncJoin: join})
+	printRes(res)
+}
+
+// Sends a SyncLeave command to the repository, optionally deleting the data of the group.
+func (t *Tool) RunLeave(_ *cobra.Command, args []string) {
+	res := t.exec(args[0], &tlv.RepoCmd{
+		SyncLeave: &tlv.SyncLeave{
+			Group:      &spec.NameContainer{Name: parseName(args[1])},
+			DeleteData: t.deleteData,
+		},
+	})
+	printRes(res)
+}
+
+// exec sends a management command to the repository and waits for the response.
+func (t *Tool) exec(repo string, cmd *tlv.RepoCmd) *tlv.RepoCmdRes {
+	t.Start()
+	defer t.Stop()
+
+	dest := parseName(repo).Append(enc.NewKeywordComponent("cmd"))
+	name := dest.WithVersion(enc.VersionUnixMicro)
+
+	type result struct {
+		wire enc.Wire
+		err  error
+	}
+	ch := make(chan result, 1)
+	t.client.ExpressCommand(dest, name, cmd.Encode(), func(wire enc.Wire, err error) {
+		ch <- result{wire, err}
+	})
+
+	r := <-ch
+	if r.err != nil {
+		fmt.Fprintf(os.Stderr, "Repository command failed: %v\n", r.err)
+		os.Exit(1)
+	}
+
+	res, err := tlv.ParseRepoCmdRes(enc.NewWireView(r.wire), false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid repository response: %v\n", err)
+		os.Exit(1)
+	}
+	return res
+}
+
+// printRes prints a command response, and exits with an error if the command failed.
+func printRes(res *tlv.RepoCmdRes) {
+	fmt.Printf("status=%d\n", res.Status)
+	if res.Message != "" {
+		fmt.Printf("message=%s\n", res.Message)
+	}
+	if res.Name != nil {
+		fmt.Printf("name=%s\n", res.Name.Name)
+	}
+	if count, ok := res.Count.Get(); ok {
+		fmt.Printf("count=%d\n", count)
+	}
+
+	if res.Status != 200 {
+		os.Exit(1)
+	}
+}
+
+// parseName parses a name argument, exiting on failure.
+func parseName(str string) enc.Name {
+	name, err := enc.NameFromStr(str)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid name %s: %v\n", str, err)
+		os.Exit(1)
+	}
+	return name
+}
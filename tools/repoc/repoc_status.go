@@ -0,0 +1,94 @@
+package repoc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/named-data/ndnd/repo/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/utils/toolutils"
+	"github.com/spf13/cobra"
+)
+
+// Fetches and displays the status dataset of a repository, including the joined sync groups, the stored objects per top-level prefix and the health of the store.
+func (t *Tool) RunStatus(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	ch := make(chan ndn.ConsumeState, 1)
+	t.client.Consume(parseName(args[0]).Append(enc.NewKeywordComponent("status")),
+		func(state ndn.ConsumeState) { ch <- state })
+
+	state := <-ch
+	if err := state.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching status dataset: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	status, err := tlv.ParseRepoStatus(enc.NewWireView(state.Content()), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing repository status: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	start := time.UnixMilli(int64(status.StartTimestamp))
+	current := time.UnixMilli(int64(status.CurrentTimestamp))
+
+	p := toolutils.StatusPrinter{File: os.Stdout, Padding: 16}
+	fmt.Println("General repo status:")
+	if status.Name != nil {
+		p.Print("name", status.Name.Name)
+	}
+	p.Print("startTime", start)
+	p.Print("currentTime", current)
+	p.Print("uptime", current.Sub(start))
+	p.Print("rejectedData", status.RejectedData)
+
+	if store := status.Store; store != nil {
+		fmt.Println()
+		fmt.Println("Store:")
+		p.Print("healthy", store.Healthy)
+		if store.Error != "" {
+			p.Print("error", store.Error)
+		}
+		p.Print("nPackets", store.Packets)
+		p.Print("size", fmt.Sprintf("%dB", store.Size))
+	}
+
+	fmt.Println()
+	fmt.Println("Sync groups:")
+	for _, group := range status.Groups {
+		info := []string{}
+		if group.Group != nil {
+			info = append(info, fmt.Sprintf("group=%s", group.Group.Name))
+		}
+		if group.Protocol != nil {
+			info = append(info, fmt.Sprintf("protocol=%s", group.Protocol.Name))
+		}
+		info = append(info, fmt.Sprintf("sv-size=%d", group.StateVectorSize))
+		if last, ok := group.LastPublication.Get(); ok {
+			info = append(info, fmt.Sprintf("last-publication=%s", time.UnixMilli(int64(last))))
+		} else {
+			info = append(info, "last-publication=never")
+		}
+		fmt.Printf("  %s\n", strings.Join(info, " "))
+	}
+
+	fmt.Println()
+	fmt.Println("Stored prefixes:")
+	for _, prefix := range status.Prefixes {
+		info := []string{}
+		if prefix.Prefix != nil {
+			info = append(info, fmt.Sprintf("prefix=%s", prefix.Prefix.Name))
+		}
+		info = append(info, fmt.Sprintf("objects=%d", prefix.Objects))
+		info = append(info, fmt.Sprintf("packets=%d", prefix.Packets))
+		info = append(info, fmt.Sprintf("size=%dB", prefix.Size))
+		fmt.Printf("  %s\n", strings.Join(info, " "))
+	}
+}
@@ -0,0 +1,186 @@
+package tools
+
+import (
+	"fmt"
+	"os"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/spf13/cobra"
+)
+
+type StoreTool struct {
+	storeType string
+	prefix    string
+	verbose   bool
+}
+
+// Constructs the Cobra command for inspecting, exporting and importing a local object store.
+func CmdStore() *cobra.Command {
+	st := StoreTool{}
+
+	cmd := &cobra.Command{
+		GroupID: "tools",
+		Use:     "store",
+		Short:   "Inspect a local object store",
+		Long: `Inspect, export and import the contents of a local object store.
+The store must not be open by another process.`,
+	}
+	cmd.PersistentFlags().StringVarP(&st.storeType, "type", "t", "badger", "store type (badger, sqlite)")
+	cmd.PersistentFlags().StringVarP(&st.prefix, "prefix", "p", "/", "name prefix to operate on")
+
+	dump := &cobra.Command{
+		Use:     "dump STORE-PATH",
+		Short:   "List all Data in a store",
+		Args:    cobra.ExactArgs(1),
+		Example: `  ndnd store dump --prefix /my/app /var/lib/ndn/repo`,
+		Run:     st.dump,
+	}
+	dump.Flags().BoolVarP(&st.verbose, "verbose", "v", false, "print details of each Data packet")
+	cmd.AddCommand(dump)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:     "export STORE-PATH FILE",
+		Short:   "Export Data in a store to a TLV file",
+		Args:    cobra.ExactArgs(2),
+		Example: `  ndnd store export --prefix /my/app /var/lib/ndn/repo app.tlv`,
+		Run:     st.export,
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:     "import STORE-PATH FILE",
+		Short:   "Import Data from a TLV file into a store",
+		Args:    cobra.ExactArgs(2),
+		Example: `  ndnd store import /var/lib/ndn/repo app.tlv`,
+		Run:     st.importFile,
+	})
+
+	return cmd
+}
+
+// Returns the string representation "store" for the StoreTool object.
+func (st *StoreTool) String() string {
+	return "store"
+}
+
+// Opens the store of the configured type at the given path.
+func (st *StoreTool) open(path string) (ndn.Store, func() error) {
+	switch st.storeType {
+	case "badger":
+		store, err := storage.NewBadgerStore(path)
+		if err != nil {
+			log.Fatal(st, "Unable to open badger store", "path", path, "err", err)
+		}
+		return store, store.Close
+	case "sqlite":
+		store, err := storage.NewSqliteStore(path)
+		if err != nil {
+			log.Fatal(st, "Unable to open sqlite store", "path", path, "err", err)
+		}
+		return store, store.Close
+	default:
+		log.Fatal(st, "Unknown store type", "type", st.storeType)
+		return nil, nil
+	}
+}
+
+// Parses the prefix flag.
+func (st *StoreTool) parsePrefix() enc.Name {
+	prefix, err := enc.NameFromStr(st.prefix)
+	if err != nil {
+		log.Fatal(st, "Invalid prefix", "prefix", st.prefix)
+	}
+	return prefix
+}
+
+// Prints the names of all Data in the store under the prefix to stdout.
+func (st *StoreTool) dump(_ *cobra.Command, args []string) {
+	prefix := st.parsePrefix()
+	store, close := st.open(args[0])
+	defer close()
+
+	count, size := 0, 0
+	wires, iterErr := store.Iter(prefix)
+	for name, wire := range wires {
+		count++
+		size += len(wire)
+
+		if !st.verbose {
+			fmt.Println(name)
+			continue
+		}
+
+		data, _, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+		if err != nil {
+			fmt.Printf("%s size=%d invalid=%v\n", name, len(wire), err)
+			continue
+		}
+
+		line := fmt.Sprintf("%s size=%d content=%d", name, len(wire), data.Content().Length())
+		if ct, ok := data.ContentType().Get(); ok {
+			line += fmt.Sprintf(" type=%d", ct)
+		}
+		if fresh, ok := data.Freshness().Get(); ok {
+			line += fmt.Sprintf(" freshness=%s", fresh)
+		}
+		if sig := data.Signature(); sig != nil {
+			line += fmt.Sprintf(" sig=%s", sig.SigType())
+			if key := sig.KeyName(); key != nil {
+				line += fmt.Sprintf(" key=%s", key)
+			}
+		}
+		fmt.Println(line)
+	}
+	if err := iterErr(); err != nil {
+		log.Fatal(st, "Unable to read store", "err", err)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "%d objects, %d bytes\n", count, size)
+}
+
+// Exports all Data in the store under the prefix to a TLV file.
+func (st *StoreTool) export(_ *cobra.Command, args []string) {
+	prefix := st.parsePrefix()
+	store, close := st.open(args[0])
+	defer close()
+
+	file, err := os.Create(args[1])
+	if err != nil {
+		log.Fatal(st, "Unable to create file", "file", args[1], "err", err)
+		return
+	}
+	defer file.Close()
+
+	count, err := storage.ExportStore(store, prefix, file)
+	if err != nil {
+		log.Fatal(st, "Unable to export store", "err", err)
+		return
+	}
+
+	log.Info(st, "Exported store", "file", args[1], "count", count)
+}
+
+// Imports all Data from a TLV file into the store.
+func (st *StoreTool) importFile(_ *cobra.Command, args []string) {
+	store, close := st.open(args[0])
+	defer close()
+
+	file, err := os.Open(args[1])
+	if err != nil {
+		log.Fatal(st, "Unable to open file", "file", args[1], "err", err)
+		return
+	}
+	defer file.Close()
+
+	count, err := storage.ImportStore(store, file)
+	if err != nil {
+		log.Fatal(st, "Unable to import store", "err", err)
+		return
+	}
+
+	log.Info(st, "Imported store", "file", args[1], "count", count)
+}